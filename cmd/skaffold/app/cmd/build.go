@@ -19,14 +19,21 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	"github.com/GoogleContainerTools/skaffold/v2/cmd/skaffold/app/flags"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
@@ -37,6 +44,9 @@ var (
 	defaultBuildFormatTemplate = "{{json .}}"
 	buildFormatFlag            = flags.NewTemplateFlag(defaultBuildFormatTemplate, flags.BuildOutput{})
 	buildOutputFlag            string
+
+	// for testing
+	buildTime = time.Now
 )
 
 // NewCmdBuild describes the CLI command to build artifacts.
@@ -47,16 +57,18 @@ func NewCmdBuild() *cobra.Command {
 		WithExample("Build all the artifacts", "build").
 		WithExample("Build artifacts with a profile activated", "build -p <profile>").
 		WithExample("Build artifacts whose image name contains <db>", "build -b <db>").
+		WithExample("Build only the <frontend> and <api> artifacts", "build --only=<frontend>,<api>").
 		WithExample("Quietly build artifacts and output the image names as json", "build -q > build_result.json").
 		WithExample("Build the artifacts and then deploy them", "build -q | skaffold deploy --build-artifacts -").
 		WithExample("Print the final image names", "build -q --dry-run").
 		WithCommonFlags().
 		WithFlags([]*Flag{
 			{Value: &quietFlag, Name: "quiet", Shorthand: "q", DefValue: false, Usage: "Suppress the build output and print image built on success. See --output to format output.", IsEnum: true},
-			{Value: buildFormatFlag, Name: "output", Shorthand: "o", DefValue: defaultBuildFormatTemplate, Usage: "Used in conjunction with --quiet flag. " + buildFormatFlag.Usage()},
+			{Value: buildFormatFlag, Name: "output", Shorthand: "o", DefValue: defaultBuildFormatTemplate, Usage: "Used in conjunction with --quiet or --file-output flags. Either json, yaml or a go-template. " + buildFormatFlag.Usage()},
 			{Value: &buildOutputFlag, Name: "file-output", DefValue: "", Usage: "Filename to write build images to"},
 			{Value: &opts.DryRun, Name: "dry-run", DefValue: false, Usage: "Don't build images, just compute the tag for each artifact.", IsEnum: true},
 			{Value: &opts.PushImages, Name: "push", DefValue: nil, Usage: "Push the built images to the specified image repository.", IsEnum: true, NoOptDefVal: "true"},
+			{Value: &opts.ProfileTimings, Name: "profile-timings", DefValue: false, Usage: "Print the time spent checking the cache, uploading the build context, building and pushing each artifact.", IsEnum: true},
 		}).
 		WithHouseKeepingMessages().
 		NoArgs(doBuild)
@@ -69,13 +81,16 @@ func doBuild(ctx context.Context, out io.Writer) error {
 	}
 
 	return withRunner(ctx, out, func(r runner.Runner, configs []util.VersionedConfig) error {
-		bRes, err := r.Build(ctx, buildOut, targetArtifacts(opts, configs))
+		artifacts, err := targetArtifacts(opts, configs)
+		if err != nil {
+			return err
+		}
+		bRes, err := r.Build(ctx, buildOut, artifacts)
 
 		if quietFlag || buildOutputFlag != "" {
-			cmdOut := flags.BuildOutput{Builds: bRes}
-			var buildOutput bytes.Buffer
-			if err := buildFormatFlag.Template().Execute(&buildOutput, cmdOut); err != nil {
-				return fmt.Errorf("executing template: %w", err)
+			buildOutput, err := formatBuildOutput(newBuildOutput(bRes, configs))
+			if err != nil {
+				return err
 			}
 
 			if quietFlag {
@@ -95,14 +110,75 @@ func doBuild(ctx context.Context, out io.Writer) error {
 	})
 }
 
-func targetArtifacts(opts config.SkaffoldOptions, configs []util.VersionedConfig) []*latest.Artifact {
+// newBuildOutput returns the output of the build, with the type of builder of each artifact.
+func newBuildOutput(bRes []graph.Artifact, configs []util.VersionedConfig) flags.BuildOutput {
+	builders := map[string]string{}
+	for _, cfg := range configs {
+		for _, a := range cfg.(*latest.SkaffoldConfig).Build.Artifacts {
+			builders[a.ImageName] = misc.ArtifactType(a)
+		}
+	}
+	timestamp := buildTime().UTC()
+	out := flags.BuildOutput{Builds: bRes, Builders: map[string]string{}, Timestamp: &timestamp}
+	for _, b := range bRes {
+		if builder, found := builders[b.ImageName]; found {
+			out.Builders[b.ImageName] = builder
+		}
+	}
+	return out
+}
+
+// formatBuildOutput encodes the output of the build in the format given by the `--output` flag.
+func formatBuildOutput(cmdOut flags.BuildOutput) (*bytes.Buffer, error) {
+	var buildOutput bytes.Buffer
+	switch buildFormatFlag.String() {
+	case "json":
+		b, err := json.MarshalIndent(cmdOut, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encoding build output: %w", err)
+		}
+		buildOutput.Write(b)
+		buildOutput.WriteString("\n")
+	case "yaml":
+		b, err := yaml.Marshal(cmdOut)
+		if err != nil {
+			return nil, fmt.Errorf("encoding build output: %w", err)
+		}
+		buildOutput.Write(b)
+	default:
+		if err := buildFormatFlag.Template().Execute(&buildOutput, cmdOut); err != nil {
+			return nil, fmt.Errorf("executing template: %w", err)
+		}
+	}
+	return &buildOutput, nil
+}
+
+func targetArtifacts(opts config.SkaffoldOptions, configs []util.VersionedConfig) ([]*latest.Artifact, error) {
 	var targetArtifacts []*latest.Artifact
+	var imageNames []string
 	for _, cfg := range configs {
 		for _, artifact := range cfg.(*latest.SkaffoldConfig).Build.Artifacts {
+			imageNames = append(imageNames, artifact.ImageName)
 			if opts.IsTargetImage(artifact) {
 				targetArtifacts = append(targetArtifacts, artifact)
 			}
 		}
 	}
-	return targetArtifacts
+	if err := checkArtifactNames("only", opts.OnlyArtifacts, imageNames); err != nil {
+		return nil, err
+	}
+	if err := checkArtifactNames("skip", opts.SkipArtifacts, imageNames); err != nil {
+		return nil, err
+	}
+	return targetArtifacts, nil
+}
+
+// checkArtifactNames checks that the artifacts selected with a flag are defined, to catch typos that would silently build nothing.
+func checkArtifactNames(flag string, selected []string, imageNames []string) error {
+	for _, name := range selected {
+		if !slices.Contains(imageNames, name) {
+			return fmt.Errorf("unknown artifact %q in --%s, the artifacts are: %s", name, flag, strings.Join(imageNames, ", "))
+		}
+	}
+	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/v2/cmd/skaffold/app/flags"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
@@ -55,6 +56,10 @@ func (r *mockRunner) Stop() error {
 	return nil
 }
 
+func fakeBuildTime() time.Time {
+	return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+}
+
 func newMockCreateRunner(artifacts []*latest.Artifact) func(context.Context, io.Writer, config.SkaffoldOptions) (runner.Runner, []util.VersionedConfig, *runcontext.RunContext, error) {
 	return func(context.Context, io.Writer, config.SkaffoldOptions) (runner.Runner, []util.VersionedConfig, *runcontext.RunContext, error) {
 		return &mockRunner{}, []util.VersionedConfig{&latest.SkaffoldConfig{
@@ -74,13 +79,14 @@ func TestTagFlag(t *testing.T) {
 		t.Override(&quietFlag, true)
 		t.Override(&opts.CustomTag, "tag")
 		t.Override(&createRunner, mockCreateRunner)
+		t.Override(&buildTime, fakeBuildTime)
 
 		var output bytes.Buffer
 
 		err := doBuild(context.Background(), &output)
 
 		t.CheckNoError(err)
-		t.CheckDeepEqual(string([]byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}]}`)), output.String())
+		t.CheckDeepEqual(string([]byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}],"timestamp":"2026-01-02T03:04:05Z"}`)), output.String())
 	})
 }
 
@@ -95,7 +101,7 @@ func TestQuietFlag(t *testing.T) {
 	}{
 		{
 			description:    "quiet flag print build images with no template",
-			expectedOutput: []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}]}`),
+			expectedOutput: []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}],"timestamp":"2026-01-02T03:04:05Z"}`),
 			shouldErr:      false,
 		},
 		{
@@ -115,6 +121,7 @@ func TestQuietFlag(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&quietFlag, true)
 			t.Override(&createRunner, mockCreateRunner)
+			t.Override(&buildTime, fakeBuildTime)
 			if test.template != "" {
 				t.Override(&buildFormatFlag, flags.NewTemplateFlag(test.template, flags.BuildOutput{}))
 			}
@@ -144,14 +151,14 @@ func TestFileOutputFlag(t *testing.T) {
 			filename:            "testfile.out",
 			quietFlag:           false,
 			expectedOutput:      []byte("Build Completed"),
-			expectedFileContent: []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}]}`),
+			expectedFileContent: []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}],"timestamp":"2026-01-02T03:04:05Z"}`),
 		},
 		{
 			description:         "file output flag with quiet flag creates a file and suppresses build output",
 			filename:            "testfile.out",
 			quietFlag:           true,
-			expectedOutput:      []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}]}`),
-			expectedFileContent: []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}]}`),
+			expectedOutput:      []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}],"timestamp":"2026-01-02T03:04:05Z"}`),
+			expectedFileContent: []byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test"}],"timestamp":"2026-01-02T03:04:05Z"}`),
 		},
 		{
 			description:         "file output flag with template properly formats output and writes to a file",
@@ -167,6 +174,7 @@ func TestFileOutputFlag(t *testing.T) {
 			t.Override(&quietFlag, test.quietFlag)
 			t.Override(&buildOutputFlag, test.filename)
 			t.Override(&createRunner, mockCreateRunner)
+			t.Override(&buildTime, fakeBuildTime)
 			if test.template != "" {
 				t.Override(&buildFormatFlag, flags.NewTemplateFlag(test.template, flags.BuildOutput{}))
 			}
@@ -222,6 +230,63 @@ func TestRunBuild(t *testing.T) {
 	}
 }
 
+func TestTargetArtifacts(t *testing.T) {
+	configs := []util.VersionedConfig{&latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{{ImageName: "frontend"}, {ImageName: "api"}, {ImageName: "worker"}},
+			},
+		},
+	}}
+	tests := []struct {
+		description string
+		opts        config.SkaffoldOptions
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "all artifacts",
+			expected:    []string{"frontend", "api", "worker"},
+		},
+		{
+			description: "only",
+			opts:        config.SkaffoldOptions{OnlyArtifacts: []string{"frontend", "api"}},
+			expected:    []string{"frontend", "api"},
+		},
+		{
+			description: "skip",
+			opts:        config.SkaffoldOptions{SkipArtifacts: []string{"worker"}},
+			expected:    []string{"frontend", "api"},
+		},
+		{
+			description: "only and skip",
+			opts:        config.SkaffoldOptions{OnlyArtifacts: []string{"frontend", "api"}, SkipArtifacts: []string{"api"}},
+			expected:    []string{"frontend"},
+		},
+		{
+			description: "unknown artifact in only",
+			opts:        config.SkaffoldOptions{OnlyArtifacts: []string{"fronted"}},
+			shouldErr:   true,
+		},
+		{
+			description: "unknown artifact in skip",
+			opts:        config.SkaffoldOptions{SkipArtifacts: []string{"db"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			artifacts, err := targetArtifacts(test.opts, configs)
+
+			var names []string
+			for _, a := range artifacts {
+				names = append(names, a.ImageName)
+			}
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, names)
+		})
+	}
+}
+
 func TestRuntimeType(t *testing.T) {
 	mockCreateRunner := newMockCreateRunner([]*latest.Artifact{{
 		ImageName:   "gcr.io/skaffold/example",
@@ -231,12 +296,73 @@ func TestRuntimeType(t *testing.T) {
 	testutil.Run(t, "set runtime type on artifact", func(t *testutil.T) {
 		t.Override(&quietFlag, true)
 		t.Override(&createRunner, mockCreateRunner)
+		t.Override(&buildTime, fakeBuildTime)
 
 		var output bytes.Buffer
 
 		err := doBuild(context.Background(), &output)
 
 		t.CheckNoError(err)
-		t.CheckDeepEqual(string([]byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test","runtimeType":"go"}]}`)), output.String())
+		t.CheckDeepEqual(string([]byte(`{"builds":[{"imageName":"gcr.io/skaffold/example","tag":"test","runtimeType":"go"}],"timestamp":"2026-01-02T03:04:05Z"}`)), output.String())
 	})
 }
+
+func TestBuildOutputFormat(t *testing.T) {
+	mockCreateRunner := newMockCreateRunner([]*latest.Artifact{{
+		ImageName: "gcr.io/skaffold/example",
+		ArtifactType: latest.ArtifactType{
+			DockerArtifact: &latest.DockerArtifact{},
+		},
+	}})
+
+	tests := []struct {
+		description    string
+		format         string
+		expectedOutput string
+	}{
+		{
+			description: "json",
+			format:      "json",
+			expectedOutput: `{
+  "builds": [
+    {
+      "imageName": "gcr.io/skaffold/example",
+      "tag": "test",
+      "builder": "docker"
+    }
+  ],
+  "timestamp": "2026-01-02T03:04:05Z"
+}
+`,
+		},
+		{
+			description: "yaml",
+			format:      "yaml",
+			expectedOutput: `builds:
+- builder: docker
+  imageName: gcr.io/skaffold/example
+  tag: test
+timestamp: "2026-01-02T03:04:05Z"
+`,
+		},
+		{
+			description:    "template",
+			format:         "{{range .Builds}}{{.ImageName}}={{index $.Builders .ImageName}}{{end}}",
+			expectedOutput: "gcr.io/skaffold/example=docker",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&quietFlag, true)
+			t.Override(&createRunner, mockCreateRunner)
+			t.Override(&buildTime, fakeBuildTime)
+			t.Override(&buildFormatFlag, flags.NewTemplateFlag(test.format, flags.BuildOutput{}))
+
+			var output bytes.Buffer
+			err := doBuild(context.Background(), &output)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedOutput, output.String())
+		})
+	}
+}
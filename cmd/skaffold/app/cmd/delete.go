@@ -48,7 +48,11 @@ func doDelete(ctx context.Context, out io.Writer) error {
 	opts.DigestSource = constants.TagDigestSource
 	opts.RenderOnly = true
 	return withRunner(ctx, out, func(r runner.Runner, configs []util.VersionedConfig) error {
-		bRes, err := r.Build(ctx, io.Discard, targetArtifacts(opts, configs))
+		artifacts, err := targetArtifacts(opts, configs)
+		if err != nil {
+			return err
+		}
+		bRes, err := r.Build(ctx, io.Discard, artifacts)
 		if err != nil {
 			return fmt.Errorf("executing build: %w", err)
 		}
@@ -36,6 +36,7 @@ func NewCmdDeploy() *cobra.Command {
 		WithExample("Build the artifacts and collect the tags into a file", "build --file-output=tags.json").
 		WithExample("Deploy those tags", "deploy --build-artifacts=tags.json").
 		WithExample("Build the artifacts and then deploy them", "build -q | skaffold deploy --build-artifacts -").
+		WithExample("Wait for resources deployed by another tool to stabilize", "deploy --status-check-only").
 		WithCommonFlags().
 		WithHouseKeepingMessages().
 		NoArgs(doDeploy)
@@ -57,6 +58,9 @@ func doDeploy(ctx context.Context, out io.Writer) error {
 		if errR != nil {
 			return fmt.Errorf("rendering manifests: %w", errR)
 		}
+		if opts.StatusCheckOnly {
+			return r.CheckStatus(ctx, out, manifests)
+		}
 		return r.DeployAndLog(ctx, out, buildArtifacts, manifests)
 	})
 }
@@ -26,8 +26,11 @@ import (
 
 	deployutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/util"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/diagnose"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/parser"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	schemaUtil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
@@ -45,7 +48,8 @@ var (
 	enableTemplating bool
 	// for testing
 	getRunContext = runcontext.GetRunContext
-	getCfgs       = parser.GetAllConfigs
+	getCfgSet     = parser.GetConfigSet
+	getRenderer   = runner.GetRenderer
 )
 
 // NewCmdDiagnose describes the CLI command to diagnose skaffold.
@@ -66,10 +70,14 @@ func NewCmdDiagnose() *cobra.Command {
 func doDiagnose(ctx context.Context, out io.Writer) error {
 	// force absolute path resolution during diagnose
 	opts.MakePathsAbsolute = util.Ptr(true)
-	configs, err := getCfgs(ctx, opts)
+	cfgSet, err := getCfgSet(ctx, opts)
 	if err != nil {
 		return err
 	}
+	var configs []schemaUtil.VersionedConfig
+	for _, cfg := range cfgSet {
+		configs = append(configs, cfg.SkaffoldConfig)
+	}
 	if outputFile != "" {
 		f, err := os.Create(outputFile)
 		if err != nil {
@@ -80,7 +88,7 @@ func doDiagnose(ctx context.Context, out io.Writer) error {
 	}
 
 	if !yamlOnly {
-		if err := printArtifactDiagnostics(ctx, out, configs); err != nil {
+		if err := printArtifactDiagnostics(ctx, out, cfgSet, configs); err != nil {
 			return err
 		}
 	}
@@ -103,7 +111,7 @@ func doDiagnose(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
-func printArtifactDiagnostics(ctx context.Context, out io.Writer, configs []schemaUtil.VersionedConfig) error {
+func printArtifactDiagnostics(ctx context.Context, out io.Writer, cfgSet parser.SkaffoldConfigSet, configs []schemaUtil.VersionedConfig) error {
 	runCtx, err := getRunContext(ctx, opts, configs)
 	if err != nil {
 		return fmt.Errorf("getting run context: %w", err)
@@ -116,6 +124,7 @@ func printArtifactDiagnostics(ctx context.Context, out io.Writer, configs []sche
 	if err != nil {
 		return fmt.Errorf("getting tags: %w", err)
 	}
+	unused := unusedArtifacts(ctx, runCtx)
 	for _, c := range configs {
 		config := c.(*latest.SkaffoldConfig)
 		fmt.Fprintln(out, "Skaffold version:", version.Get().GitCommit)
@@ -124,8 +133,67 @@ func printArtifactDiagnostics(ctx context.Context, out io.Writer, configs []sche
 		if err := diagnose.CheckArtifacts(ctx, runCtx, imageTags, out); err != nil {
 			return fmt.Errorf("running diagnostic on artifacts: %w", err)
 		}
+		printUnusedArtifacts(out, cfgSet, config, unused)
 
 		output.Blue.Fprintln(out, "\nConfiguration")
 	}
 	return nil
 }
+
+// unusedArtifacts renders the manifests without building the artifacts to find the artifacts that are never referenced.
+// The check is skipped if the manifests can't be rendered, since it isn't essential to the other diagnostics.
+func unusedArtifacts(ctx context.Context, runCtx *runcontext.RunContext) map[*latest.Artifact]bool {
+	hydrationDir, err := os.MkdirTemp("", "skaffold-diagnose")
+	if err != nil {
+		log.Entry(ctx).Warnf("skipping check for unused artifacts: %v", err)
+		return nil
+	}
+	defer os.RemoveAll(hydrationDir)
+
+	r, err := getRenderer(ctx, runCtx, hydrationDir, nil, runCtx.UsingLegacyHelmDeploy())
+	if err != nil {
+		log.Entry(ctx).Warnf("skipping check for unused artifacts: %v", err)
+		return nil
+	}
+	var builds []graph.Artifact
+	for _, a := range runCtx.Artifacts() {
+		builds = append(builds, graph.Artifact{ImageName: a.ImageName, Tag: a.ImageName})
+	}
+	manifests, err := r.Render(ctx, io.Discard, builds, true)
+	if err != nil {
+		log.Entry(ctx).Warnf("skipping check for unused artifacts: rendering manifests: %v", err)
+		return nil
+	}
+	artifacts, err := diagnose.UnusedArtifacts(runCtx, manifests)
+	if err != nil {
+		log.Entry(ctx).Warnf("skipping check for unused artifacts: %v", err)
+		return nil
+	}
+	unused := map[*latest.Artifact]bool{}
+	for _, a := range artifacts {
+		unused[a] = true
+	}
+	return unused
+}
+
+func printUnusedArtifacts(out io.Writer, cfgSet parser.SkaffoldConfigSet, config *latest.SkaffoldConfig, unused map[*latest.Artifact]bool) {
+	var lines []string
+	for _, a := range config.Build.Artifacts {
+		if !unused[a] {
+			continue
+		}
+		loc := cfgSet.Locate(a)
+		if loc.StartLine == -1 {
+			lines = append(lines, fmt.Sprintf(" - %s", a.ImageName))
+		} else {
+			lines = append(lines, fmt.Sprintf(" - %s (%s:%d)", a.ImageName, loc.SourceFile, loc.StartLine))
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	output.Yellow.Fprintln(out, "\nUnused artifacts: these images are not referenced by any rendered manifest, check the image names for typos")
+	for _, l := range lines {
+		fmt.Fprintln(out, l)
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/parser"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/parser/configlocations"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
@@ -62,22 +64,22 @@ metadata:
 				return nil, fmt.Errorf("cannot get the runtime context")
 			})
 			t.Override(&yamlOnly, test.yamlOnly)
-			t.Override(&getCfgs, func(context.Context, config.SkaffoldOptions) ([]util.VersionedConfig, error) {
-				return []util.VersionedConfig{
-					&latest.SkaffoldConfig{
+			t.Override(&getCfgSet, func(context.Context, config.SkaffoldOptions) (parser.SkaffoldConfigSet, error) {
+				return parser.SkaffoldConfigSet{
+					&parser.SkaffoldConfigEntry{SkaffoldConfig: &latest.SkaffoldConfig{
 						APIVersion: "testVersion",
 						Kind:       "Config",
 						Metadata: latest.Metadata{
 							Name: "config1",
 						},
-					},
-					&latest.SkaffoldConfig{
+					}},
+					&parser.SkaffoldConfigEntry{SkaffoldConfig: &latest.SkaffoldConfig{
 						APIVersion: "testVersion",
 						Kind:       "Config",
 						Metadata: latest.Metadata{
 							Name: "config2",
 						},
-					},
+					}},
 				}, nil
 			})
 			var b bytes.Buffer
@@ -86,3 +88,32 @@ metadata:
 		})
 	}
 }
+
+func TestPrintUnusedArtifacts(t *testing.T) {
+	used := &latest.Artifact{ImageName: "used"}
+	typo := &latest.Artifact{ImageName: "tpyo"}
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{Build: latest.BuildConfig{Artifacts: []*latest.Artifact{used, typo}}},
+	}
+	tests := []struct {
+		description string
+		unused      map[*latest.Artifact]bool
+		expected    string
+	}{
+		{
+			description: "no unused artifacts",
+		},
+		{
+			description: "unused artifact",
+			unused:      map[*latest.Artifact]bool{typo: true},
+			expected:    "\nUnused artifacts: these images are not referenced by any rendered manifest, check the image names for typos\n - tpyo\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var b bytes.Buffer
+			printUnusedArtifacts(&b, parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{SkaffoldConfig: cfg, YAMLInfos: configlocations.NewYAMLInfos()}}, cfg, test.unused)
+			t.CheckDeepEqual(test.expected, b.String())
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/GoogleContainerTools/skaffold/v2/cmd/skaffold/app/flags"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/sbom"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
@@ -106,10 +107,28 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringSliceVar",
 		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "apply", "test", "verify", "exec"},
 	},
+	{
+		Name:          "expand-env",
+		Usage:         "Expand `${VAR}` and `${VAR:-default}` references to environment variables in the `skaffold.yaml` files before parsing them. Undefined variables are replaced with an empty string, and `$${VAR}` is kept as `${VAR}`.",
+		Value:         &opts.ExpandEnv,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "apply", "test", "verify", "exec"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "expand-env-strict",
+		Usage:         "Like --expand-env, but fail if the `skaffold.yaml` files reference an undefined environment variable without a default.",
+		Value:         &opts.ExpandEnvStrict,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "apply", "test", "verify", "exec"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "namespace",
 		Shorthand:     "n",
-		Usage:         "Runs deployments in the specified namespace. When used with 'render' command, renders manifests contain the namespace",
+		Usage:         "Runs deployments in the specified namespace, which can be templated from environment variables, e.g. 'pr-{{.PR_NUMBER}}'. When used with 'render' command, renders manifests contain the namespace",
 		Value:         &opts.Namespace,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
@@ -133,6 +152,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "build", "run", "debug", "render"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "cache-content-tags",
+		Usage:         "Tag pushed images with the hash of their build inputs, and reuse an image with the same inputs from the registry instead of rebuilding it, even without a local cache entry",
+		Value:         &opts.CacheContentTags,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "render"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "cache-file",
 		Usage:         "Specify the location of the cache file (default $HOME/.skaffold/cache)",
@@ -232,6 +260,15 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringSliceVar",
 		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "filter", "apply"},
 	},
+	{
+		Name:          "allow-base-drift",
+		Usage:         "Resolve the refs of the remote bases recorded in the `remoteBasesLockFile` of the kustomizations again, and record the commits they moved to",
+		Value:         &opts.AllowBaseDrift,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "toot",
 		Usage:         "Emit a terminal beep after the deploy is complete",
@@ -349,7 +386,146 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "BoolVar",
 		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
 		IsEnum:        true,
-		Hidden:        true,
+		Deprecated:    "please use --status-check-fail-fast instead.",
+	},
+	{
+		Name:          "status-check-fail-fast",
+		Usage:         "Stop `status-check` of all resources as soon as one resource fails. Set to false to wait for every resource to succeed or fail, and report the final state of each of them.",
+		Value:         &opts.FastFailStatusCheck,
+		DefValue:      true,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-tail",
+		Usage:         "Stream the logs of unready pods while `status-check` waits for deployed resources to stabilize. A pod's logs stop streaming once it becomes ready or the status check deadline is reached.",
+		Value:         &opts.StatusCheckTail,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-quiet",
+		Usage:         "Only report the resources failing `status-check` and a final summary, instead of the progress of every deployed resource.",
+		Value:         &opts.StatusCheckQuiet,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-all-namespaces",
+		Usage:         "Select the resources to `status-check` by run id label across all namespaces, instead of only the namespaces known from the deployed manifests. Useful when namespaces are derived at apply time.",
+		Value:         &opts.StatusCheckAllNamespaces,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-poll-interval",
+		Usage:         "Interval between two `status-check` polls of a deployed resource",
+		Value:         &opts.StatusCheckPollInterval,
+		DefValue:      time.Second,
+		FlagAddMethod: "DurationVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+	},
+	{
+		Name:          "status-check-adaptive-poll",
+		Usage:         "Back off the `status-check` poll interval, up to 8 times `--status-check-poll-interval`, while a resource status stays unchanged. The interval is reset as soon as the resource status changes.",
+		Value:         &opts.StatusCheckAdaptivePoll,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-wait-for-hpa",
+		Usage:         "Wait for deployments targeted by a HorizontalPodAutoscaler to have at least the autoscaler's `minReplicas` available replicas during `status-check`",
+		Value:         &opts.StatusCheckWaitForHPA,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-wait-for-endpoints",
+		Usage:         "Wait for the services selecting the pods of deployments to have a ready endpoint for each replica during `status-check`",
+		Value:         &opts.StatusCheckWaitForEndpoints,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-log-lines",
+		Usage:         "Number of last log lines of each failing pod reported by `status-check` when its logs are muted. The full logs are written to a file",
+		Value:         &opts.StatusCheckLogLines,
+		DefValue:      3,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+	},
+	{
+		Name:          "status-check-max-log-lines",
+		Usage:         "Maximum number of last log lines of each failing pod reported by `status-check` when its logs aren't muted, with the full logs written to a file. 0 reports all the lines",
+		Value:         &opts.StatusCheckMaxLogLines,
+		DefValue:      0,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+	},
+	{
+		Name:          "status-check-kubectl",
+		Usage:         "Path of the kubectl binary run by `status-check`. Defaults to `kubectl` from the PATH",
+		Value:         &opts.StatusCheckKubectl,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+	},
+	{
+		Name:          "watch-resources",
+		Usage:         "Print a live tree of the resources tracked by `status-check` and their status, redrawn in place. Falls back to the line-based status updates when the output isn't a terminal",
+		Value:         &opts.WatchResources,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-changed-only",
+		Usage:         "When only images are rebuilt in a dev iteration, only wait for the resources using the rebuilt images during `status-check`",
+		Value:         &opts.StatusCheckChangedOnly,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-junit-output",
+		Usage:         "Write the `status-check` results to this file as a JUnit XML report, with a test case per deployed resource",
+		Value:         &opts.StatusCheckJUnitOutput,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "apply"},
+	},
+	{
+		Name:          "rollback-on-failure",
+		Usage:         "Roll back the deployments and statefulsets that fail `status-check` with a non-retriable error to their previous revision with `kubectl rollout undo`, and wait for the rollback to stabilize",
+		Value:         &opts.RollbackOnFailure,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"deploy", "run", "apply"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "status-check-only",
+		Usage:         "Don't deploy anything, only wait for the resources defined in the rendered manifests to stabilize, including resources deployed by other tools",
+		Value:         &opts.StatusCheckOnly,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"deploy"},
+		IsEnum:        true,
 	},
 	{
 		Name:          "render-only",
@@ -568,6 +744,22 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringSliceVar",
 		DefinedOn:     []string{"build", "run"},
 	},
+	{
+		Name:          "only",
+		Usage:         "Only build the artifacts with the given image names. Default is to build all artifacts",
+		Value:         &opts.OnlyArtifacts,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"build", "run"},
+	},
+	{
+		Name:          "skip",
+		Usage:         "Don't build the artifacts with the given image names",
+		Value:         &opts.SkipArtifacts,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"build", "run"},
+	},
 	{
 		Name:          "detect-minikube",
 		Usage:         "Use heuristics to detect a minikube cluster",
@@ -634,6 +826,63 @@ The build result from a previous 'skaffold build --file-output' run can be used
 		FlagAddMethod: "IntVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "test-concurrency",
+		Usage:         "Number of concurrently running test invocations. Set to 0 to use the number of CPUs. Tests of the same image always run in order.",
+		Value:         &opts.TestConcurrency,
+		DefValue:      0,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "test"},
+	},
+	{
+		Name:          "deploy-concurrency",
+		Usage:         "Number of modules of a multi-config project deployed concurrently. Modules that require other modules are only deployed once the modules before them are deployed and status checked.",
+		Value:         &opts.DeployConcurrency,
+		DefValue:      1,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "provenance-output",
+		Usage:         "Directory to write a SLSA provenance document to for each built image, keyed by image digest. Provenance is only generated for images with a digest.",
+		Value:         &opts.ProvenanceOutput,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"build", "run"},
+	},
+	{
+		Name:          "provenance-format",
+		Usage:         "Format of the provenance documents written with --provenance-output. One of 'slsa-v1' or 'slsa-v0.2'.",
+		Value:         &opts.ProvenanceFormat,
+		DefValue:      "slsa-v1",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"build", "run"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "sbom-output",
+		Usage:         "Directory to write a software bill of materials (SBOM) to for each built image. The location of each SBOM is recorded in the build output.",
+		Value:         &opts.SBOMOutput,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"build", "run"},
+	},
+	{
+		Name:          "sbom-command",
+		Usage:         "Command run with --sbom-output to print the SBOM of the image in the SKAFFOLD_IMAGE environment variable. Defaults to '" + sbom.DefaultCommand + "', with %SKAFFOLD_IMAGE% on Windows.",
+		Value:         &opts.SBOMCommand,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"build", "run"},
+	},
+	{
+		Name:          "sbom-attach",
+		Usage:         "Attach the SBOMs written with --sbom-output to the images in their registry with cosign. Requires the images to be pushed.",
+		Value:         &opts.SBOMAttach,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"build", "run"},
+	},
 	{
 		Name:          "digest-source",
 		Usage:         "Set to 'remote' to skip builds and resolve the digest of images by tag from the remote registry. Set to 'local' to build images locally and use digests from built images. Set to 'tag' to use tags directly from the build. Set to 'none' to use tags directly from the Kubernetes manifests. If unspecified, defaults to 'remote' for remote clusters, and 'tag' for local clusters like kind or minikube.",
@@ -672,6 +921,14 @@ The build result from a previous 'skaffold build --file-output' run can be used
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "render", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "transform-manifests-command",
+		Usage:         "Shell command that receives the manifests on stdin and writes the transformed manifests to stdout, run by the kubectl deployer right before they are applied",
+		Value:         &opts.TransformManifestsCommand,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
 	{
 		Name:          "docker-network",
 		Shorthand:     "",
@@ -771,6 +1028,14 @@ The build result from a previous 'skaffold build --file-output' run can be used
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"render"},
 	},
+	{
+		Name:          "values",
+		Usage:         "overrides templated manifest fields and helm chart values by YAML files of values, applied in order before --set-value-file and --set",
+		Value:         &opts.ValuesFiles,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"render"},
+	},
 	{
 		Name: "status-check-selectors",
 		Usage: `File containing resource selectors for kubernetes resources status check. A sample file looks like the following:
@@ -78,6 +78,37 @@ func TestAddFlagsSmoke(t *testing.T) {
 	}
 }
 
+func TestStatusCheckFailFastFlags(t *testing.T) {
+	tests := []struct {
+		description string
+		args        []string
+		expected    bool
+	}{
+		{
+			description: "fail fast by default",
+			expected:    true,
+		},
+		{
+			description: "report every resource",
+			args:        []string{"--status-check-fail-fast=false"},
+		},
+		{
+			description: "deprecated flag",
+			args:        []string{"--fast-fail-status-check=false"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&opts, config.SkaffoldOptions{})
+			cmd := &cobra.Command{Use: "run"}
+			AddFlags(cmd)
+
+			t.CheckNoError(cmd.ParseFlags(test.args))
+			t.CheckDeepEqual(test.expected, opts.FastFailStatusCheck)
+		})
+	}
+}
+
 func TestMakeFlag(t *testing.T) {
 	var v string
 	f := Flag{
@@ -17,8 +17,12 @@ limitations under the License.
 package cmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 )
 
 var inspectFlags = struct {
@@ -31,6 +35,9 @@ var inspectFlags = struct {
 	profile           string
 	propagateProfiles bool
 	strict            bool
+
+	statusCheck             config.BoolOrUndefined
+	statusCheckPollInterval time.Duration
 }{
 	filename:          "skaffold.yaml",
 	strict:            true,
@@ -44,7 +51,7 @@ func NewCmdInspect() *cobra.Command {
 		WithPersistentFlagAdder(cmdInspectFlags).
 		Hidden().
 		WithCommands(cmdModules(), cmdProfiles(), cmdBuildEnv(), cmdTests(), cmdNamespaces(),
-			cmdJobManifestPaths(), cmdExecutionModes(), cmdConfigDependencies())
+			cmdJobManifestPaths(), cmdExecutionModes(), cmdConfigDependencies(), cmdStatusCheck())
 }
 
 func cmdInspectFlags(f *pflag.FlagSet) {
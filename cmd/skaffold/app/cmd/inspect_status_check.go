@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/inspect"
+	statusCheck "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/inspect/statusCheck"
+)
+
+func cmdStatusCheck() *cobra.Command {
+	return NewCmd("status-check").
+		WithExample("Get the status check configuration", "inspect status-check --format json").
+		WithExample("Get the status check configuration of a specific profile", "inspect status-check --profile ci --format json").
+		WithDescription("Print the status check configuration of each module for a given configuration (default skaffold configuration, specific module, specific profile, etc).").
+		WithFlagAdder(cmdStatusCheckFlags).
+		NoArgs(printStatusCheck)
+}
+
+func printStatusCheck(ctx context.Context, out io.Writer) error {
+	return statusCheck.PrintStatusCheckConfig(ctx, out, inspect.Options{
+		Filename:          inspectFlags.filename,
+		RemoteCacheDir:    inspectFlags.remoteCacheDir,
+		OutFormat:         inspectFlags.outFormat,
+		Modules:           inspectFlags.modules,
+		Profiles:          inspectFlags.profiles,
+		PropagateProfiles: inspectFlags.propagateProfiles,
+		StatusCheckOptions: inspect.StatusCheckOptions{
+			StatusCheck:  inspectFlags.statusCheck,
+			PollInterval: inspectFlags.statusCheckPollInterval,
+		},
+	})
+}
+
+func cmdStatusCheckFlags(f *pflag.FlagSet) {
+	f.StringSliceVarP(&inspectFlags.profiles, "profile", "p", nil, `Profile names to activate`)
+	f.BoolVar(&inspectFlags.propagateProfiles, "propagate-profiles", true, `Setting '--propagate-profiles=false' disables propagating profiles set by the '--profile' flag across config dependencies. This mean that only profiles defined directly in the target 'skaffold.yaml' file are activated.`)
+	f.StringSliceVarP(&inspectFlags.modules, "module", "m", nil, "Names of modules to filter target action by.")
+	f.Var(&inspectFlags.statusCheck, "status-check", "Value of the `--status-check` flag of the deploy, which overrides the status check setting of the configs")
+	f.DurationVar(&inspectFlags.statusCheckPollInterval, "status-check-poll-interval", time.Second, "Value of the `--status-check-poll-interval` flag of the deploy")
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
 )
 
@@ -69,7 +70,12 @@ func doRender(ctx context.Context, out io.Writer) error {
 				return fmt.Errorf("loading artifacts: %w", err)
 			}
 		} else {
-			bRes, err = r.Build(ctx, buildOut, targetArtifacts(opts, configs))
+			var artifacts []*latest.Artifact
+			artifacts, err = targetArtifacts(opts, configs)
+			if err != nil {
+				return err
+			}
+			bRes, err = r.Build(ctx, buildOut, artifacts)
 			if err != nil {
 				return fmt.Errorf("executing build: %w", err)
 			}
@@ -43,7 +43,11 @@ func NewCmdRun() *cobra.Command {
 
 func doRun(ctx context.Context, out io.Writer) error {
 	return withRunner(ctx, out, func(r runner.Runner, configs []util.VersionedConfig) error {
-		bRes, err := r.Build(ctx, out, targetArtifacts(opts, configs))
+		artifacts, err := targetArtifacts(opts, configs)
+		if err != nil {
+			return err
+		}
+		bRes, err := r.Build(ctx, out, artifacts)
 		if err != nil {
 			return fmt.Errorf("failed to build: %w", err)
 		}
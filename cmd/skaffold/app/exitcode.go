@@ -19,18 +19,68 @@ package app
 import (
 	"errors"
 	"regexp"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// Exit codes of the status check failures, by failure class.
+const (
+	exitCodeStatusCheckTimeout    = 3
+	exitCodeStatusCheckImagePull  = 4
+	exitCodeStatusCheckCrash      = 5
+	exitCodeStatusCheckScheduling = 6
+	exitCodeStatusCheckCluster    = 7
 )
 
+// statusCheckExitCodes maps the status codes of the status check failures to their exit code.
+var statusCheckExitCodes = map[proto.StatusCode]int{
+	proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED:                     exitCodeStatusCheckTimeout,
+	proto.StatusCode_STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED: exitCodeStatusCheckTimeout,
+
+	proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:          exitCodeStatusCheckImagePull,
+	proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH: exitCodeStatusCheckImagePull,
+
+	proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    exitCodeStatusCheckCrash,
+	proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED: exitCodeStatusCheckCrash,
+	proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING: exitCodeStatusCheckCrash,
+	proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR: exitCodeStatusCheckCrash,
+	proto.StatusCode_STATUSCHECK_UNHEALTHY:            exitCodeStatusCheckCrash,
+
+	proto.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE:     exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE:       exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_NODE_NETWORK_UNAVAILABLE: exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_NODE_PID_PRESSURE:        exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE:       exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_NODE_UNREACHABLE:         exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_NODE_NOT_READY:           exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_FAILED_SCHEDULING:        exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE:    exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_POD_EVICTED:              exitCodeStatusCheckScheduling,
+	proto.StatusCode_STATUSCHECK_POD_PREEMPTED:            exitCodeStatusCheckScheduling,
+
+	proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR:   exitCodeStatusCheckCluster,
+	proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED:       exitCodeStatusCheckCluster,
+	proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR: exitCodeStatusCheckCluster,
+}
+
 type ExitCoder interface {
 	ExitCode() int
 }
 
 // ExitCode extracts the exit code from the error.
+// Status check failures exit with the code of their failure class.
 func ExitCode(err error) int {
 	var exitCoder ExitCoder
 	if errors.As(err, &exitCoder) {
 		return exitCoder.ExitCode()
 	}
+	var sErr sErrors.Error
+	if errors.As(err, &sErr) {
+		if code, found := statusCheckExitCodes[sErr.StatusCode()]; found {
+			return code
+		}
+	}
 	return 1
 }
 
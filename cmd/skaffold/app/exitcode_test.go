@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"testing"
 
+	sErrors "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
 
@@ -29,6 +31,28 @@ func TestExitCode(t *testing.T) {
 	testutil.CheckDeepEqual(t, 127, ExitCode(fmt.Errorf("wrapped: %w", invalidUsageError{err: fmt.Errorf("some error")})))
 }
 
+func TestStatusCheckExitCode(t *testing.T) {
+	tests := []struct {
+		code     proto.StatusCode
+		expected int
+	}{
+		{code: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, expected: 3},
+		{code: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, expected: 4},
+		{code: proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING, expected: 5},
+		{code: proto.StatusCode_STATUSCHECK_FAILED_SCHEDULING, expected: 6},
+		{code: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR, expected: 7},
+		{code: proto.StatusCode_STATUSCHECK_INTERNAL_ERROR, expected: 1},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.code.String(), func(t *testutil.T) {
+			err := sErrors.NewErrorWithStatusCode(&proto.ActionableErr{ErrCode: test.code, Message: "1/1 deployment(s) failed"})
+
+			t.CheckDeepEqual(test.expected, ExitCode(err))
+			t.CheckDeepEqual(test.expected, ExitCode(fmt.Errorf("%w; rolled back 1 resource(s)", err)))
+		})
+	}
+}
+
 func Test_extractInvalidUsageError(t *testing.T) {
 	tests := []struct {
 		name                string
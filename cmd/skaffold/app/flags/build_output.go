@@ -17,10 +17,15 @@ limitations under the License.
 package flags
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 )
@@ -32,8 +37,56 @@ type BuildOutputFileFlag struct {
 }
 
 // BuildOutput is the output of `skaffold build`.
+// Its json or yaml encoding can be passed to `--build-artifacts`.
 type BuildOutput struct {
 	Builds []graph.Artifact `json:"builds"`
+	// Builders maps the image names of the builds to the type of builder of their artifact, like `docker` or `jib`.
+	// They're encoded in the `builder` field of each build.
+	Builders map[string]string `json:"-"`
+	// Timestamp is the time the build completed.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// encodedBuildOutput is the json encoding of a BuildOutput, with the builder inlined in each build.
+type encodedBuildOutput struct {
+	Builds    []encodedBuild `json:"builds"`
+	Timestamp *time.Time     `json:"timestamp,omitempty"`
+}
+
+type encodedBuild struct {
+	graph.Artifact
+	Builder string `json:"builder,omitempty"`
+}
+
+// MarshalJSON encodes the builder of each build in its `builder` field.
+func (b BuildOutput) MarshalJSON() ([]byte, error) {
+	encoded := encodedBuildOutput{Timestamp: b.Timestamp}
+	if b.Builds != nil {
+		encoded.Builds = []encodedBuild{}
+	}
+	for _, a := range b.Builds {
+		encoded.Builds = append(encoded.Builds, encodedBuild{Artifact: a, Builder: b.Builders[a.ImageName]})
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON reads the builder of each build from its `builder` field.
+func (b *BuildOutput) UnmarshalJSON(data []byte) error {
+	var encoded encodedBuildOutput
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	*b = BuildOutput{Timestamp: encoded.Timestamp}
+	for _, a := range encoded.Builds {
+		b.Builds = append(b.Builds, a.Artifact)
+		if a.Builder != "" {
+			if b.Builders == nil {
+				b.Builders = map[string]string{}
+			}
+			b.Builders[a.ImageName] = a.Builder
+		}
+	}
+	return nil
 }
 
 func (t *BuildOutputFileFlag) String() string {
@@ -42,7 +95,7 @@ func (t *BuildOutputFileFlag) String() string {
 
 // Usage Implements Usage() method for pflag interface
 func (t *BuildOutputFileFlag) Usage() string {
-	return "Input file with json encoded BuildOutput e.g.`skaffold build -q -o >build.out`"
+	return "Input file with json or yaml encoded BuildOutput e.g.`skaffold build -q -o >build.out`"
 }
 
 // Set Implements Set() method for pflag interface
@@ -91,10 +144,13 @@ func NewBuildOutputFileFlag(value string) *BuildOutputFileFlag {
 	}
 }
 
-// ParseBuildOutput parses BuildOutput from bytes
+// ParseBuildOutput parses a json or yaml encoded BuildOutput from bytes
 func ParseBuildOutput(b []byte) (*BuildOutput, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, errors.New("empty build output")
+	}
 	buildOutput := &BuildOutput{}
-	if err := json.Unmarshal(b, buildOutput); err != nil {
+	if err := yaml.Unmarshal(b, buildOutput); err != nil {
 		return nil, err
 	}
 	return buildOutput, nil
@@ -18,6 +18,7 @@ package flags
 
 import (
 	"testing"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
@@ -32,6 +33,7 @@ func TestNewBuildOutputFlag(t *testing.T) {
 }
 
 func TestBuildOutputSet(t *testing.T) {
+	timestamp := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
 	tests := []struct {
 		description         string
 		files               map[string]string
@@ -61,6 +63,25 @@ func TestBuildOutputSet(t *testing.T) {
 				}},
 			},
 		},
+		{
+			description: "set returns correct build output format for yaml",
+			files: map[string]string{
+				"test.in": `builds:
+- imageName: gcr.io/k8s/test1
+  tag: gcr.io/k8s/test1:v1@sha256:foo
+  builder: docker
+timestamp: "2026-01-02T03:04:05Z"
+`,
+			},
+			expectedBuildOutput: BuildOutput{
+				Builds: []graph.Artifact{{
+					ImageName: "gcr.io/k8s/test1",
+					Tag:       "gcr.io/k8s/test1:v1@sha256:foo",
+				}},
+				Builders:  map[string]string{"gcr.io/k8s/test1": "docker"},
+				Timestamp: &timestamp,
+			},
+		},
 		{
 			description: "set errors with in-correct build output format",
 			files: map[string]string{
@@ -112,5 +133,6 @@ func TestBuildOutputSetStdin(t *testing.T) {
 				Tag:       "sha256@foo",
 			}},
 		}, flag.buildOutput)
+		t.CheckDeepEqual([]graph.Artifact{{ImageName: "gcr.io/k8s/test1", Tag: "sha256@foo"}}, flag.BuildArtifacts())
 	})
 }
@@ -180,6 +180,14 @@ func yamlFieldName(field *ast.Field) string {
 	return strings.Split(yamlTag, ",")[0]
 }
 
+// isInline returns whether the field is inlined, with either a `yaml:",inline"` or a `yaml:"inline"` tag.
+func isInline(field *ast.Field) bool {
+	tag := strings.ReplaceAll(field.Tag.Value, "`", "")
+	yamlTag := reflect.StructTag(tag).Get("yaml")
+
+	return yamlTag == "inline" || strings.Contains(yamlTag, ",inline")
+}
+
 //nolint:golint,goconst
 func setTypeOrRef(def *Definition, typeName string) {
 	switch typeName {
@@ -238,7 +246,7 @@ func (g *schemaGenerator) newDefinition(name string, t ast.Expr, comment string,
 		for _, field := range tt.Fields.List {
 			yamlName := yamlFieldName(field)
 
-			if strings.Contains(field.Tag.Value, "inline") {
+			if isInline(field) {
 				def.PreferredOrder = append(def.PreferredOrder, "<inline>")
 				def.inlines = append(def.inlines, &Definition{
 					Ref:      defPrefix + field.Type.(*ast.Ident).Name,
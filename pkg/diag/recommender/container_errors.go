@@ -41,6 +41,11 @@ func (r ContainerError) Make(errCode proto.StatusCode) *proto.Suggestion {
 			SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_IMAGE,
 			Action:         "Try checking container config `image`",
 		}
+	case proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH:
+		return &proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM,
+			Action:         "Try rebuilding the image for the cluster node platform with `--platform`",
+		}
 	}
 	return &NilSuggestion
 }
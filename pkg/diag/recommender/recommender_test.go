@@ -56,6 +56,14 @@ func TestContainerErrorMake(t *testing.T) {
 				Action:         "Try checking container config `image`",
 			},
 		},
+		{
+			description: "makes err suggestion for image platform mismatch (307)",
+			errCode:     proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH,
+			expected: &proto.Suggestion{
+				SuggestionCode: proto.SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM,
+				Action:         "Try rebuilding the image for the cluster node platform with `--platform`",
+			},
+		},
 		{
 			description: "returns nil suggestion if no case matches",
 			errCode:     proto.StatusCode_BUILD_CANCELLED,
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestReplicaSetPodsSelector(t *testing.T) {
+	owned := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "owned",
+			Namespace:       "test",
+			OwnerReferences: []metav1.OwnerReference{{UID: "rs-uid", Controller: truePtr()}},
+		},
+		TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+	}
+	tests := []struct {
+		description  string
+		allPods      []v1.Pod
+		expectedPods []v1.Pod
+	}{
+		{
+			description:  "replicaset pods",
+			allPods:      []v1.Pod{owned},
+			expectedPods: []v1.Pod{owned},
+		},
+		{
+			description: "pods of another controller",
+			allPods: []v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "other",
+					Namespace:       "test",
+					OwnerReferences: []metav1.OwnerReference{{UID: "other-uid", Controller: truePtr()}},
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+			}, owned},
+			expectedPods: []v1.Pod{owned},
+		},
+		{
+			description: "standalone pods",
+			allPods: []v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "test"},
+				TypeMeta:   metav1.TypeMeta{Kind: "Pod"},
+			}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var objs []runtime.Object
+			for i := range test.allPods {
+				objs = append(objs, &test.allPods[i])
+			}
+			f := fakekubeclientset.NewSimpleClientset(objs...)
+			s := NewReplicaSetPodsSelector(f, appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{UID: "rs-uid"}})
+			actualPods, err := s.Select(context.Background(), "test", metav1.ListOptions{})
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedPods, actualPods)
+		})
+	}
+}
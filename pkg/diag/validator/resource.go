@@ -52,6 +52,12 @@ func (r Resource) StatusUpdated(another Resource) bool {
 	return r.ae.ErrCode != another.ae.ErrCode
 }
 
+// WithLogs returns a copy of the resource with the logs.
+func (r Resource) WithLogs(logs []string) Resource {
+	r.logs = logs
+	return r
+}
+
 // NewResource creates new Resource of kind
 func NewResource(namespace, kind, name string, status Status, ae *proto.ActionableErr, logs []string) Resource {
 	return Resource{namespace: namespace, kind: kind, name: name, status: status, ae: ae, logs: logs}
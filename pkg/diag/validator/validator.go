@@ -17,13 +17,16 @@ limitations under the License.
 package validator
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os/exec"
 	"regexp"
 	"strings"
+	"text/template"
 
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -53,11 +56,33 @@ const (
 	podInitializing            = "PodInitializing"
 	podKind                    = "pod"
 
-	failedScheduling = "FailedScheduling"
-	unhealthy        = "Unhealthy"
-	execFmtError     = "exec format error"
+	failedScheduling     = "FailedScheduling"
+	unhealthy            = "Unhealthy"
+	failed               = "Failed"
+	readinessProbeFailed = "Readiness probe failed:"
+	execFmtError         = "exec format error"
+
+	// grpcHealthNotImplemented is reported by the kubelet gRPC probe when the container doesn't serve grpc.health.v1.Health.
+	grpcHealthNotImplemented = "does not implement the grpc health protocol"
+
+	evicted    = "Evicted"
+	preempting = "Preempting"
 )
 
+// containerGoneErrors are the kubectl logs errors reported when the pod or its container was already removed.
+var containerGoneErrors = []string{
+	"not found",
+	"unable to retrieve container logs",
+}
+
+// platformMismatchErrors are the docker and containerd image pull errors reported when
+// the image has no manifest for the node platform.
+var platformMismatchErrors = []string{
+	"no matching manifest for",
+	"no match for platform in manifest",
+	"does not match the specified platform",
+}
+
 var (
 	runContainerRe = regexp.MustCompile(errorPrefix)
 	taintsRe       = regexp.MustCompile(taintsExp)
@@ -79,6 +104,7 @@ type PodValidator struct {
 	k           kubernetes.Interface
 	podSelector PodSelector
 	recos       []Recommender
+	logPrefix   logPrefix
 }
 
 // NewPodValidator initializes a PodValidator
@@ -87,6 +113,21 @@ func NewPodValidator(k kubernetes.Interface, s PodSelector) *PodValidator {
 	return &PodValidator{k: k, recos: rs, podSelector: s}
 }
 
+// WithLogPrefix sets the Go template of the prefix of the container log lines reported for failing pods.
+// An empty or invalid template keeps the default `[pod container]` prefix.
+func (p *PodValidator) WithLogPrefix(prefixTemplate string) *PodValidator {
+	if prefixTemplate == "" {
+		return p
+	}
+	tmpl, err := template.New("statusCheckLogPrefix").Option("missingkey=zero").Parse(prefixTemplate)
+	if err != nil {
+		log.Entry(context.TODO()).Warnf("could not parse status check log prefix template %q: %v", prefixTemplate, err)
+		return p
+	}
+	p.logPrefix = logPrefix{tmpl: tmpl}
+	return p
+}
+
 // Validate implements the Validate method for Validator interface
 func (p *PodValidator) Validate(ctx context.Context, ns string, opts metav1.ListOptions) ([]Resource, error) {
 	pods, err := p.podSelector.Select(ctx, ns, opts)
@@ -110,28 +151,58 @@ func (p *PodValidator) Validate(ctx context.Context, ns string, opts metav1.List
 				updated.ae.Suggestions = append(updated.ae.Suggestions, s)
 			}
 		}
+		if updated.ae.ErrCode == proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH {
+			p.suggestNodePlatform(ctx, po, updated.ae.Suggestions)
+		}
 		rs = append(rs, NewResourceFromObject(&po, Status(updated.phase), &updated.ae, updated.logs))
 	}
 	return rs, nil
 }
 
+// suggestNodePlatform completes the suggestion to rebuild the image with the platform of the node the pod is scheduled on.
+func (p *PodValidator) suggestNodePlatform(ctx context.Context, pod v1.Pod, suggestions []*proto.Suggestion) {
+	if pod.Spec.NodeName == "" {
+		return
+	}
+	node, err := p.k.CoreV1().Nodes().Get(ctx, pod.Spec.NodeName, metav1.GetOptions{})
+	if err != nil {
+		log.Entry(ctx).Debugf("could not get node %q of pod %q: %v", pod.Spec.NodeName, pod.Name, err)
+		return
+	}
+	info := node.Status.NodeInfo
+	if info.OperatingSystem == "" || info.Architecture == "" {
+		return
+	}
+	for _, s := range suggestions {
+		if s.SuggestionCode == proto.SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM {
+			s.Action = fmt.Sprintf("Try rebuilding the image for the node platform with `--platform=%s/%s`", info.OperatingSystem, info.Architecture)
+		}
+	}
+}
+
 func (p *PodValidator) getPodStatus(pod *v1.Pod) *podStatus {
 	ps := newPodStatus(pod.Name, pod.Namespace, string(pod.Status.Phase))
 	switch pod.Status.Phase {
 	case v1.PodSucceeded:
 		return ps
 	default:
-		return ps.withErrAndLogs(getPodStatus(pod))
+		return ps.withErrAndLogs(getPodStatus(pod, p.logPrefix))
 	}
 }
 
-func getPodStatus(pod *v1.Pod) (proto.StatusCode, []string, error) {
+func getPodStatus(pod *v1.Pod, prefix logPrefix) (proto.StatusCode, []string, error) {
 	// See https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-conditions
 
 	// If the event type PodReady with status True is found then we return success immediately
 	if isPodReady(pod) {
 		return proto.StatusCode_STATUSCHECK_SUCCESS, nil, nil
 	}
+	// Evicted and preempted pods are replaced by their controller, so they are reported with a transient status
+	// rather than with the error of their terminated containers.
+	if sc, msg, ok := isPodDisrupted(pod); ok {
+		log.Entry(context.TODO()).Debugf("Pod %q disrupted: %s", pod.Name, msg)
+		return sc, nil, errors.New(msg)
+	}
 	// If the event type PodScheduled with status False is found then we check if it is due to taints and tolerations.
 	if c, ok := isPodNotScheduled(pod); ok {
 		log.Entry(context.TODO()).Debugf("Pod %q not scheduled: checking tolerations", pod.Name)
@@ -145,14 +216,14 @@ func getPodStatus(pod *v1.Pod) (proto.StatusCode, []string, error) {
 		// TODO(dgageot): Add EphemeralContainerStatuses
 		cs := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
 		// See https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-states
-		statusCode, logs, err := getContainerStatus(pod, cs)
+		statusCode, logs, err := getContainerStatus(pod, cs, prefix)
 		if statusCode == proto.StatusCode_STATUSCHECK_POD_INITIALIZING {
 			// Determine if an init container is still running and fetch the init logs.
 			for _, c := range pod.Status.InitContainerStatuses {
 				if c.State.Waiting != nil {
 					return statusCode, []string{}, fmt.Errorf("waiting for init container %s to start", c.Name)
 				} else if c.State.Running != nil {
-					sc, l := getPodLogs(pod, c.Name, statusCode)
+					sc, l := getPodLogs(pod, c.Name, statusCode, prefix)
 					return sc, l, fmt.Errorf("waiting for init container %s to complete", c.Name)
 				}
 			}
@@ -187,6 +258,34 @@ func isPodNotScheduled(pod *v1.Pod) (v1.PodCondition, bool) {
 	return v1.PodCondition{}, false
 }
 
+// isPodDisrupted returns the status code and message of a pod that was evicted from its node or preempted.
+func isPodDisrupted(pod *v1.Pod) (proto.StatusCode, string, bool) {
+	switch pod.Status.Reason {
+	case evicted:
+		return proto.StatusCode_STATUSCHECK_POD_EVICTED, disruptionMessage("evicted", pod.Status.Message), true
+	case preempting:
+		return proto.StatusCode_STATUSCHECK_POD_PREEMPTED, disruptionMessage("preempted by a higher-priority workload", ""), true
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type != v1.DisruptionTarget || c.Status != v1.ConditionTrue {
+			continue
+		}
+		if c.Reason == v1.PodReasonPreemptionByScheduler {
+			return proto.StatusCode_STATUSCHECK_POD_PREEMPTED, disruptionMessage("preempted by a higher-priority workload", ""), true
+		}
+		return proto.StatusCode_STATUSCHECK_POD_EVICTED, disruptionMessage("evicted", c.Message), true
+	}
+	return proto.StatusCode_STATUSCHECK_SUCCESS, "", false
+}
+
+func disruptionMessage(what string, detail string) string {
+	msg := "pod was " + what
+	if detail != "" {
+		msg += ": " + strings.TrimSuffix(detail, ".")
+	}
+	return msg + ". This is transient if the pod is managed by a controller, which replaces it"
+}
+
 func isPodScheduledButNotReady(pod *v1.Pod) bool {
 	for _, c := range pod.Status.Conditions {
 		if c.Type == v1.PodScheduled && c.Status == v1.ConditionTrue {
@@ -211,14 +310,14 @@ func isPodStatusUnknown(pod *v1.Pod) (v1.PodCondition, bool) {
 	return v1.PodCondition{}, false
 }
 
-func getContainerStatus(po *v1.Pod, cs []v1.ContainerStatus) (proto.StatusCode, []string, error) {
+func getContainerStatus(po *v1.Pod, cs []v1.ContainerStatus, prefix logPrefix) (proto.StatusCode, []string, error) {
 	// See https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-states
 	for _, c := range cs {
 		switch {
 		case c.State.Waiting != nil:
-			return extractErrorMessageFromWaitingContainerStatus(po, c)
+			return extractErrorMessageFromWaitingContainerStatus(po, c, prefix)
 		case c.State.Terminated != nil && c.State.Terminated.ExitCode != 0:
-			sc, l := getPodLogs(po, c.Name, proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
+			sc, l := getPodLogs(po, c.Name, proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED, prefix)
 			return sc, l, fmt.Errorf("container %s terminated with exit code %d", c.Name, c.State.Terminated.ExitCode)
 		}
 	}
@@ -303,7 +402,16 @@ func processPodEvents(e corev1.EventInterface, pod v1.Pod, ps *podStatus) *podSt
 	case failedScheduling:
 		updated.updateAE(proto.StatusCode_STATUSCHECK_FAILED_SCHEDULING, recentEvent.Message)
 	case unhealthy:
-		updated.updateAE(proto.StatusCode_STATUSCHECK_UNHEALTHY, recentEvent.Message)
+		updated.updateAE(proto.StatusCode_STATUSCHECK_UNHEALTHY, describeReadinessProbeFailure(pod, recentEvent))
+	case failed:
+		if isPlatformMismatch(recentEvent.Message) {
+			updated.updateAE(proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH, recentEvent.Message)
+			break
+		}
+		updated.updateAE(
+			proto.StatusCode_STATUSCHECK_UNKNOWN_EVENT,
+			fmt.Sprintf("%s: %s", recentEvent.Reason, recentEvent.Message),
+		)
 	default:
 		// TODO: Add unique error codes for reasons
 		updated.updateAE(
@@ -354,7 +462,7 @@ func (p *podStatus) String() string {
 	return fmt.Sprintf(actionableMessage, p.namespace, p.name)
 }
 
-func extractErrorMessageFromWaitingContainerStatus(po *v1.Pod, c v1.ContainerStatus) (proto.StatusCode, []string, error) {
+func extractErrorMessageFromWaitingContainerStatus(po *v1.Pod, c v1.ContainerStatus, prefix logPrefix) (proto.StatusCode, []string, error) {
 	// Extract meaning full error out of container statuses.
 	switch c.State.Waiting.Reason {
 	case podInitializing:
@@ -365,9 +473,12 @@ func extractErrorMessageFromWaitingContainerStatus(po *v1.Pod, c v1.ContainerSta
 		return proto.StatusCode_STATUSCHECK_CONTAINER_CREATING, nil, fmt.Errorf("creating container %s", c.Name)
 	case crashLoopBackOff:
 		// TODO, in case of container restarting, return the original failure reason due to which container failed.
-		sc, l := getPodLogs(po, c.Name, proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
+		sc, l := getPodLogs(po, c.Name, proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING, prefix)
 		return sc, l, fmt.Errorf("container %s is backing off waiting to restart", c.Name)
 	case ImagePullErr, ImagePullBackOff, ErrImagePullBackOff:
+		if isPlatformMismatch(c.State.Waiting.Message) {
+			return proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH, nil, fmt.Errorf("container %s is waiting to start: %s has no manifest for the node platform: %s", c.Name, c.Image, trimSpace(c.State.Waiting.Message))
+		}
 		return proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, nil, fmt.Errorf("container %s is waiting to start: %s can't be pulled", c.Name, c.Image)
 	case runContainerError:
 		match := runContainerRe.FindStringSubmatch(c.State.Waiting.Message)
@@ -379,6 +490,88 @@ func extractErrorMessageFromWaitingContainerStatus(po *v1.Pod, c v1.ContainerSta
 	return proto.StatusCode_STATUSCHECK_CONTAINER_WAITING_UNKNOWN, nil, fmt.Errorf("container %s in error: %v", c.Name, c.State.Waiting)
 }
 
+// describeReadinessProbeFailure adds the failing readiness probe config to the kubelet probe failure message,
+// e.g. "readiness probe GET /healthz:8080 failing: HTTP probe failed with statuscode: 503".
+func describeReadinessProbeFailure(pod v1.Pod, e *v1.Event) string {
+	if !strings.HasPrefix(e.Message, readinessProbeFailed) {
+		return e.Message
+	}
+	probe := readinessProbe(pod, e.InvolvedObject.FieldPath)
+	if probe == nil {
+		return e.Message
+	}
+	reason := trimSpace(strings.TrimPrefix(e.Message, readinessProbeFailed))
+	if probe.GRPC != nil {
+		reason = describeGRPCProbeFailure(probe.GRPC, reason)
+	}
+	return fmt.Sprintf("readiness probe %s failing: %s", describeProbe(probe), reason)
+}
+
+// describeGRPCProbeFailure explains the kubelet gRPC probe failures caused by the health service of the container,
+// e.g. "service unhealthy (responded with \"SERVICE_UNKNOWN\")".
+func describeGRPCProbeFailure(g *v1.GRPCAction, reason string) string {
+	var service string
+	if g.Service != nil {
+		service = *g.Service
+	}
+	switch {
+	case strings.Contains(reason, grpcHealthNotImplemented):
+		return fmt.Sprintf("%s: the container doesn't serve the gRPC health checking protocol on port %d", reason, g.Port)
+	case strings.Contains(reason, `responded with "SERVICE_UNKNOWN"`):
+		return fmt.Sprintf("%s: the gRPC health server doesn't know the service %q", reason, service)
+	case strings.Contains(reason, `responded with "NOT_SERVING"`) && service != "":
+		return fmt.Sprintf("%s: the gRPC health server reports the service %q as not serving", reason, service)
+	case strings.Contains(reason, `responded with "NOT_SERVING"`):
+		return fmt.Sprintf("%s: the gRPC health server reports the server as not serving", reason)
+	}
+	return reason
+}
+
+// readinessProbe returns the readiness probe of the container referenced by the event field path `spec.containers{name}`,
+// or the only readiness probe of the pod if the event doesn't reference a container.
+func readinessProbe(pod v1.Pod, fieldPath string) *v1.Probe {
+	var probes []*v1.Probe
+	for _, c := range pod.Spec.Containers {
+		if c.ReadinessProbe == nil {
+			continue
+		}
+		if fieldPath == fmt.Sprintf("spec.containers{%s}", c.Name) {
+			return c.ReadinessProbe
+		}
+		probes = append(probes, c.ReadinessProbe)
+	}
+	if fieldPath == "" && len(probes) == 1 {
+		return probes[0]
+	}
+	return nil
+}
+
+func describeProbe(p *v1.Probe) string {
+	switch {
+	case p.HTTPGet != nil:
+		return fmt.Sprintf("GET %s:%s", p.HTTPGet.Path, p.HTTPGet.Port.String())
+	case p.TCPSocket != nil:
+		return fmt.Sprintf("tcp :%s", p.TCPSocket.Port.String())
+	case p.GRPC != nil:
+		if p.GRPC.Service != nil && *p.GRPC.Service != "" {
+			return fmt.Sprintf("grpc :%d service %q", p.GRPC.Port, *p.GRPC.Service)
+		}
+		return fmt.Sprintf("grpc :%d", p.GRPC.Port)
+	case p.Exec != nil:
+		return fmt.Sprintf("exec %q", strings.Join(p.Exec.Command, " "))
+	}
+	return ""
+}
+
+func isPlatformMismatch(msg string) bool {
+	for _, e := range platformMismatchErrors {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+	return false
+}
+
 func newPodStatus(n string, ns string, p string) *podStatus {
 	return &podStatus{
 		name:      n,
@@ -394,11 +587,23 @@ func trimSpace(msg string) string {
 	return strings.Trim(msg, " ")
 }
 
-func getPodLogs(po *v1.Pod, c string, sc proto.StatusCode) (proto.StatusCode, []string) {
+func getPodLogs(po *v1.Pod, c string, sc proto.StatusCode, prefix logPrefix) (proto.StatusCode, []string) {
 	log.Entry(context.TODO()).Debugf("Fetching logs for container %s/%s", po.Name, c)
 	logCommand := []string{"kubectl", "logs", po.Name, "-n", po.Namespace, "-c", c}
 	logs, err := runCli(logCommand[0], logCommand[1:])
+	// a restarted container has no logs yet, and the logs of a terminated container may be gone:
+	// fall back to the logs of the previous instance of the container.
+	if err != nil || strings.TrimSpace(string(logs)) == "" {
+		previousCommand := []string{"kubectl", "logs", po.Name, "-n", po.Namespace, "-c", c, "--previous"}
+		if previous, prevErr := runCli(previousCommand[0], previousCommand[1:]); prevErr == nil && strings.TrimSpace(string(previous)) != "" {
+			logs, err = previous, nil
+		}
+	}
 	if err != nil {
+		if isContainerGone(string(logs)) {
+			log.Entry(context.TODO()).Debugf("Logs of container %s/%s are no longer available: %s", po.Name, c, strings.TrimSpace(string(logs)))
+			return sc, nil
+		}
 		return sc, []string{fmt.Sprintf("Error retrieving logs for pod %s: %s.\nTry `%s`", po.Name, err, strings.Join(logCommand, " "))}
 	}
 	if strings.Contains(string(logs), execFmtError) {
@@ -407,15 +612,72 @@ func getPodLogs(po *v1.Pod, c string, sc proto.StatusCode) (proto.StatusCode, []
 	output := strings.Split(string(logs), "\n")
 	// remove spurious empty lines (empty string or from trailing newline)
 	lines := make([]string, 0, len(output))
+	p := prefix.format(po, c)
 	for _, s := range output {
 		if s == "" {
 			continue
 		}
-		lines = append(lines, fmt.Sprintf("[%s %s] %s", po.Name, c, s))
+		lines = append(lines, fmt.Sprintf("%s %s", p, s))
 	}
 	return sc, lines
 }
 
+// logPrefix formats the prefix of the container log lines, `[pod container]` unless a template is set.
+type logPrefix struct {
+	tmpl *template.Template
+}
+
+// logPrefixFields are the fields available to the log prefix template.
+type logPrefixFields struct {
+	Pod       string
+	Container string
+	Namespace string
+	Owner     string
+	Labels    map[string]string
+}
+
+func (l logPrefix) format(po *v1.Pod, c string) string {
+	def := fmt.Sprintf("[%s %s]", po.Name, c)
+	if l.tmpl == nil {
+		return def
+	}
+	var buf bytes.Buffer
+	if err := l.tmpl.Execute(&buf, logPrefixFields{
+		Pod:       po.Name,
+		Container: c,
+		Namespace: po.Namespace,
+		Owner:     podOwner(po),
+		Labels:    po.Labels,
+	}); err != nil {
+		log.Entry(context.TODO()).Debugf("Could not apply the log prefix template to container %s/%s: %v", po.Name, c, err)
+		return def
+	}
+	return buf.String()
+}
+
+// podOwner returns the workload owning the pod, e.g. `deployment/web`. The deployment of a pod owned by
+// a replicaset is derived from the name of the replicaset, which is suffixed with the pod template hash.
+func podOwner(po *v1.Pod) string {
+	owner := metav1.GetControllerOf(po)
+	if owner == nil {
+		return "pod/" + po.Name
+	}
+	if hash := po.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; owner.Kind == "ReplicaSet" && hash != "" && strings.HasSuffix(owner.Name, "-"+hash) {
+		return "deployment/" + strings.TrimSuffix(owner.Name, "-"+hash)
+	}
+	return strings.ToLower(owner.Kind) + "/" + owner.Name
+}
+
+// isContainerGone returns whether kubectl failed to retrieve logs because the pod or its container was already removed.
+func isContainerGone(output string) bool {
+	for _, e := range containerGoneErrors {
+		if strings.Contains(output, e) {
+			return true
+		}
+	}
+	return false
+}
+
 func executeCLI(cmdName string, args []string) ([]byte, error) {
 	cmd := exec.Command(cmdName, args...)
 	return cmd.CombinedOutput()
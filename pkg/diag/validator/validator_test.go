@@ -30,11 +30,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	appsclient "k8s.io/client-go/kubernetes/typed/apps/v1"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag/recommender"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
@@ -51,8 +53,11 @@ func TestRun(t *testing.T) {
 		uid         string
 		pods        []*v1.Pod
 		logOutput   mockLogOutput
-		events      []v1.Event
-		expected    []Resource
+		// previousLogOutput is the output of `kubectl logs --previous`.
+		previousLogOutput mockLogOutput
+		events            []v1.Event
+		nodes             []*v1.Node
+		expected          []Resource
 	}{
 		{
 			description: "pod don't exist in test namespace",
@@ -178,6 +183,46 @@ func TestRun(t *testing.T) {
 					}},
 				}, nil)},
 		},
+		{
+			description: "pod is Waiting due to an image with no manifest for the node platform",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Spec:     v1.PodSpec{NodeName: "node-1"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodPending,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							Image: "foo-image",
+							State: v1.ContainerState{
+								Waiting: &v1.ContainerStateWaiting{
+									Reason:  "ErrImagePull",
+									Message: "rpc error: code = Unknown desc = Error response from daemon: no matching manifest for linux/arm64/v8 in the manifest list entries",
+								},
+							},
+						},
+					},
+				},
+			}},
+			nodes: []*v1.Node{{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status:     v1.NodeStatus{NodeInfo: v1.NodeSystemInfo{OperatingSystem: "linux", Architecture: "arm64"}},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				&proto.ActionableErr{
+					Message: "container foo-container is waiting to start: foo-image has no manifest for the node platform: rpc error: code = Unknown desc = Error response from daemon: no matching manifest for linux/arm64/v8 in the manifest list entries",
+					ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM,
+						Action:         "Try rebuilding the image for the node platform with `--platform=linux/arm64`",
+					}},
+				}, nil)},
+		},
 		{
 			description: "pod is in Terminated State",
 			pods: []*v1.Pod{{
@@ -429,6 +474,77 @@ func TestRun(t *testing.T) {
 				},
 			)},
 		},
+		{
+			description: "container restarted without logs yet shows the logs of the previous container",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodRunning,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}},
+						},
+					},
+				},
+			}},
+			previousLogOutput: mockLogOutput{
+				output: []byte("go panic\n"),
+			},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Running",
+				&proto.ActionableErr{
+					Message: "container foo-container terminated with exit code 1",
+					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_LOGS,
+						Action:         "Try checking container logs",
+					}},
+				}, []string{"[foo foo-container] go panic"},
+			)},
+		},
+		{
+			description: "container already gone",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodRunning,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}},
+						},
+					},
+				},
+			}},
+			logOutput: mockLogOutput{
+				output: []byte(`Error from server (NotFound): pods "foo" not found`),
+				err:    fmt.Errorf("exit status 1"),
+			},
+			previousLogOutput: mockLogOutput{
+				output: []byte(`Error from server (NotFound): pods "foo" not found`),
+				err:    fmt.Errorf("exit status 1"),
+			},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Running",
+				&proto.ActionableErr{
+					Message: "container foo-container terminated with exit code 1",
+					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_LOGS,
+						Action:         "Try checking container logs",
+					}},
+				}, nil,
+			)},
+		},
 		{
 			description: "pod is running but container terminated but could not retrieve logs",
 			pods: []*v1.Pod{{
@@ -598,6 +714,39 @@ func TestRun(t *testing.T) {
 					ErrCode: proto.StatusCode_STATUSCHECK_FAILED_SCHEDULING,
 				}, nil)},
 		},
+		{
+			description: "image pull failed with no manifest for the node platform",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase: v1.PodPending,
+					Conditions: []v1.PodCondition{{
+						Type:   v1.PodScheduled,
+						Status: v1.ConditionTrue,
+					}},
+				},
+			}},
+			events: []v1.Event{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "two", Namespace: "test"}, Reason: "Failed", Type: "Warning",
+					Message:       "Failed to pull image \"foo-image\": failed to pull and unpack image \"docker.io/library/foo-image:latest\": no match for platform in manifest: not found",
+					LastTimestamp: metav1.Time{Time: after},
+				},
+			},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				&proto.ActionableErr{
+					Message: "Failed to pull image \"foo-image\": failed to pull and unpack image \"docker.io/library/foo-image:latest\": no match for platform in manifest: not found",
+					ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM,
+						Action:         "Try rebuilding the image for the cluster node platform with `--platform`",
+					}},
+				}, nil)},
+		},
 		{
 			description: "health check failed",
 			pods: []*v1.Pod{{
@@ -633,6 +782,50 @@ func TestRun(t *testing.T) {
 					},
 				}, nil)},
 		},
+		{
+			description: "health check failed with readiness probe config",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name: "foo-container",
+						ReadinessProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{
+							HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+						}},
+					}},
+				},
+				Status: v1.PodStatus{
+					Phase: v1.PodRunning,
+					Conditions: []v1.PodCondition{{
+						Type:   v1.PodScheduled,
+						Status: v1.ConditionTrue,
+					}},
+				},
+			}},
+			events: []v1.Event{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "two", Namespace: "test"}, Reason: "Unhealthy", Type: "Warning",
+					InvolvedObject: v1.ObjectReference{FieldPath: "spec.containers{foo-container}"},
+					Message:        "Readiness probe failed: HTTP probe failed with statuscode: 503",
+					EventTime:      metav1.MicroTime{Time: after},
+				},
+			},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Running",
+				&proto.ActionableErr{
+					Message: "readiness probe GET /healthz:8080 failing: HTTP probe failed with statuscode: 503",
+					ErrCode: proto.StatusCode_STATUSCHECK_UNHEALTHY,
+					Suggestions: []*proto.Suggestion{
+						{
+							SuggestionCode: proto.SuggestionCode_CHECK_READINESS_PROBE,
+							Action:         "Try checking container config `readinessProbe`",
+						},
+					},
+				}, nil)},
+		},
 		{
 			description: "One of the pod containers is in Terminated State with non zero exit code followed by Waiting state",
 			pods: []*v1.Pod{{
@@ -745,6 +938,58 @@ func TestRun(t *testing.T) {
 					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR,
 				}, []string{"[foo foo-container] standard_init_linux.go:219: exec user process caused: exec format error"})},
 		},
+		{
+			description: "pod evicted from its node",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodFailed,
+					Reason:     "Evicted",
+					Message:    "The node was low on resource: memory.",
+					Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							Image: "foo-image",
+							State: v1.ContainerState{
+								Terminated: &v1.ContainerStateTerminated{ExitCode: 137},
+							},
+						},
+					},
+				},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Failed",
+				&proto.ActionableErr{
+					Message: "pod was evicted: The node was low on resource: memory. This is transient if the pod is managed by a controller, which replaces it",
+					ErrCode: proto.StatusCode_STATUSCHECK_POD_EVICTED,
+				}, nil)},
+		},
+		{
+			description: "pod preempted by the scheduler",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase: v1.PodRunning,
+					Conditions: []v1.PodCondition{
+						{Type: v1.PodReady, Status: v1.ConditionFalse},
+						{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: "PreemptionByScheduler"},
+					},
+				},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Running",
+				&proto.ActionableErr{
+					Message: "pod was preempted by a higher-priority workload. This is transient if the pod is managed by a controller, which replaces it",
+					ErrCode: proto.StatusCode_STATUSCHECK_POD_PREEMPTED,
+				}, nil)},
+		},
 
 		// Check to diagnose pods with owner references
 		{
@@ -778,10 +1023,14 @@ func TestRun(t *testing.T) {
 			rs := make([]runtime.Object, len(test.pods))
 			mRun := func(n string, args []string) ([]byte, error) {
 				actualCommand := strings.Join(append([]string{n}, args...), " ")
-				if expected := "kubectl logs foo -n test -c foo-container"; actualCommand != expected {
-					t.Errorf("got %s, expected %s", actualCommand, expected)
+				switch actualCommand {
+				case "kubectl logs foo -n test -c foo-container":
+					return test.logOutput.output, test.logOutput.err
+				case "kubectl logs foo -n test -c foo-container --previous":
+					return test.previousLogOutput.output, test.previousLogOutput.err
 				}
-				return test.logOutput.output, test.logOutput.err
+				t.Errorf("unexpected command %s", actualCommand)
+				return nil, nil
 			}
 			t.Override(&runCli, mRun)
 			t.Override(&getReplicaSet, func(_ *appsv1.Deployment, _ appsclient.AppsV1Interface) ([]*appsv1.ReplicaSet, []*appsv1.ReplicaSet, *appsv1.ReplicaSet, error) {
@@ -796,6 +1045,9 @@ func TestRun(t *testing.T) {
 				rs[i] = p
 			}
 			rs = append(rs, &v1.EventList{Items: test.events})
+			for _, n := range test.nodes {
+				rs = append(rs, n)
+			}
 			f := fakekubeclientset.NewSimpleClientset(rs...)
 
 			actual, err := testPodValidator(f).Validate(context.Background(), "test", metav1.ListOptions{})
@@ -892,3 +1144,154 @@ func truePtr() *bool {
 	t := true
 	return &t
 }
+
+func TestDescribeProbe(t *testing.T) {
+	tests := []struct {
+		description string
+		probe       v1.ProbeHandler
+		expected    string
+	}{
+		{
+			description: "http probe",
+			probe:       v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http")}},
+			expected:    "GET /healthz:http",
+		},
+		{
+			description: "tcp probe",
+			probe:       v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(5432)}},
+			expected:    "tcp :5432",
+		},
+		{
+			description: "grpc probe",
+			probe:       v1.ProbeHandler{GRPC: &v1.GRPCAction{Port: 9090}},
+			expected:    "grpc :9090",
+		},
+		{
+			description: "grpc probe of a service",
+			probe:       v1.ProbeHandler{GRPC: &v1.GRPCAction{Port: 9090, Service: util.Ptr("orders.v1.Orders")}},
+			expected:    `grpc :9090 service "orders.v1.Orders"`,
+		},
+		{
+			description: "exec probe",
+			probe:       v1.ProbeHandler{Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/healthy"}}},
+			expected:    `exec "cat /tmp/healthy"`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, describeProbe(&v1.Probe{ProbeHandler: test.probe}))
+		})
+	}
+}
+
+func TestDescribeReadinessProbeFailureGRPC(t *testing.T) {
+	tests := []struct {
+		description string
+		service     *string
+		message     string
+		expected    string
+	}{
+		{
+			description: "service unknown",
+			service:     util.Ptr("orders.v1.Orders"),
+			message:     `Readiness probe failed: service unhealthy (responded with "SERVICE_UNKNOWN")`,
+			expected:    `readiness probe grpc :9090 service "orders.v1.Orders" failing: service unhealthy (responded with "SERVICE_UNKNOWN"): the gRPC health server doesn't know the service "orders.v1.Orders"`,
+		},
+		{
+			description: "service not serving",
+			service:     util.Ptr("orders.v1.Orders"),
+			message:     `Readiness probe failed: service unhealthy (responded with "NOT_SERVING")`,
+			expected:    `readiness probe grpc :9090 service "orders.v1.Orders" failing: service unhealthy (responded with "NOT_SERVING"): the gRPC health server reports the service "orders.v1.Orders" as not serving`,
+		},
+		{
+			description: "server not serving",
+			message:     `Readiness probe failed: service unhealthy (responded with "NOT_SERVING")`,
+			expected:    `readiness probe grpc :9090 failing: service unhealthy (responded with "NOT_SERVING"): the gRPC health server reports the server as not serving`,
+		},
+		{
+			description: "health protocol not implemented",
+			message:     "Readiness probe failed: error: this server does not implement the grpc health protocol (grpc.health.v1.Health): unknown service grpc.health.v1.Health",
+			expected:    "readiness probe grpc :9090 failing: error: this server does not implement the grpc health protocol (grpc.health.v1.Health): unknown service grpc.health.v1.Health: the container doesn't serve the gRPC health checking protocol on port 9090",
+		},
+		{
+			description: "timeout",
+			message:     `Readiness probe failed: timeout: failed to connect service ":9090" within 1s`,
+			expected:    `readiness probe grpc :9090 failing: timeout: failed to connect service ":9090" within 1s`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod := v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "orders",
+				ReadinessProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{
+					GRPC: &v1.GRPCAction{Port: 9090, Service: test.service},
+				}},
+			}}}}
+			e := &v1.Event{
+				InvolvedObject: v1.ObjectReference{FieldPath: "spec.containers{orders}"},
+				Message:        test.message,
+			}
+			t.CheckDeepEqual(test.expected, describeReadinessProbeFailure(pod, e))
+		})
+	}
+}
+
+func TestPodLogsPrefix(t *testing.T) {
+	tests := []struct {
+		description    string
+		prefixTemplate string
+		pod            *v1.Pod
+		expected       []string
+	}{
+		{
+			description: "default prefix",
+			pod:         &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-5d8f7c6b9-x2x7d", Namespace: "test"}},
+			expected:    []string{"[web-5d8f7c6b9-x2x7d app] go panic"},
+		},
+		{
+			description:    "labels and deployment",
+			prefixTemplate: "[app={{.Labels.app}} {{.Owner}}]",
+			pod: &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-5d8f7c6b9-x2x7d",
+				Namespace:       "test",
+				Labels:          map[string]string{"app": "web", "pod-template-hash": "5d8f7c6b9"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-5d8f7c6b9", Controller: util.Ptr(true)}},
+			}},
+			expected: []string{"[app=web deployment/web] go panic"},
+		},
+		{
+			description:    "statefulset and missing label",
+			prefixTemplate: "[{{.Labels.app}}{{.Owner}} {{.Container}}]",
+			pod: &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-5d8f7c6b9-x2x7d",
+				Namespace:       "test",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "db", Controller: util.Ptr(true)}},
+			}},
+			expected: []string{"[statefulset/db app] go panic"},
+		},
+		{
+			description:    "unknown field keeps the default prefix",
+			prefixTemplate: "[{{.Unknown}}]",
+			pod:            &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-5d8f7c6b9-x2x7d", Namespace: "test"}},
+			expected:       []string{"[web-5d8f7c6b9-x2x7d app] go panic"},
+		},
+		{
+			description:    "invalid template keeps the default prefix",
+			prefixTemplate: "[{{.Pod}]",
+			pod:            &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-5d8f7c6b9-x2x7d", Namespace: "test"}},
+			expected:       []string{"[web-5d8f7c6b9-x2x7d app] go panic"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&runCli, func(string, []string) ([]byte, error) {
+				return []byte("go panic\n"), nil
+			})
+			p := (&PodValidator{}).WithLogPrefix(test.prefixTemplate)
+
+			_, actual := getPodLogs(test.pod, "app", proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED, p.logPrefix)
+
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+)
+
+var (
+	// For testing
+	makeTempDir = func() (string, error) { return os.MkdirTemp("", "skaffold-apko") }
+)
+
+// Build builds an artifact with apko.
+// Pushed images are published by apko directly, while local images are written to a tarball loaded in the docker daemon.
+func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string, matcher platform.Matcher) (string, error) {
+	a := artifact.ArtifactType.ApkoArtifact
+	archs := architectures(a, matcher)
+
+	if b.pushImages {
+		// apko publish builds and pushes the image in one step.
+		defer timing.Start(ctx, artifact.ImageName, timing.Push)()
+		args := append([]string{"publish", a.Config, tag}, archArgs(archs)...)
+		if err := runApko(ctx, out, artifact.Workspace, append(args, a.Args...)); err != nil {
+			return "", err
+		}
+		return docker.RemoteDigest(tag, b.cfg, nil)
+	}
+
+	// a docker daemon loads a single architecture.
+	if len(archs) > 1 {
+		archs = []string{runtime.GOARCH}
+		log.Entry(ctx).Warnf("multiple target architectures found for artifact %q. Only %q is built when the image isn't pushed.", artifact.ImageName, archs[0])
+	}
+	dir, err := makeTempDir()
+	if err != nil {
+		return "", fmt.Errorf("creating apko output directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tarPath := filepath.Join(dir, "image.tar")
+	args := append([]string{"build", a.Config, tag, tarPath}, archArgs(archs)...)
+	if err := runApko(ctx, out, artifact.Workspace, append(args, a.Args...)); err != nil {
+		return "", err
+	}
+	return b.loadImage(ctx, out, tarPath, tag)
+}
+
+func (b *Builder) SupportedPlatforms() platform.Matcher { return platform.All }
+
+func runApko(ctx context.Context, out io.Writer, workspace string, args []string) error {
+	cmd := exec.CommandContext(ctx, "apko", args...)
+	cmd.Dir = workspace
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(ctx, cmd); err != nil {
+		return fmt.Errorf("running apko: %w", err)
+	}
+	return nil
+}
+
+// architectures returns the architectures configured for the artifact, or else the architectures of the target platforms.
+// An empty list defers to the architectures of the apko configuration.
+func architectures(a *latest.ApkoArtifact, matcher platform.Matcher) []string {
+	if len(a.Architectures) > 0 {
+		return a.Architectures
+	}
+	var archs []string
+	for _, p := range matcher.Platforms {
+		arch := p.Architecture
+		if p.Variant != "" {
+			arch += "/" + p.Variant
+		}
+		archs = append(archs, arch)
+	}
+	return archs
+}
+
+func archArgs(archs []string) []string {
+	if len(archs) == 0 {
+		return nil
+	}
+	return []string{"--arch", strings.Join(archs, ",")}
+}
+
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, tarPath string, tag string) (string, error) {
+	manifest, err := tarball.LoadManifest(func() (io.ReadCloser, error) {
+		return os.Open(tarPath)
+	})
+	if err != nil {
+		return "", fmt.Errorf("loading manifest from tarball failed: %w", err)
+	}
+
+	imageTar, err := os.Open(tarPath)
+	if err != nil {
+		return "", fmt.Errorf("opening image tarball: %w", err)
+	}
+	defer imageTar.Close()
+
+	ref, err := loadedTag(manifest)
+	if err != nil {
+		return "", err
+	}
+	imageID, err := b.localDocker.Load(ctx, out, imageTar, ref)
+	if err != nil {
+		return "", fmt.Errorf("loading image into docker daemon: %w", err)
+	}
+
+	if err := b.localDocker.Tag(ctx, imageID, tag); err != nil {
+		return "", fmt.Errorf("tagging the image: %w", err)
+	}
+
+	return imageID, nil
+}
+
+// loadedTag returns the tag of the image to load from the tarball manifest. apko suffixes the tag of each image
+// with its architecture, so the image of the local architecture is preferred when the tarball has several.
+func loadedTag(manifest tarball.Manifest) (string, error) {
+	var tags []string
+	for _, d := range manifest {
+		tags = append(tags, d.RepoTags...)
+	}
+	if len(tags) == 0 {
+		return "", errors.New("apko image tarball has no tagged image")
+	}
+	for _, t := range tags {
+		if strings.HasSuffix(t, "-"+runtime.GOARCH) {
+			return t, nil
+		}
+	}
+	return tags[0], nil
+}
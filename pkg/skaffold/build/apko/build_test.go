@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apko
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestBuildApko(t *testing.T) {
+	tests := []struct {
+		description   string
+		architectures []string
+		expectedArgs  string
+	}{
+		{
+			description:  "architectures of the apko configuration",
+			expectedArgs: "--debug",
+		},
+		{
+			description:   "single architecture",
+			architectures: []string{"arm64"},
+			expectedArgs:  "--arch arm64 --debug",
+		},
+		{
+			description:   "local architecture of multiple architectures",
+			architectures: []string{"amd64", "arm64"},
+			expectedArgs:  "--arch " + runtime.GOARCH + " --debug",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			tarPath := filepath.Join(tmpDir.Root(), "image.tar")
+			t.Override(&makeTempDir, func() (string, error) { return tmpDir.Root(), nil })
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun("apko build apko.yaml img:tag "+tarPath+" "+test.expectedArgs))
+			testutil.CreateFakeImageTar("img:tag-"+runtime.GOARCH, tarPath)
+
+			artifact := &latest.Artifact{
+				Workspace: ".",
+				ArtifactType: latest.ArtifactType{
+					ApkoArtifact: &latest.ApkoArtifact{
+						Config:        "apko.yaml",
+						Architectures: test.architectures,
+						Args:          []string{"--debug"},
+					},
+				},
+			}
+
+			builder := NewArtifactBuilder(fakeLocalDaemon(), &mockConfig{}, false)
+			_, err := builder.Build(context.Background(), io.Discard, artifact, "img:tag", platform.Matcher{})
+
+			t.CheckNoError(err)
+		})
+	}
+}
+
+func TestLoadedTag(t *testing.T) {
+	tests := []struct {
+		description string
+		manifest    tarball.Manifest
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "single image",
+			manifest:    tarball.Manifest{{RepoTags: []string{"img:tag-arm64"}}},
+			expected:    "img:tag-arm64",
+		},
+		{
+			description: "image of the local architecture",
+			manifest:    tarball.Manifest{{RepoTags: []string{"img:tag-other"}}, {RepoTags: []string{"img:tag-" + runtime.GOARCH}}},
+			expected:    "img:tag-" + runtime.GOARCH,
+		},
+		{
+			description: "empty manifest",
+			shouldErr:   true,
+		},
+		{
+			description: "untagged image",
+			manifest:    tarball.Manifest{{Config: "sha256:abc"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tag, err := loadedTag(test.manifest)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
+
+func TestBuildApkoPush(t *testing.T) {
+	tests := []struct {
+		description   string
+		architectures []string
+		platforms     platform.Matcher
+		expectedCmd   string
+	}{
+		{
+			description: "architectures of the apko configuration",
+			expectedCmd: "apko publish apko.yaml img:tag",
+		},
+		{
+			description:   "configured architectures",
+			architectures: []string{"amd64", "arm64"},
+			platforms:     platform.Matcher{Platforms: []specs.Platform{{OS: "linux", Architecture: "386"}}},
+			expectedCmd:   "apko publish apko.yaml img:tag --arch amd64,arm64",
+		},
+		{
+			description: "target platforms",
+			platforms:   platform.Matcher{Platforms: []specs.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm", Variant: "v7"}}},
+			expectedCmd: "apko publish apko.yaml img:tag --arch amd64,arm/v7",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun(test.expectedCmd))
+			t.Override(&docker.RemoteDigest, func(string, docker.Config, []specs.Platform) (string, error) { return "sha256:abc", nil })
+
+			artifact := &latest.Artifact{
+				Workspace: ".",
+				ArtifactType: latest.ArtifactType{
+					ApkoArtifact: &latest.ApkoArtifact{
+						Config:        "apko.yaml",
+						Architectures: test.architectures,
+					},
+				},
+			}
+
+			builder := NewArtifactBuilder(fakeLocalDaemon(), &mockConfig{}, true)
+			digest, err := builder.Build(context.Background(), io.Discard, artifact, "img:tag", test.platforms)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual("sha256:abc", digest)
+		})
+	}
+}
+
+func TestBuildApkoFailure(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunErr("apko publish apko.yaml img:tag", errors.New("BUG")))
+
+		artifact := &latest.Artifact{
+			ArtifactType: latest.ArtifactType{
+				ApkoArtifact: &latest.ApkoArtifact{Config: "apko.yaml"},
+			},
+		}
+
+		builder := NewArtifactBuilder(fakeLocalDaemon(), &mockConfig{}, true)
+		_, err := builder.Build(context.Background(), io.Discard, artifact, "img:tag", platform.Matcher{})
+
+		t.CheckErrorContains("running apko", err)
+	})
+}
+
+func fakeLocalDaemon() docker.LocalDaemon {
+	return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, nil, false, nil)
+}
+
+type mockConfig struct {
+	docker.Config
+}
+
+func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
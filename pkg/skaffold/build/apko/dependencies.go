@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apko
+
+import (
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// GetDependencies returns the dependencies of the given apko artifact, relative to its workspace.
+// apko resolves the packages of the image from repositories, so only the configuration file is watched.
+func GetDependencies(a *latest.ApkoArtifact) []string {
+	return []string{a.Config}
+}
@@ -63,6 +63,12 @@ type PipelineBuilder interface {
 	SupportedPlatforms() platform.Matcher
 }
 
+// MultiPlatformBuilder is optionally implemented by a `PipelineBuilder` that can natively build a multi-platform image for some artifacts,
+// instead of building an image per platform and combining them into a manifest list.
+type MultiPlatformBuilder interface {
+	SupportsMultiPlatformBuild(artifact *latest.Artifact) bool
+}
+
 type ErrSyncMapNotSupported struct{}
 
 func (ErrSyncMapNotSupported) Error() string {
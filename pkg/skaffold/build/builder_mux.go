@@ -106,7 +106,7 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 		}
 		var built string
 
-		if platforms.IsMultiPlatform() && !SupportsMultiPlatformBuild(*artifact) {
+		if platforms.IsMultiPlatform() && !SupportsMultiPlatformBuild(*artifact) && !supportsNativeMultiPlatformBuild(p, artifact) {
 			built, err = CreateMultiPlatformImage(ctx, out, artifact, tag, platforms, artifactBuilder)
 		} else {
 			built, err = artifactBuilder(ctx, out, artifact, tag, platforms)
@@ -222,3 +222,8 @@ func checkMultiplatformHaveRegistry(b *BuilderMux, artifacts []*latest.Artifact,
 
 	return nil
 }
+
+func supportsNativeMultiPlatformBuild(p PipelineBuilder, artifact *latest.Artifact) bool {
+	mpb, ok := p.(MultiPlatformBuilder)
+	return ok && mpb.SupportsMultiPlatformBuild(artifact)
+}
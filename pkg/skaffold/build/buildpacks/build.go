@@ -23,6 +23,7 @@ import (
 
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/platform"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
@@ -46,6 +47,7 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Art
 	}
 
 	if b.pushImages {
+		defer timing.Start(ctx, artifact.ImageName, timing.Push)()
 		return b.localDocker.Push(ctx, out, tag)
 	}
 	return b.localDocker.ImageID(ctx, tag)
@@ -58,6 +58,8 @@ type cache struct {
 	isLocalImage       func(imageName string) (bool, error)
 	importMissingImage func(imageName string) (bool, error)
 	lister             DependencyLister
+	// contentTags tags the pushed images with the hash of their build inputs, to find them in the registry without a local cache entry.
+	contentTags bool
 }
 
 // DependencyLister fetches a list of dependencies for an artifact
@@ -71,6 +73,7 @@ type Config interface {
 	GetCluster() config.Cluster
 	CacheArtifacts() bool
 	CacheFile() string
+	CacheContentTags() bool
 	Mode() config.RunMode
 }
 
@@ -129,9 +132,23 @@ func NewCache(ctx context.Context, cfg Config, isLocalImage func(imageName strin
 		isLocalImage:       isLocalImage,
 		importMissingImage: importMissingImage,
 		lister:             dependencies,
+		contentTags:        cfg.CacheContentTags(),
 	}, nil
 }
 
+// clientFor returns the client of the docker daemon that builds the image, which is the docker host of
+// docker artifacts built on a remote daemon.
+func (c *cache) clientFor(ctx context.Context, imageName string) (docker.LocalDaemon, error) {
+	if p, found := c.cfg.PipelineForImage(imageName); found {
+		for _, a := range p.Build.Artifacts {
+			if a.ImageName == imageName && a.DockerArtifact != nil && a.DockerArtifact.DockerHost != "" {
+				return docker.NewHostAPIClient(ctx, c.cfg, a.DockerArtifact.DockerHost)
+			}
+		}
+	}
+	return c.client, nil
+}
+
 // resolveCacheFile makes sure that either a passed in cache file or the default cache file exists
 func resolveCacheFile(cacheFile string) (string, error) {
 	if cacheFile != "" {
@@ -63,9 +63,10 @@ type needsTagging interface {
 
 // Found locally with wrong tag. Needs retagging
 type needsLocalTagging struct {
-	hash    string
-	tag     string
-	imageID string
+	hash      string
+	imageName string
+	tag       string
+	imageID   string
 }
 
 func (d needsLocalTagging) Hash() string {
@@ -73,7 +74,11 @@ func (d needsLocalTagging) Hash() string {
 }
 
 func (d needsLocalTagging) Tag(ctx context.Context, c *cache, platforms platform.Resolver) error {
-	return c.client.Tag(ctx, d.imageID, d.tag)
+	client, err := c.clientFor(ctx, d.imageName)
+	if err != nil {
+		return err
+	}
+	return client.Tag(ctx, d.imageID, d.tag)
 }
 
 // Found remotely with wrong tag. Needs retagging
@@ -95,9 +100,10 @@ func (d needsRemoteTagging) Tag(ctx context.Context, c *cache, platforms platfor
 
 // Found locally. Needs pushing
 type needsPushing struct {
-	hash    string
-	tag     string
-	imageID string
+	hash      string
+	imageName string
+	tag       string
+	imageID   string
 }
 
 func (d needsPushing) Hash() string {
@@ -105,11 +111,15 @@ func (d needsPushing) Hash() string {
 }
 
 func (d needsPushing) Push(ctx context.Context, out io.Writer, c *cache) error {
-	if err := c.client.Tag(ctx, d.imageID, d.tag); err != nil {
+	client, err := c.clientFor(ctx, d.imageName)
+	if err != nil {
+		return err
+	}
+	if err := client.Tag(ctx, d.imageID, d.tag); err != nil {
 		return err
 	}
 
-	digest, err := c.client.Push(ctx, out, d.tag)
+	digest, err := client.Push(ctx, out, d.tag)
 	if err != nil {
 		return err
 	}
@@ -25,6 +25,7 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
@@ -34,6 +35,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 )
 
+// contentTagPrefix prefixes the tags of the images tagged with the hash of their build inputs.
+const contentTagPrefix = "skaffold-inputs-"
+
 func (c *cache) lookupArtifacts(ctx context.Context, out io.Writer, tags tag.ImageTags, platforms platform.Resolver, artifacts []*latest.Artifact) []cacheDetails {
 	details := make([]cacheDetails, len(artifacts))
 	// Create a new `artifactHasher` on every new dev loop.
@@ -63,12 +67,18 @@ func (c *cache) lookup(ctx context.Context, out io.Writer, a *latest.Artifact, t
 		"ImageName": instrumentation.PII(a.ImageName),
 	})
 	defer endTrace()
+	defer timing.Start(ctx, a.ImageName, timing.CacheCheck)()
 
 	hash, err := h.hash(ctx, out, a, platforms, tag)
 	if err != nil {
 		return failed{err: fmt.Errorf("getting hash for artifact %q: %s", a.ImageName, err)}
 	}
 
+	client, err := c.clientFor(ctx, a.ImageName)
+	if err != nil {
+		return failed{err: err}
+	}
+
 	c.cacheMutex.RLock()
 	entry, cacheHit := c.artifactCache[hash]
 	c.cacheMutex.RUnlock()
@@ -81,27 +91,31 @@ func (c *cache) lookup(ctx context.Context, out io.Writer, a *latest.Artifact, t
 		if len(pls.Platforms) == 1 {
 			pl = util.ConvertToV1Platform(pls.Platforms[0])
 		}
-		if entry, err = c.tryImport(ctx, a, tag, hash, pl); err != nil {
-			log.Entry(ctx).Debugf("Could not import artifact from Docker, building instead (%s)", err)
-			return needsBuilding{hash: hash}
+		if entry, err = c.tryImport(ctx, client, a, tag, hash, pl); err != nil {
+			// the image may still be found in the registry with the hash of its build inputs.
+			if !c.contentTags {
+				log.Entry(ctx).Debugf("Could not import artifact from Docker, building instead (%s)", err)
+				return needsBuilding{hash: hash}
+			}
+			log.Entry(ctx).Debugf("Could not import artifact from Docker (%s)", err)
 		}
 	}
 
 	if isLocal, err := c.isLocalImage(a.ImageName); err != nil {
 		return failed{err}
 	} else if isLocal {
-		return c.lookupLocal(ctx, hash, tag, entry)
+		return c.lookupLocal(ctx, client, a.ImageName, hash, tag, entry)
 	}
-	return c.lookupRemote(ctx, hash, tag, pls.Platforms, entry)
+	return c.lookupRemote(ctx, client, a.ImageName, hash, tag, pls.Platforms, entry)
 }
 
-func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDetails) cacheDetails {
+func (c *cache) lookupLocal(ctx context.Context, client docker.LocalDaemon, imageName, hash, tag string, entry ImageDetails) cacheDetails {
 	if entry.ID == "" {
 		return needsBuilding{hash: hash}
 	}
 
 	// Check the imageID for the tag
-	idForTag, err := c.client.ImageID(ctx, tag)
+	idForTag, err := client.ImageID(ctx, tag)
 	if err != nil {
 		// Rely on actionable errors thrown from pkg/skaffold/docker.LocalDaemon api.
 		return failed{err: err}
@@ -113,14 +127,14 @@ func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDe
 	}
 
 	// Image exists locally with a different tag
-	if c.client.ImageExists(ctx, entry.ID) {
-		return needsLocalTagging{hash: hash, tag: tag, imageID: entry.ID}
+	if client.ImageExists(ctx, entry.ID) {
+		return needsLocalTagging{hash: hash, imageName: imageName, tag: tag, imageID: entry.ID}
 	}
 
 	return needsBuilding{hash: hash}
 }
 
-func (c *cache) lookupRemote(ctx context.Context, hash, tag string, platforms []specs.Platform, entry ImageDetails) cacheDetails {
+func (c *cache) lookupRemote(ctx context.Context, client docker.LocalDaemon, imageName, hash, tag string, platforms []specs.Platform, entry ImageDetails) cacheDetails {
 	if remoteDigest, err := docker.RemoteDigest(tag, c.cfg, nil); err == nil {
 		// Image exists remotely with the same tag and digest
 		if remoteDigest == entry.Digest {
@@ -136,15 +150,43 @@ func (c *cache) lookupRemote(ctx context.Context, hash, tag string, platforms []
 		}
 	}
 
+	// Image built from the same inputs exists remotely, tagged with their hash
+	if c.contentTags {
+		if digest, err := lookupContentTag(tag, hash, c.cfg); err == nil {
+			c.cacheMutex.Lock()
+			c.artifactCache[hash] = ImageDetails{Digest: digest, ID: entry.ID}
+			c.cacheMutex.Unlock()
+			return needsRemoteTagging{hash: hash, tag: tag, digest: digest, platforms: platforms}
+		}
+	}
+
 	// Image exists locally
-	if entry.ID != "" && c.client != nil && c.client.ImageExists(ctx, entry.ID) {
-		return needsPushing{hash: hash, tag: tag, imageID: entry.ID}
+	if entry.ID != "" && client != nil && client.ImageExists(ctx, entry.ID) {
+		return needsPushing{hash: hash, imageName: imageName, tag: tag, imageID: entry.ID}
 	}
 
 	return needsBuilding{hash: hash}
 }
 
-func (c *cache) tryImport(ctx context.Context, a *latest.Artifact, tag string, hash string, pl v1.Platform) (ImageDetails, error) {
+// contentTag returns the reference of the image in the repository of tag, tagged with the hash of its build inputs.
+func contentTag(tag, hash string) (string, error) {
+	ref, err := docker.ParseReference(tag)
+	if err != nil {
+		return "", err
+	}
+	return ref.BaseName + ":" + contentTagPrefix + hash, nil
+}
+
+// lookupContentTag returns the digest of the image in the repository of tag built from inputs with the hash.
+func lookupContentTag(tag, hash string, cfg docker.Config) (string, error) {
+	ref, err := contentTag(tag, hash)
+	if err != nil {
+		return "", err
+	}
+	return docker.RemoteDigest(ref, cfg, nil)
+}
+
+func (c *cache) tryImport(ctx context.Context, client docker.LocalDaemon, a *latest.Artifact, tag string, hash string, pl v1.Platform) (ImageDetails, error) {
 	entry := ImageDetails{}
 
 	if importMissing, err := c.importMissingImage(a.ImageName); err != nil {
@@ -153,9 +195,9 @@ func (c *cache) tryImport(ctx context.Context, a *latest.Artifact, tag string, h
 		return ImageDetails{}, fmt.Errorf("import of missing images disabled")
 	}
 
-	if !c.client.ImageExists(ctx, tag) {
+	if !client.ImageExists(ctx, tag) {
 		log.Entry(ctx).Debugf("Importing artifact %s from docker registry", tag)
-		err := c.client.Pull(ctx, io.Discard, tag, pl)
+		err := client.Pull(ctx, io.Discard, tag, pl)
 		if err != nil {
 			return entry, err
 		}
@@ -163,7 +205,7 @@ func (c *cache) tryImport(ctx context.Context, a *latest.Artifact, tag string, h
 		log.Entry(ctx).Debugf("Importing artifact %s from local docker", tag)
 	}
 
-	imageID, err := c.client.ImageID(ctx, tag)
+	imageID, err := client.ImageID(ctx, tag)
 	if err != nil {
 		return entry, err
 	}
@@ -106,7 +106,7 @@ func TestLookupLocal(t *testing.T) {
 				"hash": {ID: "imageID"},
 			},
 			api:      (&testutil.FakeAPIClient{}).Add("tag", "otherImageID").Add("othertag", "imageID"),
-			expected: needsLocalTagging{hash: "hash", tag: "tag", imageID: "imageID"},
+			expected: needsLocalTagging{hash: "hash", imageName: "artifact", tag: "tag", imageID: "imageID"},
 		},
 		{
 			description: "hit but imageID not found",
@@ -147,6 +147,7 @@ func TestLookupRemote(t *testing.T) {
 		hasher      artifactHasher
 		cache       map[string]ImageDetails
 		api         *testutil.FakeAPIClient
+		contentTags bool
 		expected    cacheDetails
 	}{
 		{
@@ -156,6 +157,22 @@ func TestLookupRemote(t *testing.T) {
 			cache:       map[string]ImageDetails{},
 			expected:    needsBuilding{hash: "hash"},
 		},
+		{
+			description: "found with the hash of the build inputs",
+			hasher:      mockHasher{"hash"},
+			api:         &testutil.FakeAPIClient{ErrImagePull: true},
+			cache:       map[string]ImageDetails{},
+			contentTags: true,
+			expected:    needsRemoteTagging{hash: "hash", tag: "tag", digest: "contentdigest"},
+		},
+		{
+			description: "miss with the hash of the build inputs",
+			hasher:      mockHasher{"otherhash"},
+			api:         &testutil.FakeAPIClient{ErrImagePull: true},
+			cache:       map[string]ImageDetails{},
+			contentTags: true,
+			expected:    needsBuilding{hash: "otherhash"},
+		},
 		{
 			description: "hash failure",
 			hasher:      failingHasher{errors.New("BUG")},
@@ -184,7 +201,7 @@ func TestLookupRemote(t *testing.T) {
 				"hash": {ID: "imageID"},
 			},
 			api:      (&testutil.FakeAPIClient{}).Add("tag", "imageID"),
-			expected: needsPushing{hash: "hash", tag: "tag", imageID: "imageID"},
+			expected: needsPushing{hash: "hash", imageName: "artifact", tag: "tag", imageID: "imageID"},
 		},
 		{
 			description: "not found",
@@ -204,6 +221,8 @@ func TestLookupRemote(t *testing.T) {
 					return "digest", nil
 				case identifier == "tag@otherdigest":
 					return "otherdigest", nil
+				case identifier == "tag:skaffold-inputs-hash":
+					return "contentdigest", nil
 				default:
 					return "", errors.New("unknown remote tag")
 				}
@@ -215,6 +234,7 @@ func TestLookupRemote(t *testing.T) {
 				artifactCache:      test.cache,
 				client:             fakeLocalDaemon(test.api),
 				cfg:                &mockConfig{mode: config.RunModes.Build},
+				contentTags:        test.contentTags,
 			}
 			t.Override(&newArtifactHasherFunc, func(_ graph.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher { return test.hasher })
 			details := cache.lookupArtifacts(context.Background(), io.Discard, map[string]string{"artifact": "tag"}, platform.Resolver{}, []*latest.Artifact{{
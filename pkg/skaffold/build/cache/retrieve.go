@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/errors"
@@ -92,7 +93,10 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		case needsPushing:
 			eventV2.CacheCheckHit(artifact.ImageName, platforms.GetPlatforms(artifact.ImageName).String())
 			output.Green.Fprintln(out, "Found. Pushing")
-			if err := result.Push(ctx, out, c); err != nil {
+			stopPush := timing.Start(ctx, artifact.ImageName, timing.Push)
+			err := result.Push(ctx, out, c)
+			stopPush()
+			if err != nil {
 				endTrace(instrumentation.TraceEndError(err))
 
 				return nil, fmt.Errorf("%s: %w", sErrors.PushImageErr, err)
@@ -125,8 +129,12 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 			return nil, err
 		}
 		if isLocal {
-			var err error
-			uniqueTag, err = build.TagWithImageID(ctx, tag, entry.ID, c.client)
+			client, err := c.clientFor(ctx, artifact.ImageName)
+			if err != nil {
+				endTrace(instrumentation.TraceEndError(err))
+				return nil, err
+			}
+			uniqueTag, err = build.TagWithImageID(ctx, tag, entry.ID, client)
 			if err != nil {
 				endTrace(instrumentation.TraceEndError(err))
 				return nil, err
@@ -182,7 +190,11 @@ func (c *cache) AddArtifact(ctx context.Context, a graph.Artifact) error {
 		return err
 	}
 	if isLocal {
-		imageID, err := c.client.ImageID(ctx, a.Tag)
+		client, err := c.clientFor(ctx, a.ImageName)
+		if err != nil {
+			return err
+		}
+		imageID, err := client.ImageID(ctx, a.Tag)
 		if err != nil {
 			return err
 		}
@@ -196,6 +208,9 @@ func (c *cache) AddArtifact(ctx context.Context, a graph.Artifact) error {
 			return fmt.Errorf("parsing reference %q: %w", a.Tag, err)
 		}
 		entry.Digest = ref.Digest
+		if c.contentTags {
+			c.addContentTag(ctx, a)
+		}
 	}
 	c.cacheMutex.Lock()
 	c.artifactCache[c.hashByName[a.ImageName]] = entry
@@ -203,3 +218,15 @@ func (c *cache) AddArtifact(ctx context.Context, a graph.Artifact) error {
 
 	return nil
 }
+
+// addContentTag tags the pushed image of the artifact with the hash of its build inputs.
+// Failing to tag it only means that it won't be found by the next cache lookups without a local cache entry.
+func (c *cache) addContentTag(ctx context.Context, a graph.Artifact) {
+	target, err := contentTag(a.Tag, c.hashByName[a.ImageName])
+	if err == nil {
+		err = docker.CopyRemoteTag(a.Tag, target, c.cfg)
+	}
+	if err != nil {
+		log.Entry(ctx).Warnf("Unable to tag %s with the hash of its build inputs: %v", a.Tag, err)
+	}
+}
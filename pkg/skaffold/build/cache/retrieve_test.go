@@ -343,6 +343,29 @@ func TestCacheFindMissing(t *testing.T) {
 	})
 }
 
+func TestAddArtifactContentTag(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var tagged []string
+		t.Override(&docker.CopyRemoteTag, func(src, target string, _ docker.Config) error {
+			tagged = append(tagged, src+" -> "+target)
+			return nil
+		})
+
+		c := &cache{
+			artifactCache: ArtifactCache{},
+			hashByName:    map[string]string{"artifact": "hash"},
+			isLocalImage:  func(string) (bool, error) { return false, nil },
+			cfg:           &mockConfig{},
+			contentTags:   true,
+		}
+		err := c.AddArtifact(context.Background(), graph.Artifact{ImageName: "artifact", Tag: "gcr.io/p/artifact:v1@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(ArtifactCache{"hash": {Digest: "sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}}, c.artifactCache)
+		t.CheckDeepEqual([]string{"gcr.io/p/artifact:v1@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa -> gcr.io/p/artifact:skaffold-inputs-hash"}, tagged)
+	})
+}
+
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	cacheFile             string
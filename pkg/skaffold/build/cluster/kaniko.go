@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"time"
 
 	"github.com/docker/docker/pkg/progress"
@@ -33,6 +34,7 @@ import (
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/client"
@@ -128,6 +130,7 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 }
 
 func (b *Builder) copyKanikoBuildContext(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, podName string) error {
+	defer timing.Start(ctx, artifactName, timing.Context)()
 	copyTimeout, err := time.ParseDuration(artifact.CopyTimeout)
 
 	if err != nil {
@@ -222,6 +225,11 @@ func (b *Builder) setupKanikoBuildContext(ctx context.Context, out io.Writer, wo
 	if err != nil {
 		return fmt.Errorf("uploading build context: %w", err)
 	}
+	if artifact.Cache != nil && artifact.Cache.VolumeClaim != "" {
+		if err := b.checkCacheDirWritable(ctx, podName, artifact.Cache.VolumeClaim); err != nil {
+			return err
+		}
+	}
 	// Generate a file to successfully terminate the init container.
 	if out, err := b.kubectlcli.RunOut(ctx, "exec", podName, "-c", initContainer, "-n", b.Namespace, "--", "touch", "/tmp/complete"); err != nil {
 		return fmt.Errorf("finishing upload of the build context: %s", out)
@@ -230,6 +238,16 @@ func (b *Builder) setupKanikoBuildContext(ctx context.Context, out io.Writer, wo
 	return nil
 }
 
+// checkCacheDirWritable fails fast, from the init container, if the cache directory mounted from a volume claim
+// isn't writable by the user the build runs as, instead of letting kaniko silently skip the cache.
+func (b *Builder) checkCacheDirWritable(ctx context.Context, podName string, claimName string) error {
+	check := path.Join(kaniko.DefaultCacheDirMountPath, ".skaffold-write-check")
+	if out, err := b.kubectlcli.RunOut(ctx, "exec", podName, "-c", initContainer, "-n", b.Namespace, "--", "sh", "-c", fmt.Sprintf("touch %s && rm %s", check, check)); err != nil {
+		return fmt.Errorf("kaniko cache directory %s of volume claim %q is not writable: %s", kaniko.DefaultCacheDirMountPath, claimName, out)
+	}
+	return nil
+}
+
 func evaluateEnv(env []v1.EnvVar, additional ...v1.EnvVar) ([]v1.EnvVar, error) {
 	// Prepare additional envs
 	addEnv := make(map[string]string)
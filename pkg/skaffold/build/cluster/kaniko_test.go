@@ -17,11 +17,15 @@ limitations under the License.
 package cluster
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
 
@@ -96,3 +100,35 @@ func TestEnvInterpolation_Latest(t *testing.T) {
 	}
 	testutil.CheckElementsMatch(t, expected, actual)
 }
+
+func TestCheckCacheDirWritable(t *testing.T) {
+	checkCmd := "kubectl --context kubecontext exec kaniko-abcd -c kaniko-init-container -n ns -- sh -c touch /cache/.skaffold-write-check && rm /cache/.skaffold-write-check"
+	tests := []struct {
+		description string
+		command     util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "writable",
+			command:     testutil.CmdRunOut(checkCmd, ""),
+		},
+		{
+			description: "read-only",
+			command:     testutil.CmdRunOutErr(checkCmd, "touch: /cache/.skaffold-write-check: Read-only file system", errors.New("exit status 1")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.command)
+			cfg := &mockBuilderContext{kubeContext: "kubecontext"}
+			builder := &Builder{ClusterDetails: &latest.ClusterDetails{Namespace: "ns"}, cfg: cfg, kubectlcli: kubectl.NewCLI(cfg, "")}
+
+			err := builder.checkCacheDirWritable(context.Background(), "kaniko-abcd", "kaniko-cache")
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckErrorContains(`kaniko cache directory /cache of volume claim "kaniko-cache" is not writable: touch: /cache/.skaffold-write-check: Read-only file system`, err)
+			}
+		})
+	}
+}
@@ -107,6 +107,11 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string, pla
 		addHostPathVolume(pod, kaniko.DefaultCacheDirName, kaniko.DefaultCacheDirMountPath, artifact.Cache.HostPath)
 	}
 
+	// Add persistent volume for cache
+	if artifact.Cache != nil && artifact.Cache.VolumeClaim != "" {
+		addPersistentVolume(pod, kaniko.DefaultCacheDirName, kaniko.DefaultCacheDirMountPath, artifact.Cache.VolumeClaim)
+	}
+
 	if b.ClusterDetails.DockerConfig != nil {
 		// Add secret for docker config if specified
 		addSecretVolume(pod, kaniko.DefaultDockerConfigSecretName, kaniko.DefaultDockerConfigPath, b.ClusterDetails.DockerConfig.SecretName)
@@ -235,6 +240,26 @@ func addHostPathVolume(pod *v1.Pod, name, mountPath, path string) {
 	})
 }
 
+// addPersistentVolume mounts the persistent volume claim in both containers,
+// so that the init container can check that it's writable before the build starts.
+func addPersistentVolume(pod *v1.Pod, name, mountPath, claimName string) {
+	vm := v1.VolumeMount{
+		Name:      name,
+		MountPath: mountPath,
+	}
+	pod.Spec.InitContainers[0].VolumeMounts = append(pod.Spec.InitContainers[0].VolumeMounts, vm)
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: name,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+}
+
 func resourceRequirements(rr *latest.ResourceRequirements) v1.ResourceRequirements {
 	req := v1.ResourceRequirements{}
 
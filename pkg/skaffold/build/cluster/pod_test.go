@@ -414,6 +414,32 @@ func TestKanikoPodSpec(t *testing.T) {
 	testutil.CheckDeepEqual(t, expectedPod.ObjectMeta, pod.ObjectMeta)
 }
 
+func TestKanikoPodSpecCacheVolumeClaim(t *testing.T) {
+	artifact := &latest.KanikoArtifact{
+		Image:          "image",
+		DockerfilePath: "Dockerfile",
+		InitImage:      "init/image",
+		Cache:          &latest.KanikoCache{VolumeClaim: "kaniko-cache"},
+	}
+	builder := &Builder{
+		cfg:            &mockBuilderContext{},
+		ClusterDetails: &latest.ClusterDetails{Namespace: "ns"},
+	}
+
+	pod, err := builder.kanikoPodSpec(artifact, "tag", platform.Matcher{})
+	testutil.CheckError(t, false, err)
+
+	cacheMount := v1.VolumeMount{Name: kaniko.DefaultCacheDirName, MountPath: kaniko.DefaultCacheDirMountPath}
+	testutil.CheckDeepEqual(t, cacheMount, pod.Spec.InitContainers[0].VolumeMounts[len(pod.Spec.InitContainers[0].VolumeMounts)-1])
+	testutil.CheckDeepEqual(t, cacheMount, pod.Spec.Containers[0].VolumeMounts[len(pod.Spec.Containers[0].VolumeMounts)-1])
+	testutil.CheckDeepEqual(t, v1.Volume{
+		Name: kaniko.DefaultCacheDirName,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "kaniko-cache"},
+		},
+	}, pod.Spec.Volumes[len(pod.Spec.Volumes)-1])
+}
+
 func TestResourceRequirements(t *testing.T) {
 	tests := []struct {
 		description string
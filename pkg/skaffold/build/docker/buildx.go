@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+)
+
+// buildxBuilderName is the buildx builder instance created by Skaffold when the current builder can't build multi-platform images.
+const buildxBuilderName = "skaffold"
+
+// buildxBuild builds a multi-platform image with `docker buildx build` and pushes the resulting manifest list to the registry.
+// It returns the digest of the pushed manifest list.
+func (b *Builder) buildxBuild(ctx context.Context, out io.Writer, a *latest.Artifact, dockerfilePath string, opts docker.BuildOptions, matcher platform.Matcher) (string, error) {
+	builder, err := b.buildxBuilder(ctx, out)
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{"buildx", "build", a.Workspace, "--file", dockerfilePath, "-t", opts.Tag}
+	cliArgs, err := b.cliBuildArgs(a.Workspace, a.DockerArtifact, opts)
+	if err != nil {
+		return "", err
+	}
+	args = append(args, cliArgs...)
+	if builder != "" {
+		args = append(args, "--builder", builder)
+	}
+	args = append(args, "--platform", matcher.String(), "--push")
+
+	cmd := b.dockerCommand(ctx, args...)
+	cmd.Stdout = out
+	var errBuffer bytes.Buffer
+	cmd.Stderr = io.MultiWriter(out, &errBuffer)
+
+	if err := util.RunCmd(ctx, cmd); err != nil {
+		return "", tryExecFormatErr(fmt.Errorf("running buildx build: %w", err), errBuffer)
+	}
+
+	return docker.RemoteDigest(opts.Tag, b.cfg, matcher.Platforms)
+}
+
+// buildxBuilder returns the buildx builder instance to use for multi-platform builds.
+// The current builder is used unless it's backed by the `docker` driver, which can't build multi-platform images,
+// in which case a `docker-container` builder is created (once) and used instead.
+// An empty name means that the current builder should be used.
+func (b *Builder) buildxBuilder(ctx context.Context, out io.Writer) (string, error) {
+	current, err := util.RunCmdOut(ctx, b.dockerCommand(ctx, "buildx", "inspect"))
+	if err != nil {
+		return "", fmt.Errorf("docker buildx is required to build multi-platform images: %w", err)
+	}
+	if driver := buildxDriver(current); driver != "docker" {
+		log.Entry(ctx).Debugf("using current buildx builder with driver %q for multi-platform build", driver)
+		return "", nil
+	}
+
+	if _, err := util.RunCmdOut(ctx, b.dockerCommand(ctx, "buildx", "inspect", buildxBuilderName)); err == nil {
+		return buildxBuilderName, nil
+	}
+
+	fmt.Fprintf(out, "Creating buildx builder %q for multi-platform build\n", buildxBuilderName)
+	if _, err := util.RunCmdOut(ctx, b.dockerCommand(ctx, "buildx", "create", "--name", buildxBuilderName, "--driver", "docker-container", "--bootstrap")); err != nil {
+		return "", fmt.Errorf("creating buildx builder %q: %w", buildxBuilderName, err)
+	}
+	return buildxBuilderName, nil
+}
+
+// BuildxAvailable returns whether the docker buildx plugin is installed for the docker CLI of the daemon.
+func BuildxAvailable(ctx context.Context, localDocker docker.LocalDaemon) bool {
+	cmd := exec.CommandContext(ctx, "docker", "buildx", "version")
+	cmd.Env = append(util.OSEnviron(), localDocker.ExtraEnv()...)
+	if _, err := util.RunCmdOut(ctx, cmd); err != nil {
+		log.Entry(ctx).Debugf("docker buildx isn't available: %v", err)
+		return false
+	}
+	return true
+}
+
+func (b *Builder) dockerCommand(ctx context.Context, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(util.OSEnviron(), b.localDocker.ExtraEnv()...)
+	return cmd
+}
+
+// buildxDriver parses the driver of a builder from the output of `docker buildx inspect`.
+func buildxDriver(inspect []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(inspect))
+	for scanner.Scan() {
+		if driver, found := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "Driver:"); found {
+			return strings.TrimSpace(driver)
+		}
+	}
+	return ""
+}
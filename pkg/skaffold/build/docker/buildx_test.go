@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestBuildxBuild(t *testing.T) {
+	tests := []struct {
+		description string
+		commands    func(build string) *testutil.FakeCmd
+		shouldErr   bool
+	}{
+		{
+			description: "current builder supports multi-platform",
+			commands: func(build string) *testutil.FakeCmd {
+				return testutil.CmdRunOut("docker buildx inspect", "Name:   builder\nDriver: docker-container\n").
+					AndRun(build + " --platform linux/amd64,linux/arm64 --push")
+			},
+		},
+		{
+			description: "skaffold builder already exists",
+			commands: func(build string) *testutil.FakeCmd {
+				return testutil.CmdRunOut("docker buildx inspect", "Name:   default\nDriver: docker\n").
+					AndRunOut("docker buildx inspect skaffold", "Name:   skaffold\nDriver: docker-container\n").
+					AndRun(build + " --builder skaffold --platform linux/amd64,linux/arm64 --push")
+			},
+		},
+		{
+			description: "skaffold builder is created",
+			commands: func(build string) *testutil.FakeCmd {
+				return testutil.CmdRunOut("docker buildx inspect", "Name:   default\nDriver: docker\n").
+					AndRunOutErr("docker buildx inspect skaffold", "", errors.New("no builder \"skaffold\" found")).
+					AndRunOut("docker buildx create --name skaffold --driver docker-container --bootstrap", "skaffold").
+					AndRun(build + " --builder skaffold --platform linux/amd64,linux/arm64 --push")
+			},
+		},
+		{
+			description: "buildx not installed",
+			commands: func(string) *testutil.FakeCmd {
+				return testutil.CmdRunOutErr("docker buildx inspect", "", errors.New("'buildx' is not a docker command"))
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.EvalBuildArgsWithEnv, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string, _ map[string]string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&util.OSEnviron, func() []string { return nil })
+			t.Override(&util.DefaultExecCommand, test.commands("docker buildx build . --file "+dockerfilePath+" -t gcr.io/test/image:tag"))
+			t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config, platforms []specs.Platform) (string, error) {
+				t.CheckDeepEqual("gcr.io/test/image:tag", identifier)
+				t.CheckDeepEqual(2, len(platforms))
+				return "sha256:abacab", nil
+			})
+
+			matcher, err := platform.Parse([]string{"linux/amd64", "linux/arm64"})
+			t.CheckNoError(err)
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(nil), mockConfig{}, false, nil, true, mockArtifactResolver{}, nil)
+			artifact := &latest.Artifact{
+				Workspace: ".",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+				},
+			}
+
+			digest, err := builder.Build(context.Background(), io.Discard, artifact, "gcr.io/test/image:tag", matcher)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, map[bool]string{false: "sha256:abacab", true: ""}[test.shouldErr], digest)
+		})
+	}
+}
@@ -23,9 +23,11 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
@@ -46,7 +48,21 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 	if len(matcher.Platforms) == 1 {
 		pl = util.ConvertToV1Platform(matcher.Platforms[0])
 	}
+	if host := a.DockerArtifact.DockerHost; host != "" {
+		remote, err := b.withDockerHost(ctx, host)
+		if err != nil {
+			return "", dockerHostUnreachable(err, a.ImageName, host)
+		}
+		if !b.pushImages {
+			log.Entry(ctx).Warnf("image %q is built on docker host %q and not pushed, so it can only be deployed to a cluster using that daemon", a.ImageName, host)
+		}
+		b = remote
+	}
 	a = adjustCacheFrom(a, tag)
+	var inlineCacheRef string
+	if a.DockerArtifact.InlineCache {
+		a, inlineCacheRef = b.adjustInlineCache(ctx, a, tag)
+	}
 	instrumentation.AddAttributesToCurrentSpanFromContext(ctx, map[string]string{
 		"BuildType":   "docker",
 		"Context":     instrumentation.PII(a.Workspace),
@@ -61,18 +77,32 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 	if _, err := os.Stat(dockerfile); os.IsNotExist(err) {
 		return "", dockerfileNotFound(err, a.ImageName)
 	}
+	if err := checkSecrets(a.DockerArtifact.Secrets, append(util.OSEnviron(), b.localDocker.ExtraEnv()...)); err != nil {
+		return "", secretNotFound(err, a.ImageName)
+	}
+
+	opts := docker.BuildOptions{Tag: tag, Mode: b.cfg.Mode(), ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
+
+	// multi-platform images can't be loaded in the local daemon, so buildx pushes the manifest list directly.
+	if len(matcher.Platforms) > 1 {
+		digest, err := b.buildxBuild(ctx, output.GetUnderlyingWriter(out), a, dockerfile, opts, matcher)
+		if err != nil {
+			return "", newBuildError(err, b.cfg)
+		}
+		return digest, nil
+	}
 
 	if err := b.pullCacheFromImages(ctx, out, a.ArtifactType.DockerArtifact, pl); err != nil {
 		return "", cacheFromPullErr(err, a.ImageName)
 	}
-	opts := docker.BuildOptions{Tag: tag, Mode: b.cfg.Mode(), ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
 
 	var imageID string
 
 	// ignore useCLI boolean if buildkit is enabled since buildkit is only implemented for docker CLI at the moment in skaffold.
 	// we might consider a different approach in the future.
 	// use CLI for cross-platform builds
-	if b.useCLI || (b.useBuildKit != nil && *b.useBuildKit) || len(a.DockerArtifact.CliFlags) > 0 || matcher.IsCrossPlatform() {
+	// inline cache requires buildkit.
+	if b.useCLI || (b.useBuildKit != nil && *b.useBuildKit) || len(a.DockerArtifact.CliFlags) > 0 || matcher.IsCrossPlatform() || a.DockerArtifact.InlineCache {
 		imageID, err = b.dockerCLIBuild(ctx, output.GetUnderlyingWriter(out), a.ImageName, a.Workspace, dockerfile, a.ArtifactType.DockerArtifact, opts, pl)
 	} else {
 		imageID, err = b.localDocker.Build(ctx, out, a.Workspace, a.ImageName, a.ArtifactType.DockerArtifact, opts)
@@ -85,25 +115,33 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 	if b.pushImages {
 		// TODO (tejaldesai) Remove https://github.com/GoogleContainerTools/skaffold/blob/main/pkg/skaffold/errors/err_map.go#L56
 		// and instead define a pushErr() method here.
-		return b.localDocker.Push(ctx, out, tag)
+		defer timing.Start(ctx, a.ImageName, timing.Push)()
+		digest, err := b.localDocker.Push(ctx, out, tag)
+		if err == nil && inlineCacheRef != "" {
+			b.pushInlineCache(ctx, out, tag, inlineCacheRef)
+		}
+		return digest, err
 	}
 
 	return imageID, nil
 }
 
-func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, name string, workspace string, dockerfilePath string, a *latest.DockerArtifact, opts docker.BuildOptions, pl v1.Platform) (string, error) {
-	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
-	imageInfoEnv, err := docker.EnvTags(opts.Tag)
+// withDockerHost returns a copy of the builder that builds with the docker daemon at the given host.
+func (b *Builder) withDockerHost(ctx context.Context, host string) (*Builder, error) {
+	localDocker, err := docker.NewHostAPIClient(ctx, b.cfg, host)
 	if err != nil {
-		return "", fmt.Errorf("couldn't parse image tag: %w", err)
+		return nil, err
 	}
-	ba, err := docker.EvalBuildArgsWithEnv(b.cfg.Mode(), workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs, imageInfoEnv)
-	if err != nil {
-		return "", fmt.Errorf("unable to evaluate build args: %w", err)
-	}
-	cliArgs, err := docker.ToCLIBuildArgs(a, ba, imageInfoEnv)
+	remote := *b
+	remote.localDocker = localDocker
+	return &remote, nil
+}
+
+func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, name string, workspace string, dockerfilePath string, a *latest.DockerArtifact, opts docker.BuildOptions, pl v1.Platform) (string, error) {
+	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
+	cliArgs, err := b.cliBuildArgs(workspace, a, opts)
 	if err != nil {
-		return "", fmt.Errorf("getting docker build args: %w", err)
+		return "", err
 	}
 	args = append(args, cliArgs...)
 
@@ -130,6 +168,9 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, name string
 	} else if pl.String() != "" { // cross-platform builds require buildkit
 		log.Entry(ctx).Debugf("setting DOCKER_BUILDKIT=1 for docker build for artifact %q since it targets platform %q", name, pl.String())
 		cmd.Env = append(cmd.Env, "DOCKER_BUILDKIT=1")
+	} else if a.InlineCache {
+		log.Entry(ctx).Debugf("setting DOCKER_BUILDKIT=1 for docker build for artifact %q since it uses the inline cache", name)
+		cmd.Env = append(cmd.Env, "DOCKER_BUILDKIT=1")
 	}
 	cmd.Stdout = out
 
@@ -144,8 +185,26 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, name string
 	return b.localDocker.ImageID(ctx, opts.Tag)
 }
 
+// cliBuildArgs returns the build args, labels, cache and other flags of a `docker build` command line for the artifact.
+func (b *Builder) cliBuildArgs(workspace string, a *latest.DockerArtifact, opts docker.BuildOptions) ([]string, error) {
+	imageInfoEnv, err := docker.EnvTags(opts.Tag)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse image tag: %w", err)
+	}
+	ba, err := docker.EvalBuildArgsWithEnv(b.cfg.Mode(), workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs, imageInfoEnv)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	cliArgs, err := docker.ToCLIBuildArgs(a, ba, imageInfoEnv)
+	if err != nil {
+		return nil, fmt.Errorf("getting docker build args: %w", err)
+	}
+	return cliArgs, nil
+}
+
 func (b *Builder) pullCacheFromImages(ctx context.Context, out io.Writer, a *latest.DockerArtifact, pl v1.Platform) error {
-	if len(a.CacheFrom) == 0 {
+	// buildkit fetches the inline cache of the cache images from the registry.
+	if len(a.CacheFrom) == 0 || a.InlineCache {
 		return nil
 	}
 
@@ -190,3 +249,86 @@ func adjustCacheFrom(a *latest.Artifact, artifactTag string) *latest.Artifact {
 	copy.DockerArtifact.CacheFrom = cf
 	return &copy
 }
+
+// adjustInlineCache returns an artifact that embeds the build cache in the built image, with `BUILDKIT_INLINE_CACHE=1`,
+// and the reference of the image it's pulled from by later builds. The cache is pulled from the `cacheFrom` images of the
+// same repository as the artifact if set, or else from its `latest` tag, which is returned to be pushed after the build.
+// The inline cache is disabled if BuildKit isn't available.
+func (b *Builder) adjustInlineCache(ctx context.Context, a *latest.Artifact, artifactTag string) (*latest.Artifact, string) {
+	copy := *a
+	dockerArtifact := *a.DockerArtifact
+	copy.DockerArtifact = &dockerArtifact
+
+	if !b.buildKitAvailable(ctx) {
+		warnings.Printf("inlineCache of artifact %q requires BuildKit and is ignored - set `useBuildkit: true` in your config, or install docker buildx\n", a.ImageName)
+		dockerArtifact.InlineCache = false
+		return &copy, ""
+	}
+
+	dockerArtifact.BuildArgs = make(map[string]*string, len(a.DockerArtifact.BuildArgs)+1)
+	for k, v := range a.DockerArtifact.BuildArgs {
+		dockerArtifact.BuildArgs[k] = v
+	}
+	dockerArtifact.BuildArgs["BUILDKIT_INLINE_CACHE"] = util.Ptr("1")
+
+	ref, err := docker.ParseReference(artifactTag)
+	if err != nil {
+		log.Entry(ctx).Debugf("Not adding an inline cache source for %q: %v", artifactTag, err)
+		return &copy, ""
+	}
+	for _, image := range dockerArtifact.CacheFrom {
+		if cf, err := docker.ParseReference(image); err == nil && cf.BaseName == ref.BaseName && image != artifactTag {
+			return &copy, ""
+		}
+	}
+	cacheRef := ref.BaseName + ":latest"
+	dockerArtifact.CacheFrom = append(append([]string{}, dockerArtifact.CacheFrom...), cacheRef)
+	return &copy, cacheRef
+}
+
+// pushInlineCache pushes the built image with the tag that later builds pull the inline cache from.
+// A failure doesn't fail the build, since it only makes the next builds slower.
+func (b *Builder) pushInlineCache(ctx context.Context, out io.Writer, tag string, cacheRef string) {
+	if err := b.localDocker.Tag(ctx, tag, cacheRef); err != nil {
+		log.Entry(ctx).Warnf("could not tag %q as inline cache %q: %v", tag, cacheRef, err)
+		return
+	}
+	if _, err := b.localDocker.Push(ctx, out, cacheRef); err != nil {
+		log.Entry(ctx).Warnf("could not push inline cache %q: %v", cacheRef, err)
+	}
+}
+
+// buildKitAvailable returns whether the docker builds can use BuildKit. Unless enabled or disabled in the config,
+// BuildKit is available with the docker buildx plugin, which backs `docker build` since Docker 23.
+func (b *Builder) buildKitAvailable(ctx context.Context) bool {
+	if b.useBuildKit != nil {
+		return *b.useBuildKit
+	}
+	return BuildxAvailable(ctx, b.localDocker)
+}
+
+// checkSecrets fails fast if the source of a build secret is missing. Only the
+// paths and the names of the environment variables end up in the errors, never
+// the values of the secrets.
+func checkSecrets(secrets []*latest.DockerSecret, env []string) error {
+	for _, secret := range secrets {
+		if secret.Source != "" {
+			if _, err := os.Stat(util.ExpandHomePath(secret.Source)); err != nil {
+				return fmt.Errorf("source file %q of secret %q not found", secret.Source, secret.ID)
+			}
+		}
+		if secret.Env != "" && !hasEnv(env, secret.Env) {
+			return fmt.Errorf("environment variable %q of secret %q is not set", secret.Env, secret.ID)
+		}
+	}
+	return nil
+}
+
+func hasEnv(env []string, name string) bool {
+	for _, kv := range env {
+		if k, _, _ := strings.Cut(kv, "="); k == name {
+			return true
+		}
+	}
+	return false
+}
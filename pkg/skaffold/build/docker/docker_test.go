@@ -275,6 +275,223 @@ func TestDockerCLICheckCacheFromArgs(t *testing.T) {
 	}
 }
 
+func TestDockerCLIInlineCache(t *testing.T) {
+	tests := []struct {
+		description    string
+		useBuildKit    *bool
+		cacheFrom      []string
+		commands       func(dockerfilePath string) *testutil.FakeCmd
+		expectedCalls  int
+		expectedPushed []string
+	}{
+		{
+			description: "buildkit enabled",
+			useBuildKit: util.Ptr(true),
+			cacheFrom:   []string{"from/image"},
+			commands: func(dockerfilePath string) *testutil.FakeCmd {
+				return testutil.CmdRunEnv("docker build . --file "+dockerfilePath+" -t gcr.io/k8s-skaffold/test:tag --build-arg BUILDKIT_INLINE_CACHE=1 --cache-from from/image --cache-from gcr.io/k8s-skaffold/test:latest", []string{"DOCKER_BUILDKIT=1"})
+			},
+			expectedCalls:  1,
+			expectedPushed: []string{"gcr.io/k8s-skaffold/test:latest", "gcr.io/k8s-skaffold/test:tag"},
+		},
+		{
+			description: "buildkit available with buildx",
+			cacheFrom:   []string{"from/image"},
+			commands: func(dockerfilePath string) *testutil.FakeCmd {
+				return testutil.CmdRunOut("docker buildx version", "github.com/docker/buildx v0.12.0").
+					AndRunEnv("docker build . --file "+dockerfilePath+" -t gcr.io/k8s-skaffold/test:tag --build-arg BUILDKIT_INLINE_CACHE=1 --cache-from from/image --cache-from gcr.io/k8s-skaffold/test:latest", []string{"DOCKER_BUILDKIT=1"})
+			},
+			expectedCalls:  2,
+			expectedPushed: []string{"gcr.io/k8s-skaffold/test:latest", "gcr.io/k8s-skaffold/test:tag"},
+		},
+		{
+			description: "configured cache ref",
+			useBuildKit: util.Ptr(true),
+			cacheFrom:   []string{"gcr.io/k8s-skaffold/test:main"},
+			commands: func(dockerfilePath string) *testutil.FakeCmd {
+				return testutil.CmdRunEnv("docker build . --file "+dockerfilePath+" -t gcr.io/k8s-skaffold/test:tag --build-arg BUILDKIT_INLINE_CACHE=1 --cache-from gcr.io/k8s-skaffold/test:main", []string{"DOCKER_BUILDKIT=1"})
+			},
+			expectedCalls:  1,
+			expectedPushed: []string{"gcr.io/k8s-skaffold/test:tag"},
+		},
+		{
+			description:    "buildkit disabled",
+			useBuildKit:    util.Ptr(false),
+			cacheFrom:      []string{"from/image"},
+			commands:       func(string) *testutil.FakeCmd { return nil },
+			expectedPushed: []string{"gcr.io/k8s-skaffold/test:tag"},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.DefaultAuthHelper, stubAuth{})
+			t.Override(&docker.EvalBuildArgsWithEnv, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string, _ map[string]string) (map[string]*string, error) {
+				return args, nil
+			})
+			mockCmd := test.commands(dockerfilePath)
+			if mockCmd != nil {
+				t.Override(&util.DefaultExecCommand, mockCmd)
+			}
+
+			artifact := &latest.Artifact{
+				ImageName: "gcr.io/k8s-skaffold/test",
+				Workspace: ".",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+						CacheFrom:      test.cacheFrom,
+						InlineCache:    true,
+					},
+				},
+			}
+			fakeClient := (&testutil.FakeAPIClient{}).Add("gcr.io/k8s-skaffold/test:tag", "sha256:test")
+			builder := NewArtifactBuilder(docker.NewLocalDaemon(fakeClient, nil, false, nil), mockConfig{}, false, test.useBuildKit, true, mockArtifactResolver{make(map[string]string)}, nil)
+			_, err := builder.Build(context.Background(), io.Discard, artifact, "gcr.io/k8s-skaffold/test:tag", platform.Matcher{})
+
+			t.CheckNoError(err)
+			if mockCmd != nil {
+				t.CheckDeepEqual(test.expectedCalls, mockCmd.TimesCalled())
+			}
+			var pushed []string
+			for ref := range fakeClient.Pushed() {
+				pushed = append(pushed, ref)
+			}
+			t.CheckElementsMatch(test.expectedPushed, pushed)
+			t.CheckDeepEqual(map[string]*string(nil), artifact.DockerArtifact.BuildArgs)
+		})
+	}
+}
+
+func TestDockerHost(t *testing.T) {
+	tests := []struct {
+		description     string
+		hostErr         error
+		shouldErr       bool
+		expectedErrCode proto.StatusCode
+	}{
+		{
+			description: "build on the docker host",
+		},
+		{
+			description:     "unreachable docker host",
+			hostErr:         fmt.Errorf("docker host %q is unreachable: connection refused", "ssh://user@gpu-builder"),
+			shouldErr:       true,
+			expectedErrCode: proto.StatusCode_BUILD_DOCKER_UNAVAILABLE,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.DefaultAuthHelper, stubAuth{})
+			t.Override(&docker.EvalBuildArgsWithEnv, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string, _ map[string]string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&util.OSEnviron, func() []string { return []string{"KEY=VALUE"} })
+			t.Override(&docker.NewHostAPIClient, func(_ context.Context, _ docker.Config, host string) (docker.LocalDaemon, error) {
+				if test.hostErr != nil {
+					return nil, test.hostErr
+				}
+				return fakeLocalDaemonWithExtraEnv([]string{"DOCKER_HOST=" + host}), nil
+			})
+			mockCmd := testutil.CmdRunEnv("docker build . --file "+dockerfilePath+" -t tag", []string{"KEY=VALUE", "DOCKER_HOST=ssh://user@gpu-builder"})
+			t.Override(&util.DefaultExecCommand, mockCmd)
+
+			artifact := &latest.Artifact{
+				Workspace: ".",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+						DockerHost:     "ssh://user@gpu-builder",
+					},
+				},
+			}
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(nil), mockConfig{}, true, nil, false, mockArtifactResolver{make(map[string]string)}, nil)
+			_, err := builder.Build(context.Background(), io.Discard, artifact, "tag", platform.Matcher{})
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(test.expectedErrCode, err.(*sErrors.ErrDef).StatusCode(), protocmp.Transform())
+			} else {
+				t.CheckDeepEqual(1, mockCmd.TimesCalled())
+			}
+		})
+	}
+}
+
+func TestDockerCLIBuildSecrets(t *testing.T) {
+	tests := []struct {
+		description string
+		secret      latest.DockerSecret
+		env         []string
+		secretArg   string
+		shouldErr   bool
+	}{
+		{
+			description: "secret from a file",
+			secret:      latest.DockerSecret{ID: "npmrc", Source: "npmrc"},
+			secretArg:   "id=npmrc,src=npmrc",
+		},
+		{
+			description: "secret from an environment variable",
+			secret:      latest.DockerSecret{ID: "token", Env: "NPM_TOKEN"},
+			env:         []string{"NPM_TOKEN=s3cr3t"},
+			secretArg:   "id=token,env=NPM_TOKEN",
+		},
+		{
+			description: "missing source file",
+			secret:      latest.DockerSecret{ID: "npmrc", Source: "missing"},
+			shouldErr:   true,
+		},
+		{
+			description: "unset environment variable",
+			secret:      latest.DockerSecret{ID: "token", Env: "NPM_TOKEN"},
+			env:         []string{"OTHER=s3cr3t"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Write("npmrc", "//registry.npmjs.org/:_authToken=s3cr3t").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.DefaultAuthHelper, stubAuth{})
+			t.Override(&docker.EvalBuildArgsWithEnv, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string, _ map[string]string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&util.OSEnviron, func() []string { return test.env })
+			mockCmd := testutil.CmdRunEnv("docker build . --file "+dockerfilePath+" -t tag --secret "+test.secretArg+" --load", []string{"DOCKER_BUILDKIT=1"})
+			t.Override(&util.DefaultExecCommand, mockCmd)
+
+			artifact := &latest.Artifact{
+				ImageName: "image",
+				Workspace: ".",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+						Secrets:        []*latest.DockerSecret{&test.secret},
+					},
+				},
+			}
+			var out strings.Builder
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(nil), mockConfig{}, false, util.Ptr(true), false, mockArtifactResolver{make(map[string]string)}, nil)
+			_, err := builder.Build(context.Background(), &out, artifact, "tag", platform.Matcher{})
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(proto.StatusCode_BUILD_USER_ERROR, err.(*sErrors.ErrDef).StatusCode(), protocmp.Transform())
+				t.CheckFalse(strings.Contains(err.Error(), "s3cr3t"))
+				t.CheckDeepEqual(0, mockCmd.TimesCalled())
+			} else {
+				t.CheckDeepEqual(1, mockCmd.TimesCalled())
+			}
+			t.CheckFalse(strings.Contains(out.String(), "s3cr3t"))
+		})
+	}
+}
+
 func fakeLocalDaemonWithExtraEnv(extraEnv []string) docker.LocalDaemon {
 	return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, extraEnv, false, nil)
 }
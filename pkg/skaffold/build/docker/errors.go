@@ -139,6 +139,36 @@ func dockerfileNotFound(err error, artifact string) error {
 		})
 }
 
+func secretNotFound(err error, artifact string) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: proto.StatusCode_BUILD_USER_ERROR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_FIX_USER_BUILD_ERR,
+					Action: fmt.Sprintf("Please check config `secrets` for artifact %s."+
+						"\nRefer https://skaffold.dev/docs/references/yaml/#build-artifacts-docker-secrets for details.", artifact),
+				},
+			},
+		})
+}
+
+func dockerHostUnreachable(err error, artifact string, host string) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: proto.StatusCode_BUILD_DOCKER_UNAVAILABLE,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_DOCKER_RUNNING,
+					Action: fmt.Sprintf("Check that the Docker daemon at %s is running and reachable, or fix config `dockerHost` for artifact %s."+
+						"\nRefer https://skaffold.dev/docs/references/yaml/#build-artifacts-docker for details.", host, artifact),
+				},
+			},
+		})
+}
+
 func cacheFromPullErr(err error, artifact string) error {
 	return sErrors.NewError(err,
 		&proto.ActionableErr{
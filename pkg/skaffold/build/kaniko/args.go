@@ -53,6 +53,9 @@ func Args(artifact *latest.KanikoArtifact, tag, context string) ([]string, error
 		if artifact.Cache.HostPath != "" {
 			args = append(args, CacheDirFlag, artifact.Cache.HostPath)
 		}
+		if artifact.Cache.VolumeClaim != "" {
+			args = append(args, CacheDirFlag, DefaultCacheDirMountPath)
+		}
 		if artifact.Cache.TTL != "" {
 			args = append(args, CacheTTLFlag, artifact.Cache.TTL)
 		}
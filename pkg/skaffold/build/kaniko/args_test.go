@@ -99,6 +99,20 @@ func TestArgs(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			description: "with Cache volume claim",
+			artifact: &latest.KanikoArtifact{
+				DockerfilePath: "dir/Dockerfile",
+				Cache: &latest.KanikoCache{
+					VolumeClaim: "kaniko-cache",
+				},
+			},
+			expectedArgs: []string{
+				CacheFlag,
+				CacheDirFlag, DefaultCacheDirMountPath,
+			},
+			wantErr: false,
+		},
 		{
 			description: "with Cleanup",
 			artifact: &latest.KanikoArtifact{
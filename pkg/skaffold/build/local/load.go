@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/cluster"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+)
+
+// Clusters the built images can be loaded into with `loadTo`.
+const (
+	LoadToKind     = "kind"
+	LoadToMinikube = "minikube"
+	LoadToAuto     = "auto"
+)
+
+// ResolveLoadTo returns the cluster the built images are loaded into, or "" if they aren't loaded.
+// With `auto`, it's detected from the kube context.
+func ResolveLoadTo(ctx context.Context, loadTo string, kubeContext string, minikubeProfile string) (string, error) {
+	switch loadTo {
+	case LoadToKind:
+		if !config.IsKindCluster(kubeContext) {
+			return "", fmt.Errorf("loadTo: kind requires a kind cluster, but the kube context is %q", kubeContext)
+		}
+		return LoadToKind, nil
+	case LoadToAuto:
+	default:
+		return loadTo, nil
+	}
+	switch {
+	case config.IsKindCluster(kubeContext):
+		return LoadToKind, nil
+	case minikubeProfile != "" || cluster.GetClient().IsMinikube(ctx, kubeContext):
+		return LoadToMinikube, nil
+	default:
+		log.Entry(ctx).Debugf("kube context %q is neither kind nor minikube, built images are not loaded", kubeContext)
+		return "", nil
+	}
+}
+
+// loadImage loads a built image from the local docker daemon into the minikube cluster.
+// Images aren't loaded into kind clusters here, since the deployers load the local images into kind clusters.
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, ref string) error {
+	if b.loadTo != LoadToMinikube {
+		return nil
+	}
+	// Images built with the docker daemon of minikube are already in the cluster.
+	if len(b.localDocker.ExtraEnv()) > 0 {
+		return nil
+	}
+	profile := b.cfg.MinikubeProfile()
+	if profile == "" {
+		profile = b.kubeContext
+	}
+	cmd, err := cluster.GetClient().MinikubeExec(ctx, "image", "load", ref, "-p", profile)
+	if err != nil {
+		return fmt.Errorf("executing minikube command: %w", err)
+	}
+
+	output.Default.Fprintf(out, "Loading image %s into %s cluster\n", ref, b.loadTo)
+	if cmdOut, err := util.RunCmdOut(ctx, cmd); err != nil {
+		return fmt.Errorf("loading image %q into %s cluster: %w, %s", ref, b.loadTo, err, cmdOut)
+	}
+	return nil
+}
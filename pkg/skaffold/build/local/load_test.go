@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os/exec"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/cluster"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+type fakeMinikubeClient struct {
+	isMinikube bool
+}
+
+func (c fakeMinikubeClient) IsMinikube(context.Context, string) bool { return c.isMinikube }
+func (fakeMinikubeClient) MinikubeExec(ctx context.Context, arg ...string) (*exec.Cmd, error) {
+	return exec.Command("minikube", arg...), nil
+}
+
+func TestResolveLoadTo(t *testing.T) {
+	tests := []struct {
+		description     string
+		loadTo          string
+		kubeContext     string
+		minikubeProfile string
+		isMinikube      bool
+		expected        string
+		shouldErr       bool
+	}{
+		{description: "not set", kubeContext: "kind-kind"},
+		{description: "kind", loadTo: "kind", kubeContext: "kind-dev", expected: "kind"},
+		{description: "kind with another kube context", loadTo: "kind", kubeContext: "gke_project_zone_cluster", shouldErr: true},
+		{description: "auto with kind", loadTo: "auto", kubeContext: "kind-dev", expected: "kind"},
+		{description: "auto with minikube", loadTo: "auto", kubeContext: "dev", isMinikube: true, expected: "minikube"},
+		{description: "auto with minikube profile", loadTo: "auto", kubeContext: "dev", minikubeProfile: "dev", expected: "minikube"},
+		{description: "auto with remote cluster", loadTo: "auto", kubeContext: "gke_project_zone_cluster"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&cluster.GetClient, func() cluster.Client { return fakeMinikubeClient{isMinikube: test.isMinikube} })
+
+			loadTo, err := ResolveLoadTo(context.Background(), test.loadTo, test.kubeContext, test.minikubeProfile)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, loadTo)
+		})
+	}
+}
+
+func TestLoadImage(t *testing.T) {
+	tests := []struct {
+		description string
+		loadTo      string
+		kubeContext string
+		dockerEnv   []string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "kind images are loaded by the deployers",
+			loadTo:      "kind",
+			kubeContext: "kind-dev",
+		},
+		{
+			description: "minikube",
+			loadTo:      "minikube",
+			kubeContext: "minikube",
+			commands:    testutil.CmdRunOut("minikube image load app:1234 -p minikube", ""),
+		},
+		{
+			description: "minikube docker daemon",
+			loadTo:      "minikube",
+			kubeContext: "minikube",
+			dockerEnv:   []string{"MINIKUBE_ACTIVE_DOCKERD=minikube"},
+		},
+		{
+			description: "load error",
+			loadTo:      "minikube",
+			kubeContext: "minikube",
+			commands:    testutil.CmdRunOutErr("minikube image load app:1234 -p minikube", "", errors.New("dummy load error")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&cluster.GetClient, func() cluster.Client { return fakeMinikubeClient{} })
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+			b := &Builder{
+				cfg:         &mockBuilderContext{},
+				localDocker: docker.NewLocalDaemon(&testutil.FakeAPIClient{}, test.dockerEnv, false, nil),
+				kubeContext: test.kubeContext,
+				loadTo:      test.loadTo,
+			}
+
+			err := b.loadImage(context.Background(), io.Discard, "app:1234")
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
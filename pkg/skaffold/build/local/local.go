@@ -21,7 +21,9 @@ import (
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build"
+	dockerbuilder "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/docker"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/platform"
@@ -80,6 +82,19 @@ func (b *Builder) PushImages() bool {
 
 func (b *Builder) SupportedPlatforms() platform.Matcher { return platform.All }
 
+// SupportsMultiPlatformBuild returns true for docker artifacts that are pushed to a registry, since `docker buildx` can build
+// and push the manifest list in a single build. Buildx requires BuildKit, so it is not used if BuildKit is explicitly disabled.
+// Without buildx, the image of each platform is built separately and the manifest list is created afterwards.
+func (b *Builder) SupportsMultiPlatformBuild(a *latest.Artifact) bool {
+	if a.DockerArtifact == nil || !b.pushImages || (b.local.UseBuildkit != nil && !*b.local.UseBuildkit) {
+		return false
+	}
+	b.buildxOnce.Do(func() {
+		b.buildx = dockerbuilder.BuildxAvailable(context.TODO(), b.localDocker)
+	})
+	return b.buildx
+}
+
 func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, platforms platform.Matcher) (string, error) {
 	digestOrImageID, err := b.runBuildForArtifact(ctx, out, a, tag, platforms)
 	if err != nil {
@@ -89,7 +104,9 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 	if b.pushImages {
 		// only track images for pruning when building with docker
 		// if we're pushing a bazel image, it was built directly to the registry
-		if a.DockerArtifact != nil {
+		// multi-platform images are pushed directly from buildx and never loaded in the local daemon.
+		// images built on another docker host aren't in the local daemon either.
+		if a.DockerArtifact != nil && a.DockerArtifact.DockerHost == "" && !platforms.IsMultiPlatform() {
 			imageID, err := b.getImageIDForTag(ctx, tag)
 			if err != nil {
 				log.Entry(ctx).Warn("unable to inspect image: built images may not be cleaned up correctly by skaffold")
@@ -104,6 +121,14 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 	}
 
 	imageID := digestOrImageID
+	// images built on another docker host are tagged on that host, and can't be pruned or loaded from the local daemon.
+	if a.DockerArtifact != nil && a.DockerArtifact.DockerHost != "" {
+		remote, err := docker.NewHostAPIClient(ctx, b.cfg, a.DockerArtifact.DockerHost)
+		if err != nil {
+			return "", err
+		}
+		return build.TagWithImageID(ctx, tag, imageID, remote)
+	}
 	if b.mode == config.RunModes.Dev {
 		artifacts, err := b.artifactStore.GetArtifacts([]*latest.Artifact{a})
 		if err != nil {
@@ -126,7 +151,14 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 		}()
 	}
 	b.builtImages = append(b.builtImages, imageID)
-	return build.TagWithImageID(ctx, tag, imageID, b.localDocker)
+	ref, err := build.TagWithImageID(ctx, tag, imageID, b.localDocker)
+	if err != nil || ref == "" {
+		return ref, err
+	}
+	if err := b.loadImage(ctx, out, ref); err != nil {
+		return "", err
+	}
+	return ref, nil
 }
 
 func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, platforms platform.Matcher) (string, error) {
@@ -312,7 +312,7 @@ func TestLocalRun(t *testing.T) {
 			builder, err := NewBuilder(context.Background(), &mockBuilderContext{artifactStore: artifactStore, mode: test.mode}, &latest.LocalBuild{
 				Push:        util.Ptr(test.pushImages),
 				Concurrency: &constants.DefaultLocalConcurrency,
-			})
+			}, "")
 			t.CheckNoError(err)
 			ab := builder.Build(context.Background(), io.Discard, test.artifact)
 			res, err := ab(context.Background(), io.Discard, test.artifact, test.tag, platform.Matcher{})
@@ -333,6 +333,91 @@ func TestLocalRun(t *testing.T) {
 	}
 }
 
+func TestLocalRunOnDockerHost(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&docker.DefaultAuthHelper, testAuthHelper{})
+		t.Override(&docker.NewAPIClient, func(context.Context, docker.Config) (docker.LocalDaemon, error) {
+			// the image is only known to the docker host
+			return fakeLocalDaemon(&testutil.FakeAPIClient{ErrImageInspect: true}), nil
+		})
+		remote := &testutil.FakeAPIClient{}
+		t.Override(&docker.NewHostAPIClient, func(context.Context, docker.Config, string) (docker.LocalDaemon, error) {
+			return fakeLocalDaemon(remote), nil
+		})
+		t.Override(&docker.EvalBuildArgsWithEnv, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string, _ map[string]string) (map[string]*string, error) {
+			return args, nil
+		})
+		testEvent.InitializeState([]latest.Pipeline{{
+			Deploy: latest.DeployConfig{},
+			Build: latest.BuildConfig{
+				BuildType: latest.BuildType{
+					LocalBuild: &latest.LocalBuild{},
+				},
+			}}})
+		artifact := &latest.Artifact{
+			ImageName: "gcr.io/test/image",
+			ArtifactType: latest.ArtifactType{
+				DockerArtifact: &latest.DockerArtifact{DockerHost: "ssh://user@gpu-builder"},
+			},
+		}
+
+		builder, err := NewBuilder(context.Background(), &mockBuilderContext{artifactStore: mockArtifactStore{}}, &latest.LocalBuild{
+			Push:        util.Ptr(false),
+			Concurrency: &constants.DefaultLocalConcurrency,
+		}, "")
+		t.CheckNoError(err)
+		ab := builder.Build(context.Background(), io.Discard, artifact)
+		res, err := ab(context.Background(), io.Discard, artifact, "gcr.io/test/image:tag", platform.Matcher{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("gcr.io/test/image:1", res)
+		t.CheckEmpty(builder.builtImages)
+	})
+}
+
+func TestSupportsMultiPlatformBuild(t *testing.T) {
+	tests := []struct {
+		description string
+		pushImages  bool
+		useBuildkit *bool
+		buildxErr   error
+		expected    bool
+	}{
+		{
+			description: "buildx available",
+			pushImages:  true,
+			expected:    true,
+		},
+		{
+			description: "buildx not installed",
+			pushImages:  true,
+			buildxErr:   errors.New("'buildx' is not a docker command"),
+		},
+		{
+			description: "images not pushed",
+		},
+		{
+			description: "buildkit disabled",
+			pushImages:  true,
+			useBuildkit: util.Ptr(false),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOutErr("docker buildx version", "", test.buildxErr))
+			builder := &Builder{
+				local:       latest.LocalBuild{UseBuildkit: test.useBuildkit},
+				localDocker: fakeLocalDaemon(&testutil.FakeAPIClient{}),
+				pushImages:  test.pushImages,
+			}
+
+			supported := builder.SupportsMultiPlatformBuild(&latest.Artifact{ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}})
+
+			t.CheckDeepEqual(test.expected, supported)
+		})
+	}
+}
+
 type dummyLocalDaemon struct {
 	docker.LocalDaemon
 }
@@ -386,6 +471,17 @@ func TestNewBuilder(t *testing.T) {
 			shouldErr:    false,
 			expectedPush: false,
 		},
+		{
+			description: "pushImages becomes false when images are loaded into the cluster",
+			localDockerFn: func(context.Context, docker.Config) (docker.LocalDaemon, error) {
+				return dummyDaemon, nil
+			},
+			cluster: config.Cluster{PushImages: true},
+			localBuild: latest.LocalBuild{
+				LoadTo: "minikube",
+			},
+			expectedPush: false,
+		},
 		{
 			description: "pushImages defined in flags (--push=false), ignores config (local:push)",
 			localDockerFn: func(context.Context, docker.Config) (docker.LocalDaemon, error) {
@@ -409,7 +505,7 @@ func TestNewBuilder(t *testing.T) {
 				local:    test.localBuild,
 				cluster:  test.cluster,
 				pushFlag: test.pushFlag,
-			}, &test.localBuild)
+			}, &test.localBuild, test.localBuild.LoadTo)
 
 			t.CheckError(test.shouldErr, err)
 			if !test.shouldErr {
@@ -486,7 +582,7 @@ func TestGetArtifactBuilder(t *testing.T) {
 				return args, nil
 			})
 
-			b, err := NewBuilder(context.Background(), &mockBuilderContext{artifactStore: build.NewArtifactStore()}, &latest.LocalBuild{Concurrency: &constants.DefaultLocalConcurrency})
+			b, err := NewBuilder(context.Background(), &mockBuilderContext{artifactStore: build.NewArtifactStore()}, &latest.LocalBuild{Concurrency: &constants.DefaultLocalConcurrency}, "")
 			t.CheckNoError(err)
 
 			builder, err := newPerArtifactBuilder(b, test.artifact)
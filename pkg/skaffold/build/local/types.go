@@ -20,8 +20,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/apko"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/bazel"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/custom"
@@ -46,6 +48,7 @@ type Builder struct {
 	localDocker        docker.LocalDaemon
 	localCluster       bool
 	pushImages         bool
+	loadTo             string
 	tryImportMissing   bool
 	prune              bool
 	pruneChildren      bool
@@ -58,6 +61,9 @@ type Builder struct {
 	localPruner        *pruner
 	artifactStore      build.ArtifactStore
 	sourceDependencies graph.SourceDependenciesCache
+
+	buildxOnce sync.Once
+	buildx     bool
 }
 
 type Config interface {
@@ -80,7 +86,8 @@ type BuilderContext interface {
 }
 
 // NewBuilder returns an new instance of a local Builder.
-func NewBuilder(ctx context.Context, bCtx BuilderContext, buildCfg *latest.LocalBuild) (*Builder, error) {
+// loadTo is the cluster the built images are loaded into, as resolved by ResolveLoadTo from the `loadTo` of the config.
+func NewBuilder(ctx context.Context, bCtx BuilderContext, buildCfg *latest.LocalBuild, loadTo string) (*Builder, error) {
 	localDocker, err := docker.NewAPIClient(ctx, bCtx)
 	if err != nil {
 		return nil, fmt.Errorf("getting docker client: %w", err)
@@ -94,6 +101,9 @@ func NewBuilder(ctx context.Context, bCtx BuilderContext, buildCfg *latest.Local
 	case pushFlag.Value() != nil:
 		pushImages = *pushFlag.Value()
 		log.Entry(context.TODO()).Debugf("push value set via skaffold build --push flag, --push=%t", *pushFlag.Value())
+	case loadTo != "":
+		pushImages = false
+		log.Entry(context.TODO()).Debugf("images are loaded into the %s cluster instead of being pushed", loadTo)
 	case buildCfg.Push == nil:
 		pushImages = cluster.PushImages
 		log.Entry(context.TODO()).Debugf("push value not present in NewBuilder, defaulting to %t because cluster.PushImages is %t", pushImages, cluster.PushImages)
@@ -110,6 +120,7 @@ func NewBuilder(ctx context.Context, bCtx BuilderContext, buildCfg *latest.Local
 		localDocker:        localDocker,
 		localCluster:       cluster.Local,
 		pushImages:         pushImages,
+		loadTo:             loadTo,
 		tryImportMissing:   tryImportMissing,
 		skipTests:          bCtx.SkipTests(),
 		mode:               bCtx.Mode(),
@@ -152,6 +163,9 @@ func newPerArtifactBuilder(b *Builder, a *latest.Artifact) (artifactBuilder, err
 	case a.KoArtifact != nil:
 		return ko.NewArtifactBuilder(b.localDocker, b.pushImages, b.mode, b.insecureRegistries), nil
 
+	case a.ApkoArtifact != nil:
+		return apko.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages), nil
+
 	default:
 		return nil, fmt.Errorf("unexpected type %q for local artifact:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
 	}
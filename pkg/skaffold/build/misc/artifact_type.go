@@ -32,6 +32,7 @@ const (
 	Custom    = "custom"
 	Buildpack = "buildpack"
 	Ko        = "ko"
+	Apko      = "apko"
 )
 
 // ArtifactType returns a string representing the type found in an artifact. Used for error messages.
@@ -52,6 +53,8 @@ func ArtifactType(a *latest.Artifact) string {
 		return Buildpack
 	case a.KoArtifact != nil:
 		return Ko
+	case a.ApkoArtifact != nil:
+		return Apko
 	default:
 		return ""
 	}
@@ -34,7 +34,7 @@ func SupportsMultiPlatformBuild(a latest.Artifact) bool {
 	switch {
 	case a.DockerArtifact != nil || a.BazelArtifact != nil || a.BuildpackArtifact != nil:
 		return false
-	case a.JibArtifact != nil || a.CustomArtifact != nil || a.KoArtifact != nil:
+	case a.JibArtifact != nil || a.CustomArtifact != nil || a.KoArtifact != nil || a.ApkoArtifact != nil:
 		return true
 	default:
 		return false
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provenance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/version"
+)
+
+// Format is the provenance predicate format written for each artifact.
+type Format string
+
+const (
+	// SLSAv1 writes a https://slsa.dev/provenance/v1 predicate.
+	SLSAv1 Format = "slsa-v1"
+	// SLSAv02 writes a https://slsa.dev/provenance/v0.2 predicate.
+	SLSAv02 Format = "slsa-v0.2"
+
+	statementType = "https://in-toto.io/Statement/v0.1"
+	buildType     = "https://skaffold.dev/provenance/build@v1"
+	builderID     = "https://skaffold.dev/skaffold"
+)
+
+// Formats lists the supported provenance formats.
+var Formats = []Format{SLSAv1, SLSAv02}
+
+// ParseFormat validates and returns the provenance format. An empty string selects SLSA v1.
+func ParseFormat(s string) (Format, error) {
+	if s == "" {
+		return SLSAv1, nil
+	}
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported provenance format %q, must be one of %v", s, Formats)
+}
+
+// Statement is an in-toto attestation statement.
+type Statement struct {
+	Type          string      `json:"_type"`
+	Subject       []Subject   `json:"subject"`
+	PredicateType string      `json:"predicateType"`
+	Predicate     interface{} `json:"predicate"`
+}
+
+// Subject identifies the built image by name and digest.
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Material is an input to the build, such as the source repository.
+type Material struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+type predicateV1 struct {
+	BuildDefinition buildDefinition `json:"buildDefinition"`
+	RunDetails      runDetails      `json:"runDetails"`
+}
+
+type buildDefinition struct {
+	BuildType            string                 `json:"buildType"`
+	ExternalParameters   map[string]interface{} `json:"externalParameters"`
+	ResolvedDependencies []Material             `json:"resolvedDependencies,omitempty"`
+}
+
+type runDetails struct {
+	Builder  builder  `json:"builder"`
+	Metadata metadata `json:"metadata"`
+}
+
+type builder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+type metadata struct {
+	StartedOn  *time.Time `json:"startedOn,omitempty"`
+	FinishedOn *time.Time `json:"finishedOn,omitempty"`
+}
+
+type predicateV02 struct {
+	Builder    builder                `json:"builder"`
+	BuildType  string                 `json:"buildType"`
+	Invocation map[string]interface{} `json:"invocation"`
+	Metadata   metadataV02            `json:"metadata"`
+	Materials  []Material             `json:"materials,omitempty"`
+}
+
+type metadataV02 struct {
+	BuildStartedOn  *time.Time `json:"buildStartedOn,omitempty"`
+	BuildFinishedOn *time.Time `json:"buildFinishedOn,omitempty"`
+}
+
+// SourceResolver returns the materials describing the source an artifact was built from.
+type SourceResolver func(ctx context.Context, workspace string) []Material
+
+// Assembler generates provenance documents for built artifacts, independently of the builder type.
+type Assembler struct {
+	format Format
+	dir    string
+	source SourceResolver
+}
+
+// NewAssembler returns an Assembler which writes provenance documents of the given format into dir.
+func NewAssembler(format Format, dir string) *Assembler {
+	return &Assembler{
+		format: format,
+		dir:    dir,
+		source: gitSource,
+	}
+}
+
+// Write generates and writes one provenance document per built artifact, keyed by image digest.
+// Artifacts without a digest (e.g. images that were neither pushed nor loaded) are skipped.
+// buildTimes holds when the artifacts built by this run started and finished building; cached artifacts have no build time.
+func (a *Assembler) Write(ctx context.Context, artifacts []*latest.Artifact, builds []graph.Artifact, buildTimes map[string]timing.Span) error {
+	if err := os.MkdirAll(a.dir, 0755); err != nil {
+		return fmt.Errorf("creating provenance output directory: %w", err)
+	}
+	byName := map[string]*latest.Artifact{}
+	for _, artifact := range artifacts {
+		byName[artifact.ImageName] = artifact
+	}
+	for _, b := range builds {
+		artifact, found := byName[b.ImageName]
+		if !found {
+			continue
+		}
+		var buildTime *timing.Span
+		if span, found := buildTimes[b.ImageName]; found {
+			buildTime = &span
+		}
+		st, err := a.Assemble(ctx, artifact, b, buildTime)
+		if err != nil {
+			log.Entry(ctx).Warnf("skipping provenance for image %q: %v", b.ImageName, err)
+			continue
+		}
+		buf, err := json.MarshalIndent(st, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshalling provenance for %q: %w", b.ImageName, err)
+		}
+		fileName := filepath.Join(a.dir, fileNameFor(st.Subject[0]))
+		if err := os.WriteFile(fileName, buf, 0644); err != nil {
+			return fmt.Errorf("writing provenance for %q: %w", b.ImageName, err)
+		}
+		log.Entry(ctx).Debugf("wrote provenance for %q to %s", b.ImageName, fileName)
+	}
+	return nil
+}
+
+// Assemble creates the provenance statement for a single built artifact.
+// The build times are left unset without a build time, for artifacts retrieved from the cache.
+func (a *Assembler) Assemble(ctx context.Context, artifact *latest.Artifact, b graph.Artifact, buildTime *timing.Span) (*Statement, error) {
+	ref, err := docker.ParseReference(b.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Digest == "" {
+		return nil, fmt.Errorf("image %q has no digest", b.Tag)
+	}
+	alg, hex, found := strings.Cut(ref.Digest, ":")
+	if !found {
+		return nil, fmt.Errorf("invalid digest %q", ref.Digest)
+	}
+	subject := Subject{Name: ref.BaseName, Digest: map[string]string{alg: hex}}
+
+	params := map[string]interface{}{
+		"builder":   misc.ArtifactType(artifact),
+		"image":     artifact.ImageName,
+		"workspace": artifact.Workspace,
+	}
+	if args := buildArgs(artifact); args != nil {
+		params["buildArgs"] = args
+	}
+	materials := a.source(ctx, artifact.Workspace)
+	var started, finished *time.Time
+	if buildTime != nil {
+		started, finished = &buildTime.Start, &buildTime.End
+	}
+	sb := builder{ID: builderID, Version: map[string]string{"skaffold": version.Get().Version}}
+
+	st := &Statement{Type: statementType, Subject: []Subject{subject}}
+	switch a.format {
+	case SLSAv02:
+		st.PredicateType = "https://slsa.dev/provenance/v0.2"
+		st.Predicate = predicateV02{
+			Builder:    sb,
+			BuildType:  buildType,
+			Invocation: map[string]interface{}{"parameters": params},
+			Metadata:   metadataV02{BuildStartedOn: started, BuildFinishedOn: finished},
+			Materials:  materials,
+		}
+	default:
+		st.PredicateType = "https://slsa.dev/provenance/v1"
+		st.Predicate = predicateV1{
+			BuildDefinition: buildDefinition{
+				BuildType:            buildType,
+				ExternalParameters:   params,
+				ResolvedDependencies: materials,
+			},
+			RunDetails: runDetails{
+				Builder:  sb,
+				Metadata: metadata{StartedOn: started, FinishedOn: finished},
+			},
+		}
+	}
+	return st, nil
+}
+
+// buildArgs returns the builder-specific arguments recorded in the provenance, or nil if there are none.
+func buildArgs(a *latest.Artifact) interface{} {
+	var args []string
+	switch {
+	case a.DockerArtifact != nil:
+		return stringMap(a.DockerArtifact.BuildArgs)
+	case a.KanikoArtifact != nil:
+		return stringMap(a.KanikoArtifact.BuildArgs)
+	case a.BazelArtifact != nil:
+		args = a.BazelArtifact.BuildArgs
+	case a.KoArtifact != nil:
+		args = append(append(args, a.KoArtifact.Flags...), a.KoArtifact.Ldflags...)
+	case a.ApkoArtifact != nil:
+		args = a.ApkoArtifact.Args
+	case a.BuildpackArtifact != nil:
+		args = a.BuildpackArtifact.Env
+	case a.CustomArtifact != nil && a.CustomArtifact.BuildCommand != "":
+		args = []string{a.CustomArtifact.BuildCommand}
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	return args
+}
+
+func stringMap(m map[string]*string) interface{} {
+	if len(m) == 0 {
+		return nil
+	}
+	res := map[string]string{}
+	for k, v := range m {
+		if v == nil {
+			res[k] = ""
+			continue
+		}
+		res[k] = *v
+	}
+	return res
+}
+
+// gitSource records the git remote and commit of the artifact workspace, when it is a git repository.
+func gitSource(ctx context.Context, workspace string) []Material {
+	commit, err := runGit(ctx, workspace, "rev-list", "-1", "HEAD")
+	if err != nil {
+		return nil
+	}
+	uri, err := runGit(ctx, workspace, "config", "--get", "remote.origin.url")
+	if err != nil || uri == "" {
+		uri, _ = filepath.Abs(workspace)
+	}
+	return []Material{{URI: "git+" + uri, Digest: map[string]string{"sha1": commit}}}
+}
+
+func runGit(ctx context.Context, workingDir string, arg ...string) (string, error) {
+	cmd := exec.Command("git", arg...)
+	cmd.Dir = workingDir
+	out, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(out)), nil
+}
+
+func fileNameFor(s Subject) string {
+	algs := make([]string, 0, len(s.Digest))
+	for alg := range s.Digest {
+		algs = append(algs, alg)
+	}
+	sort.Strings(algs)
+	name := strings.NewReplacer("/", "_", ":", "_").Replace(s.Name)
+	return fmt.Sprintf("%s@%s-%s.intoto.json", name, algs[0], s.Digest[algs[0]])
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		description string
+		value       string
+		expected    Format
+		shouldErr   bool
+	}{
+		{description: "default", value: "", expected: SLSAv1},
+		{description: "slsa v1", value: "slsa-v1", expected: SLSAv1},
+		{description: "slsa v0.2", value: "slsa-v0.2", expected: SLSAv02},
+		{description: "unknown", value: "spdx", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			f, err := ParseFormat(test.value)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, f)
+		})
+	}
+}
+
+func TestAssemble(t *testing.T) {
+	artifact := &latest.Artifact{
+		ImageName: "app",
+		Workspace: "./app",
+		ArtifactType: latest.ArtifactType{
+			DockerArtifact: &latest.DockerArtifact{BuildArgs: map[string]*string{"FOO": util.Ptr("bar")}},
+		},
+	}
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	end := start.Add(time.Minute)
+	tests := []struct {
+		description   string
+		format        Format
+		tag           string
+		predicateType string
+		shouldErr     bool
+	}{
+		{
+			description:   "slsa v1",
+			format:        SLSAv1,
+			tag:           "gcr.io/project/app:v1@" + digest,
+			predicateType: "https://slsa.dev/provenance/v1",
+		},
+		{
+			description:   "slsa v0.2",
+			format:        SLSAv02,
+			tag:           "gcr.io/project/app:v1@" + digest,
+			predicateType: "https://slsa.dev/provenance/v0.2",
+		},
+		{
+			description: "no digest",
+			format:      SLSAv1,
+			tag:         "gcr.io/project/app:v1",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			a := NewAssembler(test.format, "")
+			a.source = func(context.Context, string) []Material {
+				return []Material{{URI: "git+https://github.com/org/repo", Digest: map[string]string{"sha1": "abc"}}}
+			}
+			st, err := a.Assemble(context.Background(), artifact, graph.Artifact{ImageName: "app", Tag: test.tag}, &timing.Span{Start: start, End: end})
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual(test.predicateType, st.PredicateType)
+			t.CheckDeepEqual([]Subject{{Name: "gcr.io/project/app", Digest: map[string]string{"sha256": digest[len("sha256:"):]}}}, st.Subject)
+
+			buf, err := json.Marshal(st.Predicate)
+			t.CheckNoError(err)
+			t.CheckContains(`"buildArgs":{"FOO":"bar"}`, string(buf))
+			t.CheckContains(`"builder":"docker"`, string(buf))
+			t.CheckContains(`"uri":"git+https://github.com/org/repo"`, string(buf))
+			finished, _ := json.Marshal(end)
+			t.CheckContains(string(finished), string(buf))
+		})
+	}
+}
+
+func TestAssembleCachedArtifact(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		a := NewAssembler(SLSAv1, "")
+		a.source = func(context.Context, string) []Material { return nil }
+
+		st, err := a.Assemble(context.Background(), &latest.Artifact{ImageName: "app"}, graph.Artifact{ImageName: "app", Tag: "gcr.io/project/app:v1@" + digest}, nil)
+		t.CheckNoError(err)
+		buf, err := json.Marshal(st.Predicate)
+		t.CheckNoError(err)
+		t.CheckFalse(strings.Contains(string(buf), "startedOn"))
+		t.CheckFalse(strings.Contains(string(buf), "finishedOn"))
+	})
+}
+
+func TestWrite(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+		a := NewAssembler(SLSAv1, dir.Root())
+		a.source = func(context.Context, string) []Material { return nil }
+
+		artifacts := []*latest.Artifact{{ImageName: "app"}, {ImageName: "local"}}
+		builds := []graph.Artifact{
+			{ImageName: "app", Tag: "gcr.io/project/app:v1@" + digest},
+			{ImageName: "local", Tag: "local:v1"},
+		}
+		err := a.Write(context.Background(), artifacts, builds, map[string]timing.Span{"app": {Start: time.Now(), End: time.Now()}})
+		t.CheckNoError(err)
+
+		files, err := os.ReadDir(dir.Root())
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(files))
+		t.CheckFileExist(filepath.Join(dir.Root(), "gcr.io_project_app@sha256-"+digest[len("sha256:"):]+".intoto.json"))
+	})
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+)
+
+const (
+	// DefaultCommand writes an SPDX SBOM of the built image to stdout with syft.
+	DefaultCommand = "syft $SKAFFOLD_IMAGE -o spdx-json"
+	// defaultWindowsCommand is the DefaultCommand for cmd.exe, which doesn't expand `$VAR`.
+	defaultWindowsCommand = "syft %SKAFFOLD_IMAGE% -o spdx-json"
+)
+
+// Generator runs an SBOM tool on each built image, and writes the SBOMs it prints to a directory.
+type Generator struct {
+	command string
+	dir     string
+	attach  bool
+}
+
+// NewGenerator returns a Generator which writes the SBOMs printed by command into dir.
+// With attach, the SBOMs are also attached to the images in their registry with cosign.
+func NewGenerator(command, dir string, attach bool) *Generator {
+	if command == "" {
+		command = defaultCommand()
+	}
+	return &Generator{
+		command: command,
+		dir:     dir,
+		attach:  attach,
+	}
+}
+
+// Generate writes the SBOM of each built image and records its location in the build.
+// The SBOM files are named after the image tags. When the tag includes the image digest, an SBOM already written
+// for the same image, for example by a previous run for an artifact found in the cache, is reused. It's only attached
+// again if the registry has no SBOM for the image, for example because the previous attach failed.
+// Without a digest, e.g. with `--digest-source=tag` or a mutable tag such as `latest`, the SBOM is always generated again.
+func (g *Generator) Generate(ctx context.Context, builds []graph.Artifact) error {
+	if err := os.MkdirAll(g.dir, 0755); err != nil {
+		return fmt.Errorf("creating SBOM output directory: %w", err)
+	}
+	for i, b := range builds {
+		fileName := filepath.Join(g.dir, fileNameFor(b.Tag))
+		if _, err := os.Stat(fileName); err == nil && strings.Contains(b.Tag, "@sha256:") {
+			log.Entry(ctx).Debugf("reusing SBOM for %q from %s", b.ImageName, fileName)
+			if g.attach && !hasAttachedSBOM(ctx, b.Tag) {
+				if err := attachSBOM(ctx, fileName, b.Tag); err != nil {
+					return err
+				}
+			}
+			builds[i].SBOM = fileName
+			continue
+		}
+		sbom, err := g.run(ctx, b.Tag)
+		if err != nil {
+			return fmt.Errorf("generating SBOM for %q: %w", b.ImageName, err)
+		}
+		if err := os.WriteFile(fileName, sbom, 0644); err != nil {
+			return fmt.Errorf("writing SBOM for %q: %w", b.ImageName, err)
+		}
+		log.Entry(ctx).Debugf("wrote SBOM for %q to %s", b.ImageName, fileName)
+
+		if g.attach {
+			if err := attachSBOM(ctx, fileName, b.Tag); err != nil {
+				return err
+			}
+		}
+		builds[i].SBOM = fileName
+	}
+	return nil
+}
+
+// attachSBOM attaches the SBOM file to the image in its registry with cosign.
+func attachSBOM(ctx context.Context, fileName, image string) error {
+	cmd := exec.CommandContext(ctx, "cosign", "attach", "sbom", "--sbom", fileName, image)
+	if err := util.RunCmd(ctx, cmd); err != nil {
+		return fmt.Errorf("attaching SBOM to %q: %w", image, err)
+	}
+	return nil
+}
+
+// hasAttachedSBOM returns true if an SBOM is attached to the image in its registry.
+func hasAttachedSBOM(ctx context.Context, image string) bool {
+	cmd := exec.CommandContext(ctx, "cosign", "download", "sbom", image)
+	if _, err := util.RunCmdOut(ctx, cmd); err != nil {
+		log.Entry(ctx).Debugf("no SBOM attached to %q: %v", image, err)
+		return false
+	}
+	return true
+}
+
+// run runs the SBOM command with the image in the `SKAFFOLD_IMAGE` environment variable, and returns its output.
+func (g *Generator) run(ctx context.Context, image string) ([]byte, error) {
+	var cmd *exec.Cmd
+	// We evaluate the command with a shell so that it can contain
+	// env variables and pipes.
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", g.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", g.command)
+	}
+	cmd.Env = append(util.OSEnviron(), "SKAFFOLD_IMAGE="+image)
+
+	log.Entry(ctx).Debugf("Generating SBOM with: %s", g.command)
+	out, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("running SBOM command %q: %w", g.command, err)
+	}
+	if len(strings.TrimSpace(string(out))) == 0 {
+		return nil, fmt.Errorf("SBOM command %q returned no SBOM", g.command)
+	}
+	return out, nil
+}
+
+// defaultCommand returns the DefaultCommand for the shell that runs it on this platform.
+func defaultCommand() string {
+	if runtime.GOOS == "windows" {
+		return defaultWindowsCommand
+	}
+	return DefaultCommand
+}
+
+func fileNameFor(tag string) string {
+	return strings.NewReplacer("/", "_", ":", "_").Replace(tag) + ".sbom.json"
+}
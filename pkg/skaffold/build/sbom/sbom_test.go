@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+const (
+	tag  = "gcr.io/project/app:v1@sha256:abc"
+	spdx = `{"spdxVersion":"SPDX-2.3"}`
+
+	fileName = "gcr.io_project_app_v1@sha256_abc.sbom.json"
+)
+
+func TestGenerate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the SBOM command runs with cmd.exe on windows")
+	}
+	tests := []struct {
+		description string
+		attach      bool
+		commands    func(dir string) util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "write sbom",
+			commands: func(string) util.Command {
+				return testutil.CmdRunOut("sh -c "+DefaultCommand, spdx)
+			},
+		},
+		{
+			description: "write and attach sbom",
+			attach:      true,
+			commands: func(dir string) util.Command {
+				return testutil.CmdRunOut("sh -c "+DefaultCommand, spdx).
+					AndRun("cosign attach sbom --sbom " + filepath.Join(dir, fileName) + " " + tag)
+			},
+		},
+		{
+			description: "command fails",
+			commands: func(string) util.Command {
+				return testutil.CmdRunOutErr("sh -c "+DefaultCommand, "", errors.New("syft: command not found"))
+			},
+			shouldErr: true,
+		},
+		{
+			description: "empty sbom",
+			commands: func(string) util.Command {
+				return testutil.CmdRunOut("sh -c "+DefaultCommand, "\n")
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			dir := t.NewTempDir()
+			t.Override(&util.DefaultExecCommand, test.commands(dir.Root()))
+			builds := []graph.Artifact{{ImageName: "app", Tag: tag}}
+
+			err := NewGenerator("", dir.Root(), test.attach).Generate(context.Background(), builds)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				expected := filepath.Join(dir.Root(), fileName)
+				t.CheckDeepEqual(expected, builds[0].SBOM)
+				t.CheckFileExistAndContent(expected, []byte(spdx))
+			}
+		})
+	}
+}
+
+func TestGenerateReusesSBOM(t *testing.T) {
+	tests := []struct {
+		description   string
+		attach        bool
+		commands      func(dir string) *testutil.FakeCmd
+		expectedCalls int
+	}{
+		{
+			description: "without attach",
+			commands: func(string) *testutil.FakeCmd {
+				return testutil.CmdRunOut("sh -c "+DefaultCommand, "{}")
+			},
+		},
+		{
+			description: "sbom attached in the registry",
+			attach:      true,
+			commands: func(string) *testutil.FakeCmd {
+				return testutil.CmdRunOut("cosign download sbom "+tag, spdx)
+			},
+			expectedCalls: 1,
+		},
+		{
+			description: "sbom missing from the registry is attached again",
+			attach:      true,
+			commands: func(dir string) *testutil.FakeCmd {
+				return testutil.CmdRunOutErr("cosign download sbom "+tag, "", errors.New("no sbom attached to the image")).
+					AndRun("cosign attach sbom --sbom " + filepath.Join(dir, fileName) + " " + tag)
+			},
+			expectedCalls: 2,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			dir := t.NewTempDir().Write(fileName, spdx)
+			cmd := test.commands(dir.Root())
+			t.Override(&util.DefaultExecCommand, cmd)
+			builds := []graph.Artifact{{ImageName: "app", Tag: tag}}
+
+			err := NewGenerator("", dir.Root(), test.attach).Generate(context.Background(), builds)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedCalls, cmd.TimesCalled())
+			t.CheckDeepEqual(filepath.Join(dir.Root(), fileName), builds[0].SBOM)
+			t.CheckFileExistAndContent(filepath.Join(dir.Root(), fileName), []byte(spdx))
+		})
+	}
+}
+
+func TestGenerateRegeneratesSBOMWithoutDigest(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the SBOM command runs with cmd.exe on windows")
+	}
+	testutil.Run(t, "", func(t *testutil.T) {
+		const mutableTag = "gcr.io/project/app:latest"
+		mutableFileName := fileNameFor(mutableTag)
+		dir := t.NewTempDir().Write(mutableFileName, `{"spdxVersion":"SPDX-2.2"}`)
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("sh -c "+DefaultCommand, spdx))
+		builds := []graph.Artifact{{ImageName: "app", Tag: mutableTag}}
+
+		err := NewGenerator("", dir.Root(), false).Generate(context.Background(), builds)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(filepath.Join(dir.Root(), mutableFileName), builds[0].SBOM)
+		t.CheckFileExistAndContent(filepath.Join(dir.Root(), mutableFileName), []byte(spdx))
+	})
+}
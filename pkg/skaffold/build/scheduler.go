@@ -25,6 +25,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/event"
@@ -118,7 +119,9 @@ func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, platforms pla
 
 	w, ctx = output.WithEventContext(ctx, w, constants.Build, a.ImageName)
 	output.Default.Fprintf(w, "Building [%s]...\n", a.ImageName)
+	stopBuild := timing.Start(ctx, a.ImageName, timing.Build)
 	finalTag, err := performBuild(ctx, w, tags, platforms, a, s.artifactBuilder)
+	stopBuild()
 	if err != nil {
 		event.BuildFailed(a.ImageName, platforms.GetPlatforms(a.ImageName).String(), err)
 		endTrace(instrumentation.TraceEndError(err))
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Phase is a step of getting an artifact built and pushed.
+type Phase string
+
+const (
+	// CacheCheck is the time spent hashing the artifact and looking it up in the cache.
+	CacheCheck Phase = "cache check"
+	// Context is the time spent creating and uploading the build context.
+	Context Phase = "context"
+	// Build is the time spent in the builder, excluding the context upload and push.
+	Build Phase = "build"
+	// Push is the time spent pushing the image to a registry.
+	Push Phase = "push"
+)
+
+// Phases are the build phases in the order they are reported.
+var Phases = []Phase{CacheCheck, Context, Build, Push}
+
+type contextKey struct{}
+
+// Span is the time between the first start and the last end of a phase of an artifact.
+type Span struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Recorder records the time spent in each build phase for every artifact.
+// A nil Recorder records nothing.
+type Recorder struct {
+	mu        sync.Mutex
+	durations map[string]map[Phase]time.Duration
+	spans     map[string]map[Phase]Span
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		durations: map[string]map[Phase]time.Duration{},
+		spans:     map[string]map[Phase]Span{},
+	}
+}
+
+// WithRecorder returns a context that carries the recorder.
+func WithRecorder(ctx context.Context, r *Recorder) context.Context {
+	return context.WithValue(ctx, contextKey{}, r)
+}
+
+// FromContext returns the recorder carried by the context, or nil.
+func FromContext(ctx context.Context) *Recorder {
+	r, _ := ctx.Value(contextKey{}).(*Recorder)
+	return r
+}
+
+// Start starts timing a phase of an artifact with the recorder carried by the context.
+// The returned function stops the timer and must be called once the phase is over.
+func Start(ctx context.Context, artifact string, phase Phase) func() {
+	r := FromContext(ctx)
+	if r == nil {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		end := time.Now()
+		r.Record(artifact, phase, end.Sub(start))
+		r.recordSpan(artifact, phase, start, end)
+	}
+}
+
+func (r *Recorder) recordSpan(artifact string, phase Phase, start, end time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.spans[artifact] == nil {
+		r.spans[artifact] = map[Phase]Span{}
+	}
+	span, found := r.spans[artifact][phase]
+	if !found || start.Before(span.Start) {
+		span.Start = start
+	}
+	if end.After(span.End) {
+		span.End = end
+	}
+	r.spans[artifact][phase] = span
+}
+
+// Span returns when the artifact started and finished the phase, and false if the phase wasn't timed.
+func (r *Recorder) Span(artifact string, phase Phase) (Span, bool) {
+	if r == nil {
+		return Span{}, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	span, found := r.spans[artifact][phase]
+	return span, found
+}
+
+// Record adds the duration to the time spent by the artifact in the phase.
+func (r *Recorder) Record(artifact string, phase Phase, d time.Duration) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.durations[artifact] == nil {
+		r.durations[artifact] = map[Phase]time.Duration{}
+	}
+	r.durations[artifact][phase] += d
+}
+
+// Durations returns the time spent by the artifact in each recorded phase.
+// The recorded build time includes the context upload and push that happen within the builder,
+// so they are subtracted from it.
+func (r *Recorder) Durations(artifact string) map[Phase]time.Duration {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recorded, found := r.durations[artifact]
+	if !found {
+		return nil
+	}
+	durations := make(map[Phase]time.Duration, len(recorded))
+	for phase, d := range recorded {
+		durations[phase] = d
+	}
+	if b, found := durations[Build]; found {
+		durations[Build] = max(0, b-durations[Context]-durations[Push])
+	}
+	return durations
+}
+
+// Print writes a table of the time spent in each phase by the artifacts.
+func (r *Recorder) Print(out io.Writer, artifacts []string) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "ARTIFACT")
+	for _, phase := range Phases {
+		fmt.Fprintf(w, "\t%s", phase)
+	}
+	fmt.Fprintln(w, "\ttotal")
+
+	for _, artifact := range artifacts {
+		durations := r.Durations(artifact)
+		var total time.Duration
+		fmt.Fprint(w, artifact)
+		for _, phase := range Phases {
+			d, found := durations[phase]
+			if !found {
+				fmt.Fprint(w, "\t-")
+				continue
+			}
+			total += d
+			fmt.Fprintf(w, "\t%s", format(d))
+		}
+		fmt.Fprintf(w, "\t%s\n", format(total))
+	}
+	return w.Flush()
+}
+
+func format(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timing
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestDurations(t *testing.T) {
+	tests := []struct {
+		description string
+		recorded    map[Phase]time.Duration
+		expected    map[Phase]time.Duration
+	}{
+		{
+			description: "nothing recorded",
+		},
+		{
+			description: "built and pushed",
+			recorded:    map[Phase]time.Duration{CacheCheck: time.Second, Context: 8 * time.Second, Build: 13 * time.Second, Push: 3 * time.Second},
+			expected:    map[Phase]time.Duration{CacheCheck: time.Second, Context: 8 * time.Second, Build: 2 * time.Second, Push: 3 * time.Second},
+		},
+		{
+			description: "cached and pushed",
+			recorded:    map[Phase]time.Duration{CacheCheck: time.Second, Push: 3 * time.Second},
+			expected:    map[Phase]time.Duration{CacheCheck: time.Second, Push: 3 * time.Second},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			r := NewRecorder()
+			for phase, d := range test.recorded {
+				r.Record("app", phase, d)
+			}
+
+			t.CheckDeepEqual(test.expected, r.Durations("app"))
+		})
+	}
+}
+
+func TestRecordAccumulates(t *testing.T) {
+	r := NewRecorder()
+	r.Record("app", Context, time.Second)
+	r.Record("app", Context, 2*time.Second)
+
+	testutil.CheckDeepEqual(t, map[Phase]time.Duration{Context: 3 * time.Second}, r.Durations("app"))
+}
+
+func TestStart(t *testing.T) {
+	testutil.Run(t, "with recorder", func(t *testutil.T) {
+		r := NewRecorder()
+		stop := Start(WithRecorder(context.Background(), r), "app", Push)
+		stop()
+
+		_, found := r.Durations("app")[Push]
+		t.CheckTrue(found)
+		span, found := r.Span("app", Push)
+		t.CheckTrue(found)
+		t.CheckFalse(span.End.Before(span.Start))
+		_, found = r.Span("app", Build)
+		t.CheckFalse(found)
+	})
+	testutil.Run(t, "without recorder", func(t *testutil.T) {
+		Start(context.Background(), "app", Push)()
+
+		t.CheckNil(FromContext(context.Background()))
+		t.CheckEmpty(FromContext(context.Background()).Durations("app"))
+		_, found := FromContext(context.Background()).Span("app", Push)
+		t.CheckFalse(found)
+	})
+}
+
+func TestSpanCoversEveryTiming(t *testing.T) {
+	r := NewRecorder()
+	start := time.Now()
+	r.recordSpan("app", Build, start.Add(time.Second), start.Add(2*time.Second))
+	r.recordSpan("app", Build, start, start.Add(time.Second))
+
+	span, found := r.Span("app", Build)
+	testutil.CheckDeepEqual(t, true, found)
+	testutil.CheckDeepEqual(t, Span{Start: start, End: start.Add(2 * time.Second)}, span)
+}
+
+func TestPrint(t *testing.T) {
+	r := NewRecorder()
+	r.Record("app", CacheCheck, 100*time.Millisecond)
+	r.Record("app", Context, 8*time.Second)
+	r.Record("app", Build, 10*time.Second)
+	r.Record("cached", CacheCheck, 50*time.Millisecond)
+
+	var out bytes.Buffer
+	err := r.Print(&out, []string{"app", "cached"})
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, `ARTIFACT  cache check  context  build  push  total
+app       100ms        8s       2s     -     10.1s
+cached    50ms         -        -      -     50ms
+`, out.String())
+}
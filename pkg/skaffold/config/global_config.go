@@ -39,6 +39,8 @@ type ContextConfig struct {
 	K3dDisableLoad       *bool         `yaml:"k3d-disable-load,omitempty"`
 	CollectMetrics       *bool         `yaml:"collect-metrics,omitempty"`
 	UpdateCheckConfig    *UpdateConfig `yaml:"update,omitempty"`
+	// UDPRelayImage is the image of the relay pod used to port-forward UDP ports.
+	UDPRelayImage string `yaml:"udp-relay-image,omitempty"`
 }
 
 // SurveyConfig is the survey config information
@@ -17,6 +17,7 @@ limitations under the License.
 package config
 
 import (
+	"slices"
 	"strings"
 	"time"
 
@@ -37,13 +38,18 @@ type SkaffoldOptions struct {
 	AutoCreateConfig            bool
 	AutoDeploy                  bool
 	AutoSync                    bool
+	AllowBaseDrift              bool
 	AssumeYes                   bool
 	CacheArtifacts              bool
+	CacheContentTags            bool
 	ContainerDebugging          bool
 	Cleanup                     bool
 	DetectMinikube              bool
 	DryRun                      bool
+	ProfileTimings              bool
 	EnableRPC                   bool
+	ExpandEnv                   bool
+	ExpandEnvStrict             bool
 	Force                       bool
 	ForceLoadImages             bool
 	IterativeStatusCheck        bool
@@ -58,7 +64,16 @@ type SkaffoldOptions struct {
 	RenderOnly                  bool
 	SkipTests                   bool
 	SkipConfigDefaults          bool
+	StatusCheckTail             bool
+	StatusCheckQuiet            bool
+	StatusCheckAllNamespaces    bool
+	StatusCheckAdaptivePoll     bool
+	StatusCheckWaitForHPA       bool
+	StatusCheckWaitForEndpoints bool
+	StatusCheckChangedOnly      bool
+	WatchResources              bool
 	Tail                        bool
+	SBOMAttach                  bool
 	WaitForConnection           bool
 	AutoInit                    bool
 	EnablePlatformNodeAffinity  bool
@@ -85,22 +100,37 @@ type SkaffoldOptions struct {
 	KubeContext                 string
 	KubeConfig                  string
 	LastLogFile                 string
+	ProvenanceOutput            string
+	ProvenanceFormat            string
+	SBOMOutput                  string
+	SBOMCommand                 string
+	StatusCheckKubectl          string
+	StatusCheckJUnitOutput      string
+	RollbackOnFailure           bool
+	StatusCheckOnly             bool
 	DigestSource                string
 	Command                     string
 	MinikubeProfile             string
 	RemoteCacheDir              string
 	TransformRulesFile          string
+	TransformManifestsCommand   string
 	VerifyDockerNetwork         string
 	VerifyEnvFile               string
 	CustomLabels                []string
 	TargetImages                []string
+	OnlyArtifacts               []string
+	SkipArtifacts               []string
 	Profiles                    []string
 	InsecureRegistries          []string
 	ConfigurationFilter         []string
 	HydratedManifests           []string
 	Platforms                   []string
 	BuildConcurrency            int
+	TestConcurrency             int
+	DeployConcurrency           int
 	WatchPollInterval           int
+	StatusCheckLogLines         int
+	StatusCheckMaxLogLines      int
 	StatusCheck                 BoolOrUndefined
 	PushImages                  BoolOrUndefined
 	RPCPort                     IntOrUndefined
@@ -110,8 +140,10 @@ type SkaffoldOptions struct {
 	DefaultRepo                 StringOrUndefined
 	SyncRemoteCache             SyncRemoteCacheOption
 	WaitForDeletions            WaitForDeletions
+	StatusCheckPollInterval     time.Duration
 	ManifestsOverrides          []string
 	ManifestsValueFile          string
+	ValuesFiles                 []string
 	StatusCheckSelectorsFile    string
 }
 
@@ -148,6 +180,12 @@ func (opts *SkaffoldOptions) Mode() RunMode {
 }
 
 func (opts *SkaffoldOptions) IsTargetImage(artifact *latest.Artifact) bool {
+	if len(opts.OnlyArtifacts) > 0 && !slices.Contains(opts.OnlyArtifacts, artifact.ImageName) {
+		return false
+	}
+	if slices.Contains(opts.SkipArtifacts, artifact.ImageName) {
+		return false
+	}
 	if len(opts.TargetImages) == 0 {
 		return true
 	}
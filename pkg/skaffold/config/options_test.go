@@ -27,6 +27,8 @@ func TestIsTargetImage(t *testing.T) {
 	tests := []struct {
 		description   string
 		targetImages  []string
+		only          []string
+		skip          []string
 		expectedMatch bool
 	}{
 		{
@@ -54,11 +56,39 @@ func TestIsTargetImage(t *testing.T) {
 			targetImages:  []string{"other"},
 			expectedMatch: false,
 		},
+		{
+			description:   "only",
+			only:          []string{"other", "domain/image"},
+			expectedMatch: true,
+		},
+		{
+			description:   "only with partial name",
+			only:          []string{"image"},
+			expectedMatch: false,
+		},
+		{
+			description:   "skip",
+			skip:          []string{"domain/image"},
+			expectedMatch: false,
+		},
+		{
+			description:   "skip other",
+			skip:          []string{"other"},
+			expectedMatch: true,
+		},
+		{
+			description:   "only and no target image match",
+			targetImages:  []string{"other"},
+			only:          []string{"domain/image"},
+			expectedMatch: false,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			opts := SkaffoldOptions{
-				TargetImages: test.targetImages,
+				TargetImages:  test.targetImages,
+				OnlyArtifacts: test.only,
+				SkipArtifacts: test.skip,
 			}
 
 			match := opts.IsTargetImage(&latest.Artifact{
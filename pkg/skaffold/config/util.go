@@ -217,6 +217,18 @@ func GetDebugHelpersRegistry(configFile string) (string, error) {
 	return constants.DefaultDebugHelpersRegistry, nil
 }
 
+func GetUDPRelayImage(configFile string) (string, error) {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.UDPRelayImage != "" {
+		log.Entry(context.TODO()).Infof("Using udp-relay-image=%s from config", cfg.UDPRelayImage)
+	}
+	return cfg.UDPRelayImage, nil
+}
+
 type GetClusterOpts struct {
 	ConfigFile      string
 	DefaultRepo     StringOrUndefined
@@ -40,6 +40,10 @@ const (
 	// context directory
 	DefaultDockerfilePath = "Dockerfile"
 
+	// DefaultApkoConfigPath is the apko configuration path given relative to the
+	// context directory
+	DefaultApkoConfigPath = "apko.yaml"
+
 	DefaultMinikubeContext         = "minikube"
 	DefaultDockerForDesktopContext = "docker-for-desktop"
 	DefaultDockerDesktopContext    = "docker-desktop"
@@ -54,6 +58,9 @@ const (
 	// DefaultDebugHelpersRegistry is the default location used for the helper images for `debug`.
 	DefaultDebugHelpersRegistry = "gcr.io/k8s-skaffold/skaffold-debug-support"
 
+	// DefaultUDPRelayImage is the default image of the relay pod used to port-forward UDP ports.
+	DefaultUDPRelayImage = "docker.io/library/python:3.13-alpine"
+
 	DefaultSkaffoldDir = ".skaffold"
 	DefaultCacheFile   = "cache"
 	DefaultMetricFile  = "metrics"
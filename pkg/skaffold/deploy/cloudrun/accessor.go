@@ -175,7 +175,7 @@ func (r *runProxyForwarder) Start(ctx context.Context, out io.Writer) error {
 					eventV2.TaskSucceeded(constants.PortForward)
 				}
 			}()
-			eventV2.PortForwarded(int32(resource.port), schemautil.FromInt(443), "", "", resource.name.Project, "", "run-service", resource.name.Service, resource.name.String())
+			eventV2.PortForwarded(int32(resource.port), schemautil.FromInt(443), "", "", resource.name.Project, "", "run-service", resource.name.Service, resource.name.String(), "TCP", false)
 			resource.started = true
 			resource.cmd = cmd
 		}
@@ -36,6 +36,8 @@ type mockAccessConfig struct {
 
 func (m mockAccessConfig) Mode() config.RunMode { return "" }
 
+func (m mockAccessConfig) GlobalConfig() string { return "" }
+
 func (m mockAccessConfig) PortForwardOptions() config.PortForwardOptions { return m.opts }
 
 func (m mockAccessConfig) PortForwardResources() []*latest.PortForwardResource { return nil }
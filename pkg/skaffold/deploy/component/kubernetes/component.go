@@ -17,6 +17,7 @@ limitations under the License.
 package kubernetes
 
 import (
+	"context"
 	gosync "sync"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/access"
@@ -32,6 +33,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/portforward"
 	k8sstatus "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/loader"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/sync"
 )
 
@@ -61,7 +63,11 @@ func newAccessor(cfg portforward.Config, kubeContext string, cli *kubectl.CLI, p
 		if !cfg.PortForwardOptions().Enabled() {
 			k8sAccessor[kubeContext] = &access.NoopAccessor{}
 		}
-		m := portforward.NewForwarderManager(cli, podSelector, labeller.RunIDSelector(), cfg.Mode(), namespaces, cfg.PortForwardOptions(), cfg.PortForwardResources())
+		udpRelayImage, err := config.GetUDPRelayImage(cfg.GlobalConfig())
+		if err != nil {
+			log.Entry(context.TODO()).Warnf("reading udp-relay-image from config: %v", err)
+		}
+		m := portforward.NewForwarderManager(cli, podSelector, labeller.RunIDSelector(), cfg.Mode(), namespaces, cfg.PortForwardOptions(), cfg.PortForwardResources(), udpRelayImage)
 		if m == nil {
 			k8sAccessor[kubeContext] = &access.NoopAccessor{}
 		} else {
@@ -24,6 +24,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	k8sstatus "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
@@ -39,12 +40,55 @@ func (m mockStatusConfig) GetKubeContext() string { return "" }
 
 func (m mockStatusConfig) StatusCheckDeadlineSeconds() int { return 0 }
 
+func (m mockStatusConfig) StatusCheckInitialDelaySeconds() int { return 0 }
+
+func (m mockStatusConfig) StatusCheckStabilizationSeconds() int { return 0 }
+
 func (m mockStatusConfig) StatusCheckTolerateFailures() bool { return false }
 
 func (m mockStatusConfig) FastFailStatusCheck() bool { return true }
 
 func (m mockStatusConfig) Muted() config.Muted { return config.Muted{} }
 
+func (m mockStatusConfig) StatusCheckTail() bool { return false }
+
+func (m mockStatusConfig) StatusCheckQuiet() bool { return false }
+
+func (m mockStatusConfig) StatusCheckAllNamespaces() bool { return false }
+
+func (m mockStatusConfig) StatusCheckFailOn() []string { return nil }
+
+func (m mockStatusConfig) StatusCheckWaitForHPA() bool { return false }
+
+func (m mockStatusConfig) StatusCheckWaitForEndpoints() bool { return false }
+
+func (m mockStatusConfig) StatusCheckLogLines() int { return 0 }
+
+func (m mockStatusConfig) StatusCheckMaxLogLines() int { return 0 }
+
+func (m mockStatusConfig) StatusCheckKubectl() string { return "" }
+
+func (m mockStatusConfig) WatchResources() bool { return false }
+
+func (m mockStatusConfig) StatusCheckJUnitOutput() string { return "" }
+
+func (m mockStatusConfig) RollbackOnFailure() bool { return false }
+
+func (m mockStatusConfig) StatusCheckExclude() []latest.StatusCheckExclude { return nil }
+
+func (m mockStatusConfig) StatusCheckSuggestions() []latest.StatusCheckSuggestion { return nil }
+
+func (m mockStatusConfig) StatusCheckRetryableErrors() []string { return nil }
+
+func (m mockStatusConfig) StatusCheckSkipPaused() bool { return false }
+
+func (m mockStatusConfig) StatusCheckReadyPercent() int { return 0 }
+
+func (m mockStatusConfig) StatusCheckWebhook() string { return "" }
+func (m mockStatusConfig) StatusCheckLogPrefix() string { return "" }
+
+func (m mockStatusConfig) StatusCheckReadiness() []latest.StatusCheckReadiness { return nil }
+
 func (m mockStatusConfig) StatusCheckResourceSelectors() []manifest.GroupKindSelector {
 	return []manifest.GroupKindSelector{}
 }
@@ -17,10 +17,13 @@ limitations under the License.
 package deploy
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"strconv"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/access"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/debug"
@@ -41,6 +44,10 @@ import (
 type DeployerMux struct {
 	iterativeStatusCheck bool
 	deployers            []Deployer
+	// concurrency is the maximum number of modules deployed at once. Modules are deployed sequentially unless it is greater than 1.
+	concurrency int
+	// requiresOthers returns whether the module of the config requires other modules.
+	requiresOthers func(configName string) bool
 }
 
 type deployerWithHooks interface {
@@ -53,6 +60,12 @@ func NewDeployerMux(deployers []Deployer, iterativeStatusCheck bool) Deployer {
 	return DeployerMux{deployers: deployers, iterativeStatusCheck: iterativeStatusCheck}
 }
 
+// NewConcurrentDeployerMux returns a deployer that deploys up to `concurrency` modules at once.
+// A module that requires other modules is only deployed once all the modules before it are deployed and status checked.
+func NewConcurrentDeployerMux(deployers []Deployer, concurrency int, requiresOthers func(configName string) bool) Deployer {
+	return DeployerMux{deployers: deployers, iterativeStatusCheck: true, concurrency: concurrency, requiresOthers: requiresOthers}
+}
+
 func (m DeployerMux) GetDeployers() []Deployer {
 	return m.deployers
 }
@@ -116,6 +129,9 @@ func (m DeployerMux) ConfigName() string {
 }
 
 func (m DeployerMux) Deploy(ctx context.Context, w io.Writer, as []graph.Artifact, l manifest.ManifestListByConfig) error {
+	if m.concurrency > 1 {
+		return m.deployConcurrently(ctx, w, as, l)
+	}
 	for i, deployer := range m.deployers {
 		eventV2.DeployInProgress(i)
 		w, ctx = output.WithEventContext(ctx, w, constants.Deploy, strconv.Itoa(i))
@@ -186,3 +202,105 @@ func (m DeployerMux) Cleanup(ctx context.Context, w io.Writer, dryRun bool, mani
 
 // TrackBuildArtifacts should *only* be called on individual deployers. This is a noop.
 func (m DeployerMux) TrackBuildArtifacts(_, _ []graph.Artifact) {}
+
+// deployLevels groups the indices of the deployers by module, the consecutive deployers of a config, and the modules
+// in levels deployed one after the other. The modules of a level don't require each other: a module that requires
+// other modules starts a new level, since the modules it requires come before it.
+func (m DeployerMux) deployLevels() [][][]int {
+	var levels [][][]int
+	for i, deployer := range m.deployers {
+		if i > 0 && deployer.ConfigName() == m.deployers[i-1].ConfigName() {
+			level := levels[len(levels)-1]
+			level[len(level)-1] = append(level[len(level)-1], i)
+			continue
+		}
+		if len(levels) == 0 || m.requiresOthers(deployer.ConfigName()) {
+			levels = append(levels, nil)
+		}
+		levels[len(levels)-1] = append(levels[len(levels)-1], []int{i})
+	}
+	return levels
+}
+
+// deployConcurrently deploys the modules of each level concurrently, up to the concurrency limit, and waits for
+// their status check before deploying the next level.
+func (m DeployerMux) deployConcurrently(ctx context.Context, w io.Writer, as []graph.Artifact, l manifest.ManifestListByConfig) error {
+	for _, level := range m.deployLevels() {
+		g, gCtx := errgroup.WithContext(ctx)
+		g.SetLimit(m.concurrency)
+		var monitors status.MonitorMux
+		// each module writes to its own buffer, which is flushed in order once the level is deployed.
+		buffers := make([]*bytes.Buffer, len(level))
+		for j, module := range level {
+			for _, i := range module {
+				monitors = append(monitors, m.deployers[i].GetStatusMonitor())
+			}
+			buffers[j] = new(bytes.Buffer)
+			mw := io.Writer(buffers[j])
+			if output.IsColorable(w) {
+				mw = output.GetWriter(ctx, mw, output.DefaultColorCode, true, false)
+			}
+			g.Go(func() error {
+				for _, i := range module {
+					if err := m.deployOne(gCtx, mw, i, as, l); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}
+		err := g.Wait()
+		for _, b := range buffers {
+			if _, werr := b.WriteTo(w); werr != nil && err == nil {
+				err = werr
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		// the modules of the level share the status monitors, so they are checked all at once.
+		if err := monitors.Check(ctx, w); err != nil {
+			for _, module := range level {
+				for _, i := range module {
+					eventV2.DeployFailed(i, err)
+				}
+			}
+			return err
+		}
+
+		for _, module := range level {
+			for _, i := range module {
+				if deployHooks, ok := m.deployers[i].(deployerWithHooks); ok && deployHooks.HasRunnableHooks() {
+					iw, ictx := output.WithEventContext(ctx, w, constants.Deploy, strconv.Itoa(i))
+					if err := deployHooks.PostDeployHooks(ictx, iw); err != nil {
+						return err
+					}
+				}
+				eventV2.DeploySucceeded(i)
+			}
+		}
+	}
+	return nil
+}
+
+// deployOne runs the pre-deploy hooks of the i-th deployer and deploys it.
+func (m DeployerMux) deployOne(ctx context.Context, w io.Writer, i int, as []graph.Artifact, l manifest.ManifestListByConfig) error {
+	deployer := m.deployers[i]
+	eventV2.DeployInProgress(i)
+	w, ctx = output.WithEventContext(ctx, w, constants.Deploy, strconv.Itoa(i))
+	ctx, endTrace := instrumentation.StartTrace(ctx, "Deploy")
+	if deployHooks, ok := deployer.(deployerWithHooks); ok && deployHooks.HasRunnableHooks() {
+		if err := deployHooks.PreDeployHooks(ctx, w); err != nil {
+			endTrace(instrumentation.TraceEndError(err))
+			return err
+		}
+	}
+	if err := deployer.Deploy(ctx, w, as, l); err != nil {
+		eventV2.DeployFailed(i, err)
+		endTrace(instrumentation.TraceEndError(err))
+		return err
+	}
+	endTrace()
+	return nil
+}
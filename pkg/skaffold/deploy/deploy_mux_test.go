@@ -126,6 +126,11 @@ func (m *MockDeployer) ConfigName() string {
 	return m.configName
 }
 
+func (m *MockDeployer) WithConfigName(configName string) *MockDeployer {
+	m.configName = configName
+	return m
+}
+
 func TestDeployerMux_Deploy(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -170,6 +175,95 @@ func TestDeployerMux_Deploy(t *testing.T) {
 	}
 }
 
+func TestDeployerMux_DeployLevels(t *testing.T) {
+	tests := []struct {
+		name      string
+		configs   []string
+		requiring []string
+		expected  [][][]int
+	}{
+		{
+			name:     "independent modules",
+			configs:  []string{"a", "b", "c"},
+			expected: [][][]int{{{0}, {1}, {2}}},
+		},
+		{
+			name:      "module requiring the modules before it",
+			configs:   []string{"a", "b", "root"},
+			requiring: []string{"root"},
+			expected:  [][][]int{{{0}, {1}}, {{2}}},
+		},
+		{
+			name:      "modules after a requiring module",
+			configs:   []string{"a", "b", "c", "d"},
+			requiring: []string{"b"},
+			expected:  [][][]int{{{0}}, {{1}, {2}, {3}}},
+		},
+		{
+			name:      "deployers of the same module",
+			configs:   []string{"a", "a", "b", "b"},
+			requiring: []string{"b"},
+			expected:  [][][]int{{{0, 1}}, {{2, 3}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var deployers []Deployer
+			for _, c := range test.configs {
+				deployers = append(deployers, NewMockDeployer().WithConfigName(c))
+			}
+			requiresOthers := func(configName string) bool {
+				for _, r := range test.requiring {
+					if r == configName {
+						return true
+					}
+				}
+				return false
+			}
+			deployerMux := NewConcurrentDeployerMux(deployers, 2, requiresOthers).(DeployerMux)
+
+			testutil.CheckDeepEqual(t, test.expected, deployerMux.deployLevels())
+		})
+	}
+}
+
+func TestDeployerMux_DeployConcurrently(t *testing.T) {
+	tests := []struct {
+		name      string
+		err1      error
+		err2      error
+		shouldErr bool
+	}{
+		{
+			name: "all deploys succeed",
+		},
+		{
+			name:      "when first deploy fails",
+			err1:      fmt.Errorf("failed in first"),
+			shouldErr: true,
+		},
+		{
+			name:      "when second deploy fails",
+			err2:      fmt.Errorf("failed in second"),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testEvent.InitializeState([]latest.Pipeline{{}, {}})
+
+			deployerMux := NewConcurrentDeployerMux([]Deployer{
+				NewMockDeployer().WithConfigName("a").WithDeployErr(test.err1),
+				NewMockDeployer().WithConfigName("b").WithDeployErr(test.err2),
+			}, 2, func(string) bool { return false })
+
+			err := deployerMux.Deploy(context.Background(), io.Discard, nil, manifest.NewManifestListByConfig())
+
+			testutil.CheckError(t, test.shouldErr, err)
+		})
+	}
+}
+
 func TestDeployerMux_Dependencies(t *testing.T) {
 	tests := []struct {
 		name         string
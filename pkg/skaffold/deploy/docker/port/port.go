@@ -173,6 +173,8 @@ func (pm *PortManager) containerPortForwardEvents(out io.Writer) {
 			"container",
 			entry.resourceName,
 			entry.resourceAddress,
+			"TCP",
+			false,
 		)
 
 		eventV2.PortForwarded(
@@ -185,6 +187,8 @@ func (pm *PortManager) containerPortForwardEvents(out io.Writer) {
 			"container",
 			entry.resourceName,
 			entry.resourceAddress,
+			"TCP",
+			false,
 		)
 
 		output.Green.Fprintln(out,
@@ -96,7 +96,7 @@ func (h *Deployer) installArgs(r latest.HelmRelease, builds []graph.Artifact, o
 		args = append(args, "-f", constants.HelmOverridesFilename)
 	}
 
-	if r.Wait {
+	if r.Wait && !h.waitWithStatusCheck(r) {
 		args = append(args, "--wait")
 	}
 
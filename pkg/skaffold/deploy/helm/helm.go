@@ -87,7 +87,7 @@ type Deployer struct {
 	debugger      debug.Debugger
 	imageLoader   loader.ImageLoader
 	logger        log.Logger
-	statusMonitor status.Monitor
+	statusMonitor kstatus.Monitor
 	syncer        sync.Syncer
 	hookRunner    hooks.Runner
 
@@ -108,11 +108,12 @@ type Deployer struct {
 
 	labels map[string]string
 
-	forceDeploy       bool
-	enableDebug       bool
-	overrideProtocols []string
-	isMultiConfig     bool
-	helmVersion       semver.Version
+	forceDeploy        bool
+	enableDebug        bool
+	overrideProtocols  []string
+	isMultiConfig      bool
+	statusCheckEnabled bool
+	helmVersion        semver.Version
 
 	transformableAllowlist map[apimachinery.GroupKind]latest.ResourceFilter
 	transformableDenylist  map[apimachinery.GroupKind]latest.ResourceFilter
@@ -190,6 +191,7 @@ func NewDeployer(ctx context.Context, cfg Config, labeller *label.DefaultLabelle
 		kubeConfig:             cfg.GetKubeConfig(),
 		namespace:              cfg.GetKubeNamespace(),
 		forceDeploy:            cfg.ForceDeploy(),
+		statusCheckEnabled:     cfg.StatusCheck() == nil || *cfg.StatusCheck(),
 		configFile:             cfg.ConfigurationFile(),
 		labels:                 labeller.Labels(),
 		helmVersion:            helmVersion,
@@ -273,6 +275,7 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 	var mu sync2.Mutex
 	nsMap := map[string]struct{}{}
 	manifests := manifest.ManifestList{}
+	waitWithStatusCheck := false
 
 	concurrency := 1
 	if h.Concurrency != nil {
@@ -345,6 +348,7 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 				mu.Lock()
 				defer mu.Unlock()
 				manifests.Append(m)
+				waitWithStatusCheck = waitWithStatusCheck || h.waitWithStatusCheck(release)
 				for _, res := range results {
 					if trimmed := strings.TrimSpace(res.Namespace); trimmed != "" {
 						nsMap[trimmed] = struct{}{}
@@ -367,6 +371,13 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 		h.warnAboutUnusedImages(builds, manifests)
 	}
 
+	// The status check waits for the labelled resources of every release. Releases that hand off `--wait` to it also need
+	// the resources it only checks when they're deployed, like custom resources, to be waited for: the manifests of all the
+	// releases are registered, so these resources are waited for in every release, not only in the ones handing off `--wait`.
+	if waitWithStatusCheck {
+		h.statusMonitor.RegisterDeployManifests(manifests)
+	}
+
 	// Collect namespaces in a string
 	var namespaces []string
 	for ns := range nsMap {
@@ -380,6 +391,11 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 	return nil
 }
 
+// waitWithStatusCheck returns whether the status check waits for the release resources instead of `helm --wait`.
+func (h *Deployer) waitWithStatusCheck(r latest.HelmRelease) bool {
+	return r.Wait && r.WaitWithStatusCheck && h.statusCheckEnabled
+}
+
 // Dependencies returns a list of files that the deployer depends on.
 func (h *Deployer) Dependencies() ([]string, error) {
 	var deps []string
@@ -540,11 +556,19 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, releaseName
 		}
 	}
 
+	opts.chartPath, opts.version, err = helm.ResolveOCIChart(ctx, h, opts.chartPath, opts.version)
+	if err != nil {
+		return nil, nil, helm.UserErr("resolving OCI chart", err)
+	}
+
 	installEnv := util.OSEnviron()
 	// skaffold use the post-renderer feature to do skaffold specific rendering such as image replacement, adding debugging annotation in helm rendered result,
 	// as Helm doesn't support to run multiple post-renderers,  this is used to run user-defined render inside skaffold filter which happens before skaffold
 	// post-rendering process for helm releases.
 	postRendererFlag := getPostRendererFlag(opts.flags)
+	if r.PostRenderer != "" {
+		postRendererFlag = []string{"--post-renderer", r.PostRenderer}
+	}
 	skaffoldBinary, filterEnv, cleanup, err := helm.PrepareSkaffoldFilter(h, builds, postRendererFlag)
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not prepare `skaffold filter`: %w", err)
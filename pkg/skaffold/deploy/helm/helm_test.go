@@ -40,6 +40,7 @@ import (
 	kubectx "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/logger"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
+	kstatus "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status"
 	rhelm "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/renderer/helm"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
@@ -360,6 +361,14 @@ var testDeployWithDepBuild = latest.LegacyHelmDeploy{
 	},
 }
 
+var testDeployPostRendererConfig = latest.LegacyHelmDeploy{
+	Releases: []latest.HelmRelease{{
+		Name:         "skaffold-helm",
+		ChartPath:    "examples/test",
+		PostRenderer: "./kustomize-wrapper.sh",
+	}},
+}
+
 var validDeployYaml = `
 # Source: skaffold-helm/templates/deployment.yaml
 apiVersion: apps/v1
@@ -514,6 +523,19 @@ func TestHelmDeploy(t *testing.T) {
 			builds:             testBuilds,
 			expectedNamespaces: []string{""},
 		},
+		{
+			description: "helm3.1 deploy with a release post-renderer",
+			commands: testutil.
+				CmdRunWithOutput("helm version", version31).
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext dep build examples/test --kubeconfig kubeconfig").
+				AndRunEnv("helm --kube-context kubecontext upgrade skaffold-helm examples/test --post-renderer SKAFFOLD-BINARY --kubeconfig kubeconfig",
+					[]string{"SKAFFOLD_FILENAME=test.yaml", "SKAFFOLD_CMDLINE=filter --kube-context kubecontext --build-artifacts TMPFILE --kubeconfig kubeconfig --post-renderer ./kustomize-wrapper.sh"}).
+				AndRunWithOutput("helm --kube-context kubecontext get all skaffold-helm --template {{.Release.Manifest}} --kubeconfig kubeconfig", validDeployYaml),
+			helm:               testDeployPostRendererConfig,
+			builds:             testBuilds,
+			expectedNamespaces: []string{""},
+		},
 		{
 			description: "helm4.0 deploy success",
 			commands: testutil.
@@ -1134,6 +1156,77 @@ func TestHelmDeploy(t *testing.T) {
 	}
 }
 
+type recordingMonitor struct {
+	*kstatus.NoopMonitor
+	registered manifest.ManifestList
+}
+
+func (m *recordingMonitor) RegisterDeployManifests(manifests manifest.ManifestList) {
+	m.registered = manifests
+}
+
+func TestHelmDeployWaitWithStatusCheck(t *testing.T) {
+	tmpDir := t.TempDir()
+	disabled := false
+
+	tests := []struct {
+		description        string
+		release            latest.HelmRelease
+		statusCheck        *bool
+		expectedInstall    string
+		expectedRegistered bool
+	}{
+		{
+			description:     "helm waits",
+			release:         latest.HelmRelease{Name: "skaffold-helm", ChartPath: "examples/test", Wait: true},
+			expectedInstall: "helm --kube-context kubecontext upgrade skaffold-helm examples/test --wait --post-renderer SKAFFOLD-BINARY --kubeconfig kubeconfig",
+		},
+		{
+			description:        "status check waits instead of helm",
+			release:            latest.HelmRelease{Name: "skaffold-helm", ChartPath: "examples/test", Wait: true, WaitWithStatusCheck: true},
+			expectedInstall:    "helm --kube-context kubecontext upgrade skaffold-helm examples/test --post-renderer SKAFFOLD-BINARY --kubeconfig kubeconfig",
+			expectedRegistered: true,
+		},
+		{
+			description:     "helm waits when the status check is disabled",
+			release:         latest.HelmRelease{Name: "skaffold-helm", ChartPath: "examples/test", Wait: true, WaitWithStatusCheck: true},
+			statusCheck:     &disabled,
+			expectedInstall: "helm --kube-context kubecontext upgrade skaffold-helm examples/test --wait --post-renderer SKAFFOLD-BINARY --kubeconfig kubeconfig",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&helm.WriteBuildArtifacts, func([]graph.Artifact) (string, func(), error) { return "TMPFILE", func() {}, nil })
+			t.Override(&client.Client, deployutil.MockK8sClient)
+			t.Override(&util.OSEnviron, func() []string { return []string{"FOO=FOOBAR"} })
+			t.Override(&util.DefaultExecCommand, testutil.
+				CmdRunWithOutput("helm version", version31).
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext dep build examples/test --kubeconfig kubeconfig").
+				AndRunEnv(test.expectedInstall,
+					[]string{"SKAFFOLD_FILENAME=test.yaml", "SKAFFOLD_CMDLINE=filter --kube-context kubecontext --build-artifacts TMPFILE --kubeconfig kubeconfig"}).
+				AndRunWithOutput("helm --kube-context kubecontext get all skaffold-helm --template {{.Release.Manifest}} --kubeconfig kubeconfig", validDeployYaml))
+			t.Override(&helm.OSExecutable, func() (string, error) { return "SKAFFOLD-BINARY", nil })
+			t.Override(&kubectx.CurrentConfig, func() (api.Config, error) {
+				return api.Config{CurrentContext: ""}, nil
+			})
+
+			deployer, err := NewDeployer(context.Background(), &helmConfig{
+				configFile:  "test.yaml",
+				statusCheck: test.statusCheck,
+			}, &label.DefaultLabeller{}, &latest.LegacyHelmDeploy{Releases: []latest.HelmRelease{test.release}}, nil, "default", nil)
+			t.RequireNoError(err)
+			monitor := &recordingMonitor{NoopMonitor: &kstatus.NoopMonitor{}}
+			deployer.statusMonitor = monitor
+			deployer.pkgTmpDir = tmpDir
+
+			err = deployer.Deploy(context.Background(), io.Discard, testBuilds, manifest.ManifestListByConfig{})
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedRegistered, len(monitor.registered) > 0)
+		})
+	}
+}
+
 func TestHelmDeployConcurrently(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "TestHelmDeploy")
 	if err != nil {
@@ -1749,6 +1842,7 @@ type helmConfig struct {
 	namespace             string
 	force                 bool
 	configFile            string
+	statusCheck           *bool
 }
 
 func (c *helmConfig) ForceDeploy() bool                                   { return c.force }
@@ -1758,6 +1852,7 @@ func (c *helmConfig) GetKubeNamespace() string                            { retu
 func (c *helmConfig) GetNamespace() string                                { return c.namespace }
 func (c *helmConfig) ConfigurationFile() string                           { return c.configFile }
 func (c *helmConfig) PortForwardResources() []*latest.PortForwardResource { return nil }
+func (c *helmConfig) StatusCheck() *bool                                  { return c.statusCheck }
 
 func TestHasRunnableHooks(t *testing.T) {
 	tests := []struct {
@@ -38,6 +38,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 )
 
+// fieldManager is the field manager of the fields applied by Skaffold with server-side apply.
+const fieldManager = "skaffold"
+
 // CLI holds parameters to run kubectl.
 type CLI struct {
 	*kubectl.CLI
@@ -58,6 +61,7 @@ type Config interface {
 	WaitForDeletions() config.WaitForDeletions
 	Mode() config.RunMode
 	HydratedManifests() []string
+	TransformManifestsCommand() string
 	GetNamespace() string
 	DefaultPipeline() latest.Pipeline
 	Tail() bool
@@ -66,6 +70,7 @@ type Config interface {
 	JSONParseConfig() latest.JSONParseConfig
 	EnablePlatformNodeAffinityInRenderedManifests() bool
 	EnableGKEARMNodeTolerationInRenderedManifests() bool
+	AllowBaseDrift() bool
 }
 
 func NewCLI(cfg Config, flags latest.KubectlFlags, defaultNamespace string) CLI {
@@ -78,10 +83,17 @@ func NewCLI(cfg Config, flags latest.KubectlFlags, defaultNamespace string) CLI
 }
 
 // Delete runs `kubectl delete` on a list of manifests.
+// Custom resource definitions are deleted after the other resources, and namespaces last.
 func (c *CLI) Delete(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error {
-	args := c.args(c.Flags.Delete, "--ignore-not-found=true", "--wait=false", "-f", "-")
-	if err := c.Run(ctx, manifests.Reader(), out, "delete", args...); err != nil {
-		return deployerr.CleanupErr(fmt.Errorf("kubectl delete: %w", err))
+	wait := "--wait=false"
+	if c.Flags.WaitOnDelete {
+		wait = "--wait=true"
+	}
+	args := c.args(c.Flags.Delete, "--ignore-not-found=true", wait, "-f", "-")
+	for _, step := range manifests.DeletionSteps() {
+		if err := c.Run(ctx, step.Reader(), out, "delete", args...); err != nil {
+			return deployerr.CleanupErr(fmt.Errorf("kubectl delete: %w", err))
+		}
 	}
 
 	return nil
@@ -102,7 +114,13 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 	}
 
 	args := []string{"-f", "-"}
-	if c.forceDeploy {
+	if c.Flags.ServerSideApply {
+		// `--force` can't be used with server-side apply: forcing resolves field ownership conflicts instead.
+		args = append(args, "--server-side", "--field-manager", fieldManager)
+		if c.forceDeploy || c.Flags.ForceConflicts {
+			args = append(args, "--force-conflicts")
+		}
+	} else if c.forceDeploy {
 		args = append(args, "--force", "--grace-period=0")
 	}
 
@@ -69,6 +69,7 @@ type Deployer struct {
 	localImages         []graph.Artifact // the set of images marked as "local" by the Runner
 	podSelector         *kubernetes.ImageList
 	hydratedManifests   []string
+	transformCommand    string
 	workingDir          string
 	globalConfig        string
 	defaultRepo         *string
@@ -147,6 +148,7 @@ func NewDeployer(cfg Config, labeller *label.DefaultLabeller, d *latest.KubectlD
 		labeller:            labeller,
 		// hydratedManifests refers to the DIR in the `skaffold apply DIR`. Used in both v1 and v2.
 		hydratedManifests:      cfg.HydratedManifests(),
+		transformCommand:       cfg.TransformManifestsCommand(),
 		transformableAllowlist: transformableAllowlist,
 		transformableDenylist:  transformableDenylist,
 	}, nil
@@ -241,6 +243,15 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 		return err
 	}
 
+	if k.transformCommand != "" {
+		childCtx, endTrace = instrumentation.StartTrace(ctx, "Deploy_TransformManifests")
+		if manifests, err = transformManifests(childCtx, k.transformCommand, k.workingDir, manifests); err != nil {
+			endTrace(instrumentation.TraceEndError(err))
+			return userErr(err)
+		}
+		endTrace()
+	}
+
 	childCtx, endTrace = instrumentation.StartTrace(ctx, "Deploy_LoadImages")
 	if err := k.imageLoader.LoadImages(childCtx, out, k.localImages, k.originalImages, builds); err != nil {
 		endTrace(instrumentation.TraceEndError(err))
@@ -101,6 +101,68 @@ func TestKubectlV1RenderDeploy(t *testing.T) {
 			forceDeploy:      true,
 			waitForDeletions: true,
 		},
+		{
+			description: "deploy success (server-side apply)",
+			generate: latest.Generate{
+				RawK8s: []string{"deployment.yaml"},
+			},
+			kubectl: latest.KubectlDeploy{
+				Flags: latest.KubectlFlags{
+					ServerSideApply: true,
+				},
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", "").
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --server-side --field-manager skaffold").
+				AndRunOutOnce("kubectl config view --minify -o jsonpath='{..namespace}'", "testNamespace"),
+			builds: []graph.Artifact{{
+				ImageName: "leeroy-web",
+				Tag:       "leeroy-web:v1",
+			}},
+			waitForDeletions: true,
+		},
+		{
+			description: "deploy success (server-side apply, forced)",
+			generate: latest.Generate{
+				RawK8s: []string{"deployment.yaml"},
+			},
+			kubectl: latest.KubectlDeploy{
+				Flags: latest.KubectlFlags{
+					ServerSideApply: true,
+				},
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", "").
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --server-side --field-manager skaffold --force-conflicts").
+				AndRunOutOnce("kubectl config view --minify -o jsonpath='{..namespace}'", "testNamespace"),
+			builds: []graph.Artifact{{
+				ImageName: "leeroy-web",
+				Tag:       "leeroy-web:v1",
+			}},
+			forceDeploy:      true,
+			waitForDeletions: true,
+		},
+		{
+			description: "deploy success (server-side apply, force conflicts)",
+			generate: latest.Generate{
+				RawK8s: []string{"deployment.yaml"},
+			},
+			kubectl: latest.KubectlDeploy{
+				Flags: latest.KubectlFlags{
+					ServerSideApply: true,
+					ForceConflicts:  true,
+				},
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", "").
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --server-side --field-manager skaffold --force-conflicts").
+				AndRunOutOnce("kubectl config view --minify -o jsonpath='{..namespace}'", "testNamespace"),
+			builds: []graph.Artifact{{
+				ImageName: "leeroy-web",
+				Tag:       "leeroy-web:v1",
+			}},
+			waitForDeletions: true,
+		},
 		{
 			description: "deploy success",
 			generate: latest.Generate{
@@ -320,6 +382,19 @@ func TestKubectlCleanup(t *testing.T) {
 			commands: testutil.
 				CmdRun("kubectl --context kubecontext --namespace testNamespace delete -v=0 --grace-period=1 --ignore-not-found=true --wait=false -f -"),
 		},
+		{
+			description: "wait on delete",
+			generate: latest.Generate{
+				RawK8s: []string{"deployment.yaml"},
+			},
+			kubectl: latest.KubectlDeploy{
+				Flags: latest.KubectlFlags{
+					WaitOnDelete: true,
+				},
+			},
+			commands: testutil.
+				CmdRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true --wait=true -f -"),
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -472,6 +547,67 @@ func TestKubectlWaitForDeletions(t *testing.T) {
 	})
 }
 
+func TestKubectlTransformManifests(t *testing.T) {
+	const transformed = `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    team: platform
+  name: leeroy-web
+spec:
+  containers:
+    - image: leeroy-web:v1
+      name: leeroy-web`
+
+	tests := []struct {
+		description string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "manifests are applied after the transformation",
+			commands: testutil.
+				CmdRunInputOut("sh -c add-labels", DeploymentWebYAMLv1, transformed).
+				AndRunInput("kubectl --context kubecontext apply -f -", transformed),
+		},
+		{
+			description: "transform command fails",
+			commands:    testutil.CmdRunOutErr("sh -c add-labels", "", errors.New("exit status 1")),
+			shouldErr:   true,
+		},
+		{
+			description: "transform command returns no manifests",
+			commands:    testutil.CmdRunInputOut("sh -c add-labels", DeploymentWebYAMLv1, ""),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&client.Client, deployutil.MockK8sClient)
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			const configName = "default"
+			deployer, err := NewDeployer(&kubectlConfig{
+				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{
+					TransformManifestsCommand: "add-labels",
+				}},
+			}, &label.DefaultLabeller{}, &latest.KubectlDeploy{}, nil, configName, nil)
+			t.RequireNoError(err)
+
+			m, err := manifest.Load(bytes.NewReader([]byte(DeploymentWebYAMLv1)))
+			t.CheckNoError(err)
+			manifestListByConfig := manifest.NewManifestListByConfig()
+			manifestListByConfig.Add(configName, m)
+
+			err = deployer.Deploy(context.Background(), io.Discard, []graph.Artifact{
+				{ImageName: "leeroy-web", Tag: "leeroy-web:v1"},
+			}, manifestListByConfig)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestKubectlWaitForDeletionsFails(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().Write("deployment-web.yaml", DeploymentWebYAML)
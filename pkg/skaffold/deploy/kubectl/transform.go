@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+)
+
+// transformManifests pipes the manifests through the given shell command and
+// returns the manifests it writes to stdout.
+func transformManifests(ctx context.Context, command string, workingDir string, manifests manifest.ManifestList) (manifest.ManifestList, error) {
+	var cmd *exec.Cmd
+	// We evaluate the command with a shell so that it can contain
+	// env variables and pipes.
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Stdin = manifests.Reader()
+	cmd.Dir = workingDir
+
+	log.Entry(ctx).Debugf("Transforming manifests with: %s", command)
+	out, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("running manifest transform command %q: %w", command, err)
+	}
+	transformed, err := manifest.Load(bytes.NewReader(out))
+	if err != nil {
+		return nil, fmt.Errorf("reading manifests returned by transform command %q: %w", command, err)
+	}
+	if len(transformed) == 0 {
+		return nil, fmt.Errorf("manifest transform command %q returned no manifests", command)
+	}
+	return transformed, nil
+}
@@ -68,6 +68,18 @@ func GetAllPodNamespaces(configNamespace string, pipelines []latest.Pipeline) ([
 	return namespaces, nil
 }
 
+// currentContextNamespace returns the namespace of the current kube context, or `default` if it isn't set.
+func currentContextNamespace() string {
+	config, err := kubectx.CurrentConfig()
+	if err != nil {
+		return "default"
+	}
+	if context, ok := config.Contexts[config.CurrentContext]; ok && context.Namespace != "" {
+		return context.Namespace
+	}
+	return "default"
+}
+
 func collectHelmReleasesNamespaces(pipelines []latest.Pipeline) ([]string, error) {
 	var namespaces []string
 	for _, cfg := range pipelines {
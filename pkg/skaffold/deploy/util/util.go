@@ -53,7 +53,9 @@ var (
 )
 
 // ApplyDefaultRepo applies the default repo to a given image tag.
-func ApplyDefaultRepo(globalConfig string, defaultRepo *string, tag string) (string, error) {
+// The namespace is only looked up when the default repo is a template, and falls back
+// to the namespace of the current kube context, then to `default`.
+func ApplyDefaultRepo(globalConfig string, defaultRepo *string, namespace func() string, tag string) (string, error) {
 	repo, err := config.GetDefaultRepo(globalConfig, defaultRepo)
 	if err != nil {
 		return "", fmt.Errorf("getting default repo: %w", err)
@@ -64,7 +66,14 @@ func ApplyDefaultRepo(globalConfig string, defaultRepo *string, tag string) (str
 		return "", fmt.Errorf("getting multi-level repo support: %w", err)
 	}
 
-	newTag, err := docker.SubstituteDefaultRepoIntoImage(repo, multiLevel, tag)
+	var ns string
+	if docker.IsDefaultRepoTemplate(repo) {
+		if ns = namespace(); ns == "" {
+			ns = currentContextNamespace()
+		}
+	}
+
+	newTag, err := docker.SubstituteDefaultRepoIntoImage(repo, multiLevel, ns, tag)
 	if err != nil {
 		return "", fmt.Errorf("applying default repo to %q: %w", tag, err)
 	}
@@ -254,7 +263,7 @@ func ImageTags(ctx context.Context, runCtx *runcontext.RunContext, tagger tag.Ta
 				showWarning = true
 			}
 
-			_tag, err := ApplyDefaultRepo(runCtx.GlobalConfig(), runCtx.DefaultRepo(), t.tag)
+			_tag, err := ApplyDefaultRepo(runCtx.GlobalConfig(), runCtx.DefaultRepo(), runCtx.GetNamespace, t.tag)
 
 			if err != nil {
 				return nil, err
@@ -23,11 +23,13 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes"
+	kubectx "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	renderutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/renderer/util"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
@@ -132,6 +134,57 @@ func TestGetHydrationDir_CustomHydrationDir(t *testing.T) {
 	})
 }
 
+func TestApplyDefaultRepo(t *testing.T) {
+	tests := []struct {
+		description string
+		defaultRepo string
+		namespace   string
+		kubeContext *api.Context
+		expected    string
+	}{
+		{
+			description: "prefix",
+			defaultRepo: "gcr.io/project",
+			expected:    "gcr.io/project/app:v1",
+		},
+		{
+			description: "template with namespace",
+			defaultRepo: "gcr.io/project/{{.Namespace}}/{{.ImageName}}",
+			namespace:   "team-a",
+			expected:    "gcr.io/project/team-a/app:v1",
+		},
+		{
+			description: "template with namespace from kube context",
+			defaultRepo: "gcr.io/project/{{.Namespace}}/{{.ImageName}}",
+			kubeContext: &api.Context{Namespace: "team-b"},
+			expected:    "gcr.io/project/team-b/app:v1",
+		},
+		{
+			description: "template without namespace",
+			defaultRepo: "gcr.io/project/{{.Namespace}}/{{.ImageName}}",
+			kubeContext: &api.Context{},
+			expected:    "gcr.io/project/default/app:v1",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&config.ReadConfigFile, func(string) (*config.GlobalConfig, error) { return &config.GlobalConfig{}, nil })
+			t.Override(&kubectx.CurrentConfig, func() (api.Config, error) {
+				cfg := api.Config{CurrentContext: "test"}
+				if test.kubeContext != nil {
+					cfg.Contexts = map[string]*api.Context{"test": test.kubeContext}
+				}
+				return cfg, nil
+			})
+
+			actual, err := ApplyDefaultRepo("", &test.defaultRepo, func() string { return test.namespace }, "app:v1")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
 func TestAddTagsToPodSelector(t *testing.T) {
 	tests := []struct {
 		description       string
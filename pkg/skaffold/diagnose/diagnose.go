@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/sync"
@@ -104,6 +105,58 @@ func CheckArtifacts(ctx context.Context, cfg Config, tags tag.ImageTags, out io.
 	return nil
 }
 
+// UnusedArtifacts returns the artifacts whose image is never referenced, which usually means a typo in the image name.
+// An image is referenced when it's used by the rendered manifests, the docker deployer, a verify test case,
+// a custom action or when another artifact requires it.
+func UnusedArtifacts(cfg Config, manifests manifest.ManifestListByConfig) ([]*latest.Artifact, error) {
+	used := map[string]bool{}
+	for _, configName := range manifests.ConfigNames() {
+		l := manifests.GetForConfig(configName)
+		images, err := l.GetImages(manifest.NewResourceSelectorImages(manifest.TransformAllowlist, manifest.TransformDenylist))
+		if err != nil {
+			return nil, err
+		}
+		for _, image := range images {
+			used[image.ImageName] = true
+		}
+	}
+	use := func(image string) {
+		if parsed, err := docker.ParseReference(image); err == nil {
+			used[parsed.BaseName] = true
+		}
+	}
+	for _, p := range cfg.GetPipelines() {
+		if p.Deploy.DockerDeploy != nil {
+			for _, image := range p.Deploy.DockerDeploy.Images {
+				use(image)
+			}
+		}
+		for _, tc := range p.Verify {
+			use(tc.Container.Image)
+		}
+		for _, action := range p.CustomActions {
+			for _, c := range action.Containers {
+				use(c.Image)
+			}
+		}
+		for _, artifact := range p.Build.Artifacts {
+			for _, d := range artifact.Dependencies {
+				use(d.ImageName)
+			}
+		}
+	}
+
+	var unused []*latest.Artifact
+	for _, p := range cfg.GetPipelines() {
+		for _, artifact := range p.Build.Artifacts {
+			if !used[docker.SanitizeImageName(artifact.ImageName)] {
+				unused = append(unused, artifact)
+			}
+		}
+	}
+	return unused, nil
+}
+
 func typeOfArtifact(a *latest.Artifact) string {
 	switch {
 	case a.DockerArtifact != nil:
@@ -120,6 +173,8 @@ func typeOfArtifact(a *latest.Artifact) string {
 		return "Buildpack artifact"
 	case a.KoArtifact != nil:
 		return "Ko artifact"
+	case a.ApkoArtifact != nil:
+		return "Apko artifact"
 	default:
 		panic("Unknown artifact")
 	}
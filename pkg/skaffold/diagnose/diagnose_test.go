@@ -21,6 +21,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
@@ -114,6 +115,28 @@ func TestCheckArtifactsFailure(t *testing.T) {
 	})
 }
 
+func TestUnusedArtifacts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		app := &latest.Artifact{ImageName: "gcr.io/project/app", Dependencies: []*latest.ArtifactDependency{{ImageName: "base"}}}
+		base := &latest.Artifact{ImageName: "base"}
+		typo := &latest.Artifact{ImageName: "gcr.io/project/frontedn"}
+		manifests := manifest.NewManifestListByConfig()
+		manifests.Add("config", manifest.ManifestList{[]byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: app
+spec:
+  containers:
+  - name: app
+    image: gcr.io/project/app:v1
+  - name: frontend
+    image: gcr.io/project/frontend`)})
+
+		unused, err := UnusedArtifacts(&mockConfig{artifacts: []*latest.Artifact{app, base, typo}}, manifests)
+		t.CheckErrorAndDeepEqual(false, err, []*latest.Artifact{typo}, unused)
+	})
+}
+
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	artifacts             []*latest.Artifact
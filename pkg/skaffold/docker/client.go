@@ -49,13 +49,17 @@ const oldMinikubeBadUsageExitCode = 64
 
 // For testing
 var (
-	NewAPIClient = NewAPIClientImpl
+	NewAPIClient     = NewAPIClientImpl
+	NewHostAPIClient = NewHostAPIClientImpl
 )
 
 var (
 	dockerAPIClientOnce sync.Once
 	dockerAPIClient     LocalDaemon
 	dockerAPIClientErr  error
+
+	hostAPIClientsMutex sync.Mutex
+	hostAPIClients      = map[string]LocalDaemon{}
 )
 
 type Config interface {
@@ -100,18 +104,8 @@ func newAPIClient(ctx context.Context, kubeContext string, minikubeProfile strin
 func newEnvAPIClient() ([]string, client.CommonAPIClient, error) {
 	var opts = []client.Opt{client.WithHTTPHeaders(getUserAgentHeader())}
 	if host := os.Getenv("DOCKER_HOST"); host != "" {
-		helper, err := connhelper.GetConnectionHelper(host)
-		if err == nil && helper != nil {
-			httpClient := &http.Client{
-				Transport: &http.Transport{
-					DialContext: helper.Dialer,
-				},
-			}
-			opts = append(opts,
-				client.WithHTTPClient(httpClient),
-				client.WithHost(helper.Host),
-				client.WithDialContext(helper.Dialer),
-			)
+		if helperOpts := connectionHelperOpts(host); helperOpts != nil {
+			opts = append(opts, helperOpts...)
 		} else {
 			opts = append(opts, client.FromEnv)
 		}
@@ -141,6 +135,63 @@ func newEnvAPIClient() ([]string, client.CommonAPIClient, error) {
 	return nil, cli, nil
 }
 
+// NewHostAPIClientImpl returns a client of the docker daemon at the given host, like `ssh://user@builder` or `tcp://10.0.0.5:2376`,
+// after checking that the daemon is reachable. The docker CLI commands run with this client also target the host.
+// Like the default client, the client of a host is created once and shared by the builders and the cache.
+func NewHostAPIClientImpl(ctx context.Context, cfg Config, host string) (LocalDaemon, error) {
+	hostAPIClientsMutex.Lock()
+	defer hostAPIClientsMutex.Unlock()
+
+	if localDocker, found := hostAPIClients[host]; found {
+		return localDocker, nil
+	}
+	localDocker, err := newHostAPIClient(ctx, cfg, host)
+	if err != nil {
+		return nil, err
+	}
+	hostAPIClients[host] = localDocker
+	return localDocker, nil
+}
+
+func newHostAPIClient(ctx context.Context, cfg Config, host string) (LocalDaemon, error) {
+	opts := []client.Opt{client.WithHTTPHeaders(getUserAgentHeader())}
+	if helperOpts := connectionHelperOpts(host); helperOpts != nil {
+		opts = append(opts, helperOpts...)
+	} else {
+		opts = append(opts, client.WithHost(host), client.WithTLSClientConfigFromEnv())
+	}
+	cli, err := client.NewClientWithOpts(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting docker client for host %q: %s", host, err)
+	}
+	if _, err := cli.ServerVersion(ctx); err != nil {
+		cli.Close()
+		return nil, fmt.Errorf("docker host %q is unreachable: %w", host, err)
+	}
+	cli.NegotiateAPIVersion(ctx)
+
+	return NewLocalDaemon(cli, []string{"DOCKER_HOST=" + host}, cfg.Prune(), cfg), nil
+}
+
+// connectionHelperOpts returns the client options to connect to a host through a connection helper,
+// like ssh, or nil if the host doesn't need one.
+func connectionHelperOpts(host string) []client.Opt {
+	helper, err := connhelper.GetConnectionHelper(host)
+	if err != nil || helper == nil {
+		return nil
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: helper.Dialer,
+		},
+	}
+	return []client.Opt{
+		client.WithHTTPClient(httpClient),
+		client.WithHost(helper.Host),
+		client.WithDialContext(helper.Dialer),
+	}
+}
+
 type ExitCoder interface {
 	ExitCode() int
 }
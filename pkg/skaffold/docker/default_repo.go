@@ -17,10 +17,13 @@ limitations under the License.
 package docker
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/distribution/reference"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 )
 
 const maxLength = 255
@@ -33,7 +36,33 @@ var (
 	prefixRegex = regexp.MustCompile(`^` + reference.DomainRegexp.String() + `/` + gcpProjectIDRegex + `/?`)
 )
 
-func SubstituteDefaultRepoIntoImage(defaultRepo string, multiLevelRepo *bool, image string) (string, error) {
+// IsDefaultRepoTemplate returns true if the default repo is a template, like `gcr.io/project/{{.Namespace}}/{{.ImageName}}`,
+// that is evaluated for each image instead of being used as a prefix.
+func IsDefaultRepoTemplate(defaultRepo string) bool {
+	return strings.Contains(defaultRepo, "{{")
+}
+
+// ValidateDefaultRepo returns an error if the default repo is a template that can't be parsed
+// or that references variables other than `ImageName`, `Namespace` and the environment variables.
+func ValidateDefaultRepo(defaultRepo string) error {
+	if !IsDefaultRepoTemplate(defaultRepo) {
+		return nil
+	}
+	placeholders := map[string]string{
+		"ImageName": "app",
+		"Namespace": "default",
+	}
+	if _, err := util.ExpandEnvTemplateOrFail(defaultRepo, placeholders); err != nil {
+		return fmt.Errorf("invalid default repo template %q: %w", defaultRepo, err)
+	}
+	return nil
+}
+
+// SubstituteDefaultRepoIntoImage rewrites the image to be pushed to the default repo.
+// A templated default repo is evaluated with the environment variables and:
+//   - `ImageName`: the image name without its registry, like `org/app` for `gcr.io/org/app`.
+//   - `Namespace`: the namespace deployed to.
+func SubstituteDefaultRepoIntoImage(defaultRepo string, multiLevelRepo *bool, namespace string, image string) (string, error) {
 	if defaultRepo == "" {
 		return image, nil
 	}
@@ -43,7 +72,19 @@ func SubstituteDefaultRepoIntoImage(defaultRepo string, multiLevelRepo *bool, im
 		return "", err
 	}
 
-	replaced := replace(defaultRepo, multiLevelRepo, parsed.BaseName)
+	var replaced string
+	if IsDefaultRepoTemplate(defaultRepo) {
+		replaced, err = util.ExpandEnvTemplateOrFail(defaultRepo, map[string]string{
+			"ImageName": imagePath(parsed),
+			"Namespace": namespace,
+		})
+		if err != nil {
+			return "", fmt.Errorf("evaluating default repo template: %w", err)
+		}
+		replaced = truncate(replaced)
+	} else {
+		replaced = replace(defaultRepo, multiLevelRepo, parsed.BaseName)
+	}
 	if parsed.Tag != "" {
 		replaced = replaced + ":" + parsed.Tag
 	}
@@ -54,6 +95,16 @@ func SubstituteDefaultRepoIntoImage(defaultRepo string, multiLevelRepo *bool, im
 	return replaced, nil
 }
 
+// imagePath returns the image name without its registry.
+// Like docker, the first component of the name is a registry if it's `localhost` or contains a `.` or a `:`.
+func imagePath(parsed *ImageReference) string {
+	registry, path, found := strings.Cut(parsed.BaseName, "/")
+	if found && (registry == "localhost" || strings.ContainsAny(registry, ".:")) {
+		return path
+	}
+	return parsed.BaseName
+}
+
 func replace(defaultRepo string, multiLevelRepo *bool, baseImage string) string {
 	if strings.HasPrefix(baseImage, defaultRepo) {
 		return baseImage
@@ -29,6 +29,7 @@ func TestImageReplaceDefaultRepo(t *testing.T) {
 		image          string
 		defaultRepo    string
 		multiLevelRepo *bool
+		namespace      string
 		expectedImage  string
 		shouldErr      bool
 	}{
@@ -155,12 +156,70 @@ func TestImageReplaceDefaultRepo(t *testing.T) {
 			image:       "!!invalid!!",
 			shouldErr:   true,
 		},
+		{
+			description:   "template",
+			image:         "img:tag",
+			defaultRepo:   "gcr.io/project/{{.Namespace}}/{{.ImageName}}",
+			namespace:     "team-a",
+			expectedImage: "gcr.io/project/team-a/img:tag",
+		},
+		{
+			description:   "template strips the registry",
+			image:         "gcr.io/org/img",
+			defaultRepo:   "registry.example.com/{{.Namespace}}/{{.ImageName}}",
+			namespace:     "team-a",
+			expectedImage: "registry.example.com/team-a/org/img",
+		},
+		{
+			description:   "template keeps the image path",
+			image:         "org/img@sha256:81daf011d63b68cfa514ddab7741a1adddd59d3264118dfb0fd9266328bb8883",
+			defaultRepo:   "registry.example.com/{{.ImageName}}",
+			expectedImage: "registry.example.com/org/img@sha256:81daf011d63b68cfa514ddab7741a1adddd59d3264118dfb0fd9266328bb8883",
+		},
+		{
+			description: "template with unknown variable",
+			image:       "img",
+			defaultRepo: "gcr.io/project/{{.Unknown}}",
+			shouldErr:   true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			replaced, err := SubstituteDefaultRepoIntoImage(test.defaultRepo, test.multiLevelRepo, test.image)
+			replaced, err := SubstituteDefaultRepoIntoImage(test.defaultRepo, test.multiLevelRepo, test.namespace, test.image)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedImage, replaced)
 		})
 	}
 }
+
+func TestValidateDefaultRepo(t *testing.T) {
+	tests := []struct {
+		description string
+		defaultRepo string
+		shouldErr   bool
+	}{
+		{
+			description: "prefix",
+			defaultRepo: "gcr.io/project",
+		},
+		{
+			description: "template",
+			defaultRepo: "gcr.io/project/{{.Namespace}}/{{.ImageName}}",
+		},
+		{
+			description: "invalid template",
+			defaultRepo: "gcr.io/project/{{.Namespace",
+			shouldErr:   true,
+		},
+		{
+			description: "template with unknown variable",
+			defaultRepo: "gcr.io/project/{{.Namespce}}/{{.ImageName}}",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckError(test.shouldErr, ValidateDefaultRepo(test.defaultRepo))
+		})
+	}
+}
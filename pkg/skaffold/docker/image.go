@@ -44,6 +44,7 @@ import (
 	"github.com/docker/go-connections/nat"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 	sErrors "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
@@ -338,6 +339,7 @@ func (l *localDaemon) Build(ctx context.Context, out io.Writer, workspace string
 
 	buildCtx, buildCtxWriter := io.Pipe()
 	go func() {
+		defer timing.Start(ctx, artifact, timing.Context)()
 		err := CreateDockerTarContext(ctx, buildCtxWriter,
 			NewBuildConfig(workspace, artifact, a.DockerfilePath, buildArgs), l.cfg)
 		if err != nil {
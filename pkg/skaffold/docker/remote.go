@@ -35,9 +35,10 @@ import (
 
 // for testing
 var (
-	RemoteDigest = getRemoteDigest
-	remoteImage  = remote.Image
-	remoteIndex  = remote.Index
+	RemoteDigest  = getRemoteDigest
+	CopyRemoteTag = copyRemoteTag
+	remoteImage   = remote.Image
+	remoteIndex   = remote.Index
 )
 
 func AddRemoteTag(src, target string, cfg Config, platforms []specs.Platform) error {
@@ -68,6 +69,36 @@ func AddRemoteTag(src, target string, cfg Config, platforms []specs.Platform) er
 	return remote.Write(targetRef, img, remote.WithAuthFromKeychain(primaryKeychain))
 }
 
+// copyRemoteTag tags the remote image or image index of src with target, in the same repository.
+func copyRemoteTag(src, target string, cfg Config) error {
+	log.Entry(context.TODO()).Debugf("attempting to add tag %s to src %s", target, src)
+
+	srcRef, err := parseReference(src, cfg)
+	if err != nil {
+		return err
+	}
+	targetRef, err := parseReference(target, cfg, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+	tag, ok := targetRef.(name.Tag)
+	if !ok {
+		return fmt.Errorf("%q is not a tag", target)
+	}
+
+	options := []remote.Option{
+		remote.WithAuthFromKeychain(primaryKeychain),
+	}
+	if IsInsecure(srcRef, cfg.GetInsecureRegistries()) {
+		options = append(options, insecureTransportOption())
+	}
+	desc, err := remote.Get(srcRef, options...)
+	if err != nil {
+		return fmt.Errorf("getting %s: %w", src, err)
+	}
+	return remote.Tag(tag, desc, options...)
+}
+
 func getRemoteDigest(identifier string, cfg Config, platforms []specs.Platform) (string, error) {
 	idx, err := getRemoteIndex(identifier, cfg)
 	if err == nil {
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// customSuggestionsConfig is implemented by the configs that provide user-defined suggestions.
+type customSuggestionsConfig interface {
+	StatusCheckSuggestions() []latest.StatusCheckSuggestion
+}
+
+// CustomSuggestions returns the suggestions configured by the user for the status codes, in configuration order.
+func CustomSuggestions(cfg interface{}, codes ...proto.StatusCode) []*proto.Suggestion {
+	c, ok := cfg.(customSuggestionsConfig)
+	if v := reflect.ValueOf(cfg); !ok || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil
+	}
+	var suggestions []*proto.Suggestion
+	for _, s := range c.StatusCheckSuggestions() {
+		for _, code := range codes {
+			if s.Code == code.String() {
+				suggestions = append(suggestions, &proto.Suggestion{
+					SuggestionCode: proto.SuggestionCode_CUSTOM_SUGGESTION,
+					Action:         customAction(s),
+				})
+				break
+			}
+		}
+	}
+	return suggestions
+}
+
+// WithCustomSuggestions returns a copy of the actionable error with the suggestions configured by the user
+// for its status code, and the additional status codes, before its own suggestions.
+func WithCustomSuggestions(cfg interface{}, ae *proto.ActionableErr, codes ...proto.StatusCode) *proto.ActionableErr {
+	custom := CustomSuggestions(cfg, append([]proto.StatusCode{ae.GetErrCode()}, codes...)...)
+	if len(custom) == 0 {
+		return ae
+	}
+	return &proto.ActionableErr{
+		ErrCode:     ae.GetErrCode(),
+		Message:     ae.GetMessage(),
+		Suggestions: append(custom, ae.GetSuggestions()...),
+	}
+}
+
+func customAction(s latest.StatusCheckSuggestion) string {
+	switch {
+	case s.URL == "":
+		return s.Action
+	case s.Action == "":
+		return fmt.Sprintf("See %s", s.URL)
+	default:
+		return fmt.Sprintf("%s (see %s)", strings.TrimSuffix(s.Action, "."), s.URL)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func runCtxWithSuggestions(suggestions ...latest.StatusCheckSuggestion) *runcontext.RunContext {
+	return &runcontext.RunContext{
+		Pipelines: runcontext.NewPipelines(map[string]latest.Pipeline{"": {Deploy: latest.DeployConfig{StatusCheckSuggestions: suggestions}}}, []string{""}),
+	}
+}
+
+func TestWithCustomSuggestions(t *testing.T) {
+	runCtx := runCtxWithSuggestions(
+		latest.StatusCheckSuggestion{Code: "STATUSCHECK_IMAGE_PULL_ERR", Action: "Check the team registry"},
+		latest.StatusCheckSuggestion{Code: "STATUSCHECK_IMAGE_PULL_ERR", URL: "https://wiki.example.com/image-pull"},
+		latest.StatusCheckSuggestion{Code: "STATUSCHECK_DEADLINE_EXCEEDED", Action: "Check the cluster capacity.", URL: "https://wiki.example.com/capacity"},
+	)
+	builtin := &proto.Suggestion{SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_IMAGE, Action: "Check the image"}
+	tests := []struct {
+		description string
+		cfg         interface{}
+		ae          *proto.ActionableErr
+		codes       []proto.StatusCode
+		expected    *proto.ActionableErr
+	}{
+		{
+			description: "custom suggestions before built-in ones",
+			cfg:         runCtx,
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, Message: "image can't be pulled", Suggestions: []*proto.Suggestion{builtin}},
+			expected: &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, Message: "image can't be pulled", Suggestions: []*proto.Suggestion{
+				{SuggestionCode: proto.SuggestionCode_CUSTOM_SUGGESTION, Action: "Check the team registry"},
+				{SuggestionCode: proto.SuggestionCode_CUSTOM_SUGGESTION, Action: "See https://wiki.example.com/image-pull"},
+				builtin,
+			}},
+		},
+		{
+			description: "additional status code",
+			cfg:         runCtx,
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "failed"},
+			codes:       []proto.StatusCode{proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED},
+			expected: &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "failed", Suggestions: []*proto.Suggestion{
+				{SuggestionCode: proto.SuggestionCode_CUSTOM_SUGGESTION, Action: "Check the cluster capacity (see https://wiki.example.com/capacity)"},
+			}},
+		},
+		{
+			description: "no custom suggestion for status code",
+			cfg:         runCtx,
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "failed"},
+			expected:    &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "failed"},
+		},
+		{
+			description: "nil config",
+			cfg:         (*runcontext.RunContext)(nil),
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, Message: "image can't be pulled"},
+			expected:    &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, Message: "image can't be pulled"},
+		},
+		{
+			description: "config without custom suggestions",
+			cfg:         nil,
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, Message: "image can't be pulled"},
+			expected:    &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, Message: "image can't be pulled"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, WithCustomSuggestions(test.cfg, test.ae, test.codes...), protocmp.Transform())
+		})
+	}
+}
+
+func TestActionableErrWithCustomSuggestions(t *testing.T) {
+	runCtx := runCtxWithSuggestions(latest.StatusCheckSuggestion{Code: "STATUSCHECK_UNKNOWN", Action: "Ask in the platform channel"})
+
+	ae := ActionableErr(runCtx, constants.StatusCheck, errors.New("something went wrong"))
+
+	testutil.CheckDeepEqual(t, &proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+		Message: "something went wrong",
+		Suggestions: append([]*proto.Suggestion{{SuggestionCode: proto.SuggestionCode_CUSTOM_SUGGESTION, Action: "Ask in the platform channel"}},
+			ReportIssueSuggestion(runCtx)...),
+	}, ae, protocmp.Transform())
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package errors
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
 )
@@ -70,6 +72,20 @@ func NewErrorWithStatusCode(ae *proto.ActionableErr) *ErrDef {
 	}
 }
 
+// WithStatusCode creates an actionable error with the given status code preserving the actual error,
+// and the suggestions of the actionable error it wraps, if any.
+func WithStatusCode(err error, sc proto.StatusCode) *ErrDef {
+	ae := &proto.ActionableErr{ErrCode: sc, Message: err.Error()}
+	var e *ErrDef
+	if errors.As(err, &e) {
+		ae.Suggestions = e.Suggestions()
+		if s := concatSuggestions(e.Suggestions()); s != "" {
+			ae.Message = strings.TrimSuffix(ae.Message, ". "+s)
+		}
+	}
+	return NewError(err, ae)
+}
+
 func IsSkaffoldErr(err error) bool {
 	if _, ok := err.(Error); ok {
 		return true
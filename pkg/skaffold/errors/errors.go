@@ -98,14 +98,14 @@ func ShowAIError(cfg interface{}, err error) error {
 
 	if p, ok := isProblem(err); ok {
 		instrumentation.SetErrorCode(p.ErrCode)
-		return p.AIError(cfg, err)
+		return p.withCustomSuggestions(cfg).AIError(cfg, err)
 	}
 
 	for _, problems := range GetProblemCatalogCopy().allErrors {
 		for _, p := range problems {
 			if p.Regexp.MatchString(err.Error()) {
 				instrumentation.SetErrorCode(p.ErrCode)
-				return p.AIError(cfg, err)
+				return p.withCustomSuggestions(cfg).AIError(cfg, err)
 			}
 		}
 	}
@@ -115,17 +115,18 @@ func ShowAIError(cfg interface{}, err error) error {
 func getErrorCodeFromError(cfg interface{}, phase constants.Phase, err error) (proto.StatusCode, []*proto.Suggestion) {
 	var sErr Error
 	if errors.As(err, &sErr) {
-		return sErr.StatusCode(), sErr.Suggestions()
+		return sErr.StatusCode(), append(CustomSuggestions(cfg, sErr.StatusCode()), sErr.Suggestions()...)
 	}
 
 	if problems, ok := GetProblemCatalogCopy().allErrors[phase]; ok {
 		for _, p := range problems {
 			if p.Regexp.MatchString(err.Error()) {
-				return p.ErrCode, p.Suggestion(cfg)
+				return p.ErrCode, append(CustomSuggestions(cfg, p.ErrCode), p.Suggestion(cfg)...)
 			}
 		}
 	}
-	return unknownErrForPhase(phase), ReportIssueSuggestion(cfg)
+	code := unknownErrForPhase(phase)
+	return code, append(CustomSuggestions(cfg, code), ReportIssueSuggestion(cfg)...)
 }
 
 func concatSuggestions(suggestions []*proto.Suggestion) string {
@@ -17,6 +17,7 @@ limitations under the License.
 package errors
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -133,3 +134,39 @@ func TestShowAIError(t *testing.T) {
 		})
 	}
 }
+
+func TestWithStatusCode(t *testing.T) {
+	suggestion := &proto.Suggestion{SuggestionCode: proto.SuggestionCode_CHECK_CLUSTER_CONNECTION, Action: "Check your connection for the cluster"}
+	tests := []struct {
+		description         string
+		err                 error
+		expectedMessage     string
+		expectedSuggestions []*proto.Suggestion
+	}{
+		{
+			description:     "plain error",
+			err:             fmt.Errorf("1/2 deployment(s) failed"),
+			expectedMessage: "1/2 deployment(s) failed",
+		},
+		{
+			description: "actionable error with suggestions",
+			err: fmt.Errorf("deploying: %w", NewError(fmt.Errorf("unable to connect"), &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+				Message:     "unable to connect",
+				Suggestions: []*proto.Suggestion{suggestion},
+			})),
+			expectedMessage:     "deploying: unable to connect. Check your connection for the cluster.",
+			expectedSuggestions: []*proto.Suggestion{suggestion},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := WithStatusCode(test.err, proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED)
+
+			t.CheckDeepEqual(proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, err.StatusCode())
+			t.CheckDeepEqual(test.expectedMessage, err.Error())
+			t.CheckDeepEqual(test.expectedSuggestions, err.Suggestions(), protocmp.Transform())
+			t.CheckTrue(errors.Is(err, test.err))
+		})
+	}
+}
@@ -72,6 +72,23 @@ func (p Problem) AIError(i interface{}, err error) error {
 	return p
 }
 
+// withCustomSuggestions returns a copy of the problem that suggests the suggestions configured by the user
+// for its status code before its own.
+func (p Problem) withCustomSuggestions(cfg interface{}) Problem {
+	custom := CustomSuggestions(cfg, p.ErrCode)
+	if len(custom) == 0 {
+		return p
+	}
+	suggest := p.Suggestion
+	p.Suggestion = func(cfg interface{}) []*proto.Suggestion {
+		if suggest == nil {
+			return custom
+		}
+		return append(custom, suggest(cfg)...)
+	}
+	return p
+}
+
 func isProblem(err error) (Problem, bool) {
 	if p, ok := err.(Problem); ok {
 		return p, true
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	//nolint:golint,staticcheck
 	"github.com/golang/protobuf/jsonpb"
@@ -293,12 +294,45 @@ func resourceStatusCheckEventFailed(r string, ae *proto.ActionableErr) {
 }
 
 func ResourceStatusCheckEventUpdated(r string, ae *proto.ActionableErr) {
+	ResourceStatusCheckEventProgressed(r, ae, 0, 0)
+}
+
+// ResourceStatusCheckEventProgressed notifies that the status of a resource has changed, with the rollout progress
+// of its replicas.
+func ResourceStatusCheckEventProgressed(r string, ae *proto.ActionableErr, current, total int) {
 	handler.handleResourceStatusCheckEvent(&proto.ResourceStatusCheckEvent{
-		Resource:      r,
-		Status:        InProgress,
-		Message:       ae.Message,
-		StatusCode:    ae.ErrCode,
-		ActionableErr: ae,
+		Resource:        r,
+		Status:          InProgress,
+		Message:         ae.Message,
+		StatusCode:      ae.ErrCode,
+		ActionableErr:   ae,
+		CurrentReplicas: int32(current),
+		TotalReplicas:   int32(total),
+	})
+}
+
+// ResourceStatusCheckEventStarted notifies that the status check of a resource has started.
+func ResourceStatusCheckEventStarted(r, kind, name, namespace string, deadline time.Duration) {
+	handler.handleResourceStatusCheckEvent(&proto.ResourceStatusCheckEvent{
+		Resource:        r,
+		Status:          Started,
+		Kind:            kind,
+		Name:            name,
+		Namespace:       namespace,
+		DeadlineSeconds: int32(deadline.Seconds()),
+	})
+}
+
+// ResourceStatusCheckEventEnded notifies that the status check of a resource has ended, whatever its outcome.
+func ResourceStatusCheckEventEnded(r, kind, name, namespace string, deadline time.Duration, sc proto.StatusCode) {
+	handler.handleResourceStatusCheckEvent(&proto.ResourceStatusCheckEvent{
+		Resource:        r,
+		Status:          Complete,
+		StatusCode:      sc,
+		Kind:            kind,
+		Name:            name,
+		Namespace:       namespace,
+		DeadlineSeconds: int32(deadline.Seconds()),
 	})
 }
 
@@ -442,7 +476,7 @@ func FileSyncSucceeded(fileCount int, image string) {
 }
 
 // PortForwarded notifies that a remote port has been forwarded locally.
-func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
+func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address, protocol string, reconnected bool) {
 	event := proto.PortEvent{
 		LocalPort:     localPort,
 		PodName:       podName,
@@ -452,6 +486,8 @@ func PortForwarded(localPort int32, remotePort util.IntOrString, podName, contai
 		ResourceType:  resourceType,
 		ResourceName:  resourceName,
 		Address:       address,
+		Protocol:      protocol,
+		Reconnected:   reconnected,
 		TargetPort: &proto.IntOrString{
 			Type:   int32(remotePort.Type),
 			IntVal: int32(remotePort.IntVal),
@@ -653,7 +689,11 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		}
 		ev.state.ForwardedPorts[pe.LocalPort] = pe
 		ev.stateLock.Unlock()
-		logEntry.Entry = fmt.Sprintf("Forwarding container %s to local port %d", pe.ContainerName, pe.LocalPort)
+		if pe.Reconnected {
+			logEntry.Entry = fmt.Sprintf("Reconnected forwarding of container %s to local port %d", pe.ContainerName, pe.LocalPort)
+		} else {
+			logEntry.Entry = fmt.Sprintf("Forwarding container %s to local port %d", pe.ContainerName, pe.LocalPort)
+		}
 	case *proto.Event_StatusCheckEvent:
 		se := e.StatusCheckEvent
 		ev.stateLock.Lock()
@@ -677,9 +717,16 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		if ev.state.StatusCheckState.Resources == nil {
 			ev.state.StatusCheckState.Resources = map[string]string{}
 		}
-		ev.state.StatusCheckState.Resources[rseName] = rse.Status
+		// the end of a resource status check keeps the Succeeded or Failed status it ended with.
+		if prev := ev.state.StatusCheckState.Resources[rseName]; rse.Status != Complete || (prev != Succeeded && prev != Failed) {
+			ev.state.StatusCheckState.Resources[rseName] = rse.Status
+		}
 		ev.stateLock.Unlock()
 		switch rse.Status {
+		case Started:
+			logEntry.Entry = fmt.Sprintf("Resource %s status check started", rseName)
+		case Complete:
+			logEntry.Entry = fmt.Sprintf("Resource %s status check ended", rseName)
 		case InProgress:
 			logEntry.Entry = fmt.Sprintf("Resource %s status updated to %s", rseName, rse.Status)
 		case Succeeded:
@@ -211,14 +211,19 @@ func TestPortForwarded(t *testing.T) {
 	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
 
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] == nil })
-	PortForwarded(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1")
+	PortForwarded(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1", "TCP", false)
 	wait(t, func() bool {
 		return handler.getState().ForwardedPorts[8080] != nil && handler.getState().ForwardedPorts[8080].RemotePort == 8888
 	})
 
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8081] == nil })
-	PortForwarded(8081, schemautil.FromString("http"), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1")
+	PortForwarded(8081, schemautil.FromString("http"), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1", "TCP", false)
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8081] != nil })
+
+	PortForwarded(8080, schemautil.FromInt(8888), "newpod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1", "TCP", true)
+	wait(t, func() bool {
+		return handler.getState().ForwardedPorts[8080].GetReconnected() && handler.getState().ForwardedPorts[8080].PodName == "newpod"
+	})
 }
 
 // Ensure that port-forward event handling deals with a nil State.ForwardedPorts map.
@@ -233,7 +238,7 @@ func TestPortForwarded_handleNil(t *testing.T) {
 	if handler.getState().ForwardedPorts != nil {
 		t.Error("ForwardPorts should be a nil map")
 	}
-	PortForwarded(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1")
+	PortForwarded(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1", "TCP", false)
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] != nil })
 }
 
@@ -341,6 +346,49 @@ func TestResourceStatusCheckEventFailed(t *testing.T) {
 	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:pod/foo"] == Failed })
 }
 
+func TestResourceStatusCheckEventStarted(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
+	ResourceStatusCheckEventStarted("ns:deployment/foo", "deployment", "foo", "ns", 2*time.Minute)
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/foo"] == Started })
+}
+
+func TestResourceStatusCheckEventEnded(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
+	ResourceStatusCheckEventEnded("ns:deployment/foo", "deployment", "foo", "ns", 2*time.Minute, proto.StatusCode_STATUSCHECK_USER_CANCELLED)
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/foo"] == Complete })
+}
+
+func TestResourceStatusCheckEventEndedKeepsFailure(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
+	resourceStatusCheckEventFailed("ns:deployment/foo", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED})
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/foo"] == Failed })
+	ResourceStatusCheckEventEnded("ns:deployment/foo", "deployment", "foo", "ns", 2*time.Minute, proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED)
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		handler.logLock.Unlock()
+		return logEntry.GetEvent().GetResourceStatusCheckEvent().GetStatus() == Complete
+	})
+	if status := handler.getState().StatusCheckState.Resources["ns:deployment/foo"]; status != Failed {
+		t.Errorf("expected the resource status to stay %q, got %q", Failed, status)
+	}
+}
+
 func TestFileSyncInProgress(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -85,6 +85,8 @@ func getBuilders(b latest.BuildConfig) []*proto.BuildMetadata_ImageBuilder {
 			updateOrAddKey(m, proto.BuilderType_KANIKO)
 		case a.KoArtifact != nil:
 			updateOrAddKey(m, proto.BuilderType_KO)
+		case a.ApkoArtifact != nil:
+			updateOrAddKey(m, proto.BuilderType_APKO)
 		default:
 			updateOrAddKey(m, proto.BuilderType_UNKNOWN_BUILDER_TYPE)
 		}
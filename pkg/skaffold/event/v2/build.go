@@ -26,8 +26,9 @@ import (
 )
 
 const (
-	Cache = "Cache"
-	Build = "Build"
+	Cache   = "Cache"
+	Build   = "Build"
+	Timings = "Timings"
 )
 
 func CacheCheckInProgress(artifact, platforms string) {
@@ -58,6 +59,20 @@ func BuildCanceled(artifact, platforms string, err error) {
 	buildSubtaskEvent(artifact, platforms, Build, Canceled, err)
 }
 
+// BuildTimings sends the time spent by the artifact in each build phase.
+func BuildTimings(artifact, platforms string, phaseTimings []*proto.BuildPhaseTiming) {
+	handler.handleBuildSubtaskEvent(&proto.BuildSubtaskEvent{
+		Id:              artifact,
+		TaskId:          fmt.Sprintf("%s-%d", constants.Build, handler.iteration),
+		Artifact:        artifact,
+		TargetPlatforms: platforms,
+		HostPlatform:    platform.Host.String(),
+		Step:            Timings,
+		Status:          Succeeded,
+		PhaseTimings:    phaseTimings,
+	})
+}
+
 func buildSubtaskEvent(artifact, platforms, step, status string, err error) {
 	var aErr *proto.ActionableErr
 	if err != nil {
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	proto "github.com/GoogleContainerTools/skaffold/v2/proto/v2"
+)
+
+func TestBuildTimings(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{
+		Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "app"}}},
+	}}, "test"))
+
+	BuildTimings("app", "linux/amd64", []*proto.BuildPhaseTiming{
+		{Phase: "context", DurationMs: 8100},
+		{Phase: "build", DurationMs: 1900},
+	})
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) == 0 {
+			return false
+		}
+		be := handler.eventLog[len(handler.eventLog)-1].GetBuildSubtaskEvent()
+		return be != nil && be.Artifact == "app" && be.Step == Timings && len(be.PhaseTimings) == 2 &&
+			be.PhaseTimings[0].Phase == "context" && be.PhaseTimings[0].DurationMs == 8100
+	})
+	// the timings don't change the build state of the artifact.
+	wait(t, func() bool { return handler.getState().BuildState.Artifacts["app"] == NotStarted })
+}
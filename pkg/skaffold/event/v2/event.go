@@ -179,7 +179,7 @@ func (ev *eventHandler) log(event *proto.Event, listeners *[]*listener, log *[]*
 }
 
 // PortForwarded notifies that a remote port has been forwarded locally.
-func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
+func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address, protocol string, reconnected bool) {
 	event := proto.PortForwardEvent{
 		TaskId:        fmt.Sprintf("%s-%d", constants.PortForward, handler.iteration),
 		LocalPort:     localPort,
@@ -190,6 +190,8 @@ func PortForwarded(localPort int32, remotePort util.IntOrString, podName, contai
 		ResourceType:  resourceType,
 		ResourceName:  resourceName,
 		Address:       address,
+		Protocol:      protocol,
+		Reconnected:   reconnected,
 		TargetPort: &proto.IntOrString{
 			Type:   int32(remotePort.Type),
 			IntVal: int32(remotePort.IntVal),
@@ -281,7 +283,10 @@ func (ev *eventHandler) handleExec(event *proto.Event) {
 	case *proto.Event_StatusCheckSubtaskEvent:
 		se := e.StatusCheckSubtaskEvent
 		ev.stateLock.Lock()
-		ev.state.StatusCheckState.Resources[se.Resource] = se.Status
+		// the end of a resource status check keeps the Succeeded or Failed status it ended with.
+		if prev := ev.state.StatusCheckState.Resources[se.Resource]; se.Status != Complete || (prev != Succeeded && prev != Failed) {
+			ev.state.StatusCheckState.Resources[se.Resource] = se.Status
+		}
 		ev.stateLock.Unlock()
 	case *proto.Event_FileSyncEvent:
 		fse := e.FileSyncEvent
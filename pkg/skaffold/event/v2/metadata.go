@@ -104,6 +104,8 @@ func getArtifacts(b latest.BuildConfig) []*proto.BuildMetadata_Artifact {
 			artifact.Dockerfile = a.KanikoArtifact.DockerfilePath
 		case a.KoArtifact != nil:
 			artifact.Type = proto.BuilderType_KO
+		case a.ApkoArtifact != nil:
+			artifact.Type = proto.BuilderType_APKO
 		default:
 			artifact.Type = proto.BuilderType_UNKNOWN_BUILDER_TYPE
 		}
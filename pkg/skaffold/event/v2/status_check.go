@@ -18,12 +18,42 @@ package v2
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/v2/proto/enums"
 	proto "github.com/GoogleContainerTools/skaffold/v2/proto/v2"
 )
 
+// ResourceStatusCheckEventStarted notifies that the status check of a resource has started.
+func ResourceStatusCheckEventStarted(r, kind, name, namespace string, deadline time.Duration) {
+	handler.handleStatusCheckSubtaskEvent(&proto.StatusCheckSubtaskEvent{
+		Id:              r,
+		TaskId:          fmt.Sprintf("%s-%d", constants.Deploy, handler.iteration),
+		Resource:        r,
+		Status:          Started,
+		Kind:            kind,
+		Name:            name,
+		Namespace:       namespace,
+		DeadlineSeconds: int32(deadline.Seconds()),
+	})
+}
+
+// ResourceStatusCheckEventEnded notifies that the status check of a resource has ended, whatever its outcome.
+func ResourceStatusCheckEventEnded(r, kind, name, namespace string, deadline time.Duration, sc proto.StatusCode) {
+	handler.handleStatusCheckSubtaskEvent(&proto.StatusCheckSubtaskEvent{
+		Id:              r,
+		TaskId:          fmt.Sprintf("%s-%d", constants.Deploy, handler.iteration),
+		Resource:        r,
+		Status:          Complete,
+		StatusCode:      sc,
+		Kind:            kind,
+		Name:            name,
+		Namespace:       namespace,
+		DeadlineSeconds: int32(deadline.Seconds()),
+	})
+}
+
 func ResourceStatusCheckEventCompleted(r string, ae *proto.ActionableErr) {
 	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
 		resourceStatusCheckEventFailed(r, ae)
@@ -65,14 +95,22 @@ func resourceStatusCheckEventFailed(r string, ae *proto.ActionableErr) {
 }
 
 func ResourceStatusCheckEventUpdated(r string, ae *proto.ActionableErr) {
+	ResourceStatusCheckEventProgressed(r, ae, 0, 0)
+}
+
+// ResourceStatusCheckEventProgressed notifies that the status of a resource has changed, with the rollout progress
+// of its replicas.
+func ResourceStatusCheckEventProgressed(r string, ae *proto.ActionableErr, current, total int) {
 	handler.handleStatusCheckSubtaskEvent(&proto.StatusCheckSubtaskEvent{
-		Id:            r,
-		TaskId:        fmt.Sprintf("%s-%d", constants.Deploy, handler.iteration),
-		Resource:      r,
-		Status:        InProgress,
-		Message:       ae.Message,
-		StatusCode:    ae.ErrCode,
-		ActionableErr: ae,
+		Id:              r,
+		TaskId:          fmt.Sprintf("%s-%d", constants.Deploy, handler.iteration),
+		Resource:        r,
+		Status:          InProgress,
+		Message:         ae.Message,
+		StatusCode:      ae.ErrCode,
+		ActionableErr:   ae,
+		CurrentReplicas: int32(current),
+		TotalReplicas:   int32(total),
 	})
 }
 
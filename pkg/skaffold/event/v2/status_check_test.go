@@ -18,6 +18,7 @@ package v2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	proto "github.com/GoogleContainerTools/skaffold/v2/proto/v2"
@@ -37,6 +38,27 @@ func TestResourceStatusCheckEventUpdated(t *testing.T) {
 	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:pod/foo"] == InProgress })
 }
 
+func TestResourceStatusCheckEventProgressed(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
+	ResourceStatusCheckEventProgressed("ns:deployment/foo", &proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+		Message: "waiting for rollout to finish: 1 of 3 updated replicas are available...",
+	}, 1, 3)
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/foo"] == InProgress })
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		handler.logLock.Unlock()
+		se := logEntry.GetStatusCheckSubtaskEvent()
+		return se != nil && se.CurrentReplicas == 1 && se.TotalReplicas == 3
+	})
+}
+
 func TestResourceStatusCheckEventSucceeded(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -61,3 +83,60 @@ func TestResourceStatusCheckEventFailed(t *testing.T) {
 	})
 	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:pod/foo"] == Failed })
 }
+
+func TestResourceStatusCheckEventStarted(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
+	ResourceStatusCheckEventStarted("ns:deployment/foo", "deployment", "foo", "ns", 2*time.Minute)
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/foo"] == Started })
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		handler.logLock.Unlock()
+		se := logEntry.GetStatusCheckSubtaskEvent()
+		return se != nil && se.Kind == "deployment" && se.Name == "foo" && se.Namespace == "ns" && se.DeadlineSeconds == 120
+	})
+}
+
+func TestResourceStatusCheckEventEnded(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
+	ResourceStatusCheckEventEnded("ns:deployment/foo", "deployment", "foo", "ns", 2*time.Minute, proto.StatusCode_STATUSCHECK_SUCCESS)
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/foo"] == Complete })
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		handler.logLock.Unlock()
+		se := logEntry.GetStatusCheckSubtaskEvent()
+		return se != nil && se.StatusCode == proto.StatusCode_STATUSCHECK_SUCCESS && se.Kind == "deployment" && se.DeadlineSeconds == 120
+	})
+}
+
+func TestResourceStatusCheckEventEndedKeepsFailure(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
+	resourceStatusCheckEventFailed("ns:deployment/foo", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED})
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/foo"] == Failed })
+	ResourceStatusCheckEventEnded("ns:deployment/foo", "deployment", "foo", "ns", 2*time.Minute, proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED)
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		handler.logLock.Unlock()
+		return logEntry.GetStatusCheckSubtaskEvent().GetStatus() == Complete
+	})
+	if status := handler.getState().StatusCheckState.Resources["ns:deployment/foo"]; status != Failed {
+		t.Errorf("expected the resource status to stay %q, got %q", Failed, status)
+	}
+}
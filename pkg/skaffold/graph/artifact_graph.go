@@ -25,6 +25,8 @@ type Artifact struct {
 	ImageName   string `json:"imageName"`
 	Tag         string `json:"tag"`
 	RuntimeType string `json:"runtimeType,omitempty"`
+	// SBOM is the location of the software bill of materials generated for the image.
+	SBOM string `json:"sbom,omitempty"`
 }
 
 // ArtifactGraph is a map of [artifact image : artifact definition]
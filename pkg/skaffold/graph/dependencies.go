@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/apko"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/bazel"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/custom"
@@ -158,6 +159,9 @@ func sourceDependenciesForArtifact(ctx context.Context, a *latest.Artifact, cfg
 	case a.KoArtifact != nil:
 		paths, err = ko.GetDependencies(ctx, a.Workspace, a.KoArtifact)
 
+	case a.ApkoArtifact != nil:
+		paths = apko.GetDependencies(a.ApkoArtifact)
+
 	default:
 		return nil, fmt.Errorf("unexpected artifact type %q:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
 	}
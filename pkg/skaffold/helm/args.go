@@ -94,6 +94,12 @@ func ConstructOverrideArgs(r *latest.HelmRelease, builds []graph.Artifact, args
 		args = append(args, "--set", fmt.Sprintf("%s=%s", expandedKey, v))
 	}
 
+	imageArgs, err := imageValueArgs(r.ImageValues, builds)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, imageArgs...)
+
 	gcs := gcs.NewGsutil()
 
 	for _, v := range r.ValuesFiles {
@@ -133,6 +139,50 @@ func ConstructOverrideArgs(r *latest.HelmRelease, builds []graph.Artifact, args
 	return args, nil
 }
 
+// imageValueArgs creates the `--set-string` arguments of the values of the repository and tag of the built images.
+// `--set` would turn a tag such as `1.10` or an all-digit commit into a number.
+func imageValueArgs(values []latest.HelmImageValues, builds []graph.Artifact) ([]string, error) {
+	var args []string
+	for _, v := range values {
+		b, found := findBuild(builds, v.Image)
+		if !found {
+			return nil, fmt.Errorf("image %q of the helm image values is not a built artifact", v.Image)
+		}
+		ref, err := docker.ParseReference(b.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("parsing reference %q of image %q: %w", b.Tag, v.Image, err)
+		}
+		if v.Repository != "" {
+			args = append(args, "--set-string", fmt.Sprintf("%s=%s", v.Repository, ref.BaseName))
+		}
+		tag := ref.Tag
+		if v.UseDigest && ref.Digest != "" {
+			// a bare digest isn't a valid tag.
+			if tag == "" {
+				return nil, fmt.Errorf("useDigest requires a tagged image, but %q of image %q is only referenced by digest: use the digest value key instead", b.Tag, v.Image)
+			}
+			tag = tag + "@" + ref.Digest
+		}
+		// images deployed without tag, e.g. with `--digest-source=none`, keep the tag of the chart.
+		if v.Tag != "" && tag != "" {
+			args = append(args, "--set-string", fmt.Sprintf("%s=%s", v.Tag, tag))
+		}
+		if v.Digest != "" && ref.Digest != "" {
+			args = append(args, "--set-string", fmt.Sprintf("%s=%s", v.Digest, ref.Digest))
+		}
+	}
+	return args, nil
+}
+
+func findBuild(builds []graph.Artifact, image string) (graph.Artifact, bool) {
+	for _, b := range builds {
+		if b.ImageName == image {
+			return b, true
+		}
+	}
+	return graph.Artifact{}, false
+}
+
 // GetArgs calculates the correct arguments to "helm get"
 func GetArgs(releaseName string, namespace string) []string {
 	args := []string{"get", "all"}
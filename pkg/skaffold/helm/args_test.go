@@ -23,6 +23,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
 
@@ -180,3 +182,73 @@ func TestExtractValueFileFromGCS(t *testing.T) {
 		})
 	}
 }
+
+func TestConstructOverrideArgsImageValues(t *testing.T) {
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	builds := []graph.Artifact{
+		{ImageName: "web", Tag: "gcr.io/project/web:v1@" + digest},
+		{ImageName: "worker", Tag: "worker:abcdef"},
+		{ImageName: "digest-only", Tag: "gcr.io/project/digest-only@" + digest},
+		{ImageName: "untagged", Tag: "untagged"},
+		{ImageName: "numeric", Tag: "numeric:1.10"},
+	}
+	tests := []struct {
+		description string
+		values      []latest.HelmImageValues
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "repository and tag",
+			values:      []latest.HelmImageValues{{Image: "web", Repository: "frontend.image.repo", Tag: "frontend.image.version"}},
+			expected:    []string{"--set-string", "frontend.image.repo=gcr.io/project/web", "--set-string", "frontend.image.version=v1"},
+		},
+		{
+			description: "tag with digest",
+			values:      []latest.HelmImageValues{{Image: "web", Tag: "image.tag", UseDigest: true}},
+			expected:    []string{"--set-string", "image.tag=v1@" + digest},
+		},
+		{
+			description: "tag with digest of an untagged image",
+			values:      []latest.HelmImageValues{{Image: "digest-only", Tag: "image.tag", UseDigest: true}},
+			shouldErr:   true,
+		},
+		{
+			description: "tag and digest",
+			values:      []latest.HelmImageValues{{Image: "web", Tag: "image.tag", Digest: "image.digest"}},
+			expected:    []string{"--set-string", "image.tag=v1", "--set-string", "image.digest=" + digest},
+		},
+		{
+			description: "digest of an untagged image",
+			values:      []latest.HelmImageValues{{Image: "digest-only", Repository: "image.repository", Tag: "image.tag", Digest: "image.digest"}},
+			expected:    []string{"--set-string", "image.repository=gcr.io/project/digest-only", "--set-string", "image.digest=" + digest},
+		},
+		{
+			description: "local image without digest",
+			values:      []latest.HelmImageValues{{Image: "worker", Repository: "worker.repository", Tag: "worker.tag", Digest: "worker.digest", UseDigest: true}},
+			expected:    []string{"--set-string", "worker.repository=worker", "--set-string", "worker.tag=abcdef"},
+		},
+		{
+			description: "image deployed without tag",
+			values:      []latest.HelmImageValues{{Image: "untagged", Repository: "image.repository", Tag: "image.tag"}},
+			expected:    []string{"--set-string", "image.repository=untagged"},
+		},
+		{
+			description: "numeric tag",
+			values:      []latest.HelmImageValues{{Image: "numeric", Tag: "image.tag"}},
+			expected:    []string{"--set-string", "image.tag=1.10"},
+		},
+		{
+			description: "image not built",
+			values:      []latest.HelmImageValues{{Image: "unknown", Tag: "image.tag"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			args, err := ConstructOverrideArgs(&latest.HelmRelease{ImageValues: test.values}, builds, nil, nil)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, args)
+		})
+	}
+}
@@ -193,8 +193,8 @@ func generateSkaffoldFilter(h Client, buildsFile string, flags []string) []strin
 
 func generateHelmCommand(ctx context.Context, h Client, useSecrets bool, env []string, args ...string) *exec.Cmd {
 	// Only add Kubernetes parameters for subcommands that need it. The plugin system
-	// shouldn't need it.
-	wantKubernetesArgs := h != nil && (len(args) == 0 || args[0] != "plugin")
+	// and registry login shouldn't need it.
+	wantKubernetesArgs := h != nil && (len(args) == 0 || (args[0] != "plugin" && args[0] != "registry"))
 
 	if wantKubernetesArgs {
 		args = append([]string{"--kube-context", h.KubeContext()}, args...)
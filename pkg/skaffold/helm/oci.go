@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+)
+
+const ociPrefix = "oci://"
+
+// IsOCIChart returns true if the chart is a reference to a chart in an OCI registry, like `oci://registry/chart`.
+func IsOCIChart(chart string) bool {
+	return strings.HasPrefix(chart, ociPrefix)
+}
+
+// ResolveOCIChart prepares a chart stored in an OCI registry to be used by helm.
+// A version pinned by the chart reference tag, like `oci://registry/chart:1.2.3`, is moved to the returned version
+// since helm expects it with `--version`. Helm is then logged in to the registry with the credentials from the docker config.
+// Other charts are returned unchanged.
+func ResolveOCIChart(ctx context.Context, h Client, chart string, version string) (string, string, error) {
+	if !IsOCIChart(chart) {
+		return chart, version, nil
+	}
+
+	ref, tag := splitOCIChartTag(chart)
+	if tag != "" {
+		if version != "" && version != tag {
+			return "", "", fmt.Errorf("chart %q is pinned to version %q but version %q is configured", chart, tag, version)
+		}
+		version = tag
+	}
+
+	if err := registryLogin(ctx, h, ociRegistry(ref)); err != nil {
+		return "", "", err
+	}
+	return ref, version, nil
+}
+
+// splitOCIChartTag splits `oci://registry/chart:tag` into `oci://registry/chart` and `tag`.
+func splitOCIChartTag(chart string) (string, string) {
+	lastSlash := strings.LastIndex(chart, "/")
+	if i := strings.LastIndex(chart, ":"); i > lastSlash {
+		return chart[:i], chart[i+1:]
+	}
+	return chart, ""
+}
+
+func ociRegistry(ref string) string {
+	registry, _, _ := strings.Cut(strings.TrimPrefix(ref, ociPrefix), "/")
+	return registry
+}
+
+// registryLogin logs helm in to the registry if the docker config has credentials for it.
+// Without credentials, helm is left to use its own registry config, which works for public registries.
+func registryLogin(ctx context.Context, h Client, registry string) error {
+	auth, err := docker.DefaultAuthHelper.GetAuthConfig(ctx, registry)
+	if err != nil {
+		log.Entry(ctx).Warnf("unable to get docker credentials for helm registry %q, helm will use its own registry config: %v", registry, err)
+		return nil
+	}
+	if auth.Username == "" || auth.Password == "" {
+		log.Entry(ctx).Debugf("no docker credentials found for helm registry %q", registry)
+		return nil
+	}
+
+	cmd := generateHelmCommand(ctx, h, false, nil, "registry", "login", registry, "--username", auth.Username, "--password-stdin")
+	cmd.Stdin = strings.NewReader(auth.Password)
+	cmd.Stdout = io.Discard
+	cmd.Stderr = io.Discard
+	if err := util.RunCmd(ctx, cmd); err != nil {
+		return fmt.Errorf("logging in to helm registry %q: %w", registry, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/docker/docker/api/types/registry"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+type stubAuth struct {
+	auths map[string]registry.AuthConfig
+	err   error
+}
+
+func (s stubAuth) GetAuthConfig(_ context.Context, host string) (registry.AuthConfig, error) {
+	return s.auths[host], s.err
+}
+
+func (s stubAuth) GetAllAuthConfigs(context.Context) (map[string]registry.AuthConfig, error) {
+	return s.auths, nil
+}
+
+func TestResolveOCIChart(t *testing.T) {
+	auths := map[string]registry.AuthConfig{
+		"us-docker.pkg.dev": {Username: "oauth2accesstoken", Password: "token"},
+	}
+	tests := []struct {
+		description     string
+		chart           string
+		version         string
+		commands        *testutil.FakeCmd
+		authErr         error
+		expectedChart   string
+		expectedVersion string
+		shouldErr       bool
+	}{
+		{
+			description:     "not an OCI chart",
+			chart:           "stable/chart",
+			version:         "1.0.0",
+			expectedChart:   "stable/chart",
+			expectedVersion: "1.0.0",
+		},
+		{
+			description:     "public registry",
+			chart:           "oci://ghcr.io/org/chart",
+			version:         "1.0.0",
+			expectedChart:   "oci://ghcr.io/org/chart",
+			expectedVersion: "1.0.0",
+		},
+		{
+			description:     "docker credentials error",
+			chart:           "oci://us-docker.pkg.dev/project/charts/chart",
+			authErr:         errors.New("credential helper failed"),
+			expectedChart:   "oci://us-docker.pkg.dev/project/charts/chart",
+			expectedVersion: "",
+		},
+		{
+			description:     "version pinned by tag",
+			chart:           "oci://localhost:5000/charts/chart:1.2.3",
+			expectedChart:   "oci://localhost:5000/charts/chart",
+			expectedVersion: "1.2.3",
+		},
+		{
+			description:     "version pinned by tag and version",
+			chart:           "oci://localhost:5000/charts/chart:1.2.3",
+			version:         "1.2.3",
+			expectedChart:   "oci://localhost:5000/charts/chart",
+			expectedVersion: "1.2.3",
+		},
+		{
+			description: "conflicting versions",
+			chart:       "oci://ghcr.io/org/chart:1.2.3",
+			version:     "2.0.0",
+			shouldErr:   true,
+		},
+		{
+			description:     "registry login",
+			chart:           "oci://us-docker.pkg.dev/project/charts/chart",
+			commands:        testutil.CmdRunInput("helm registry login us-docker.pkg.dev --username oauth2accesstoken --password-stdin", "token"),
+			expectedChart:   "oci://us-docker.pkg.dev/project/charts/chart",
+			expectedVersion: "",
+		},
+		{
+			description: "registry login failure",
+			chart:       "oci://us-docker.pkg.dev/project/charts/chart",
+			commands:    testutil.CmdRunErr("helm registry login us-docker.pkg.dev --username oauth2accesstoken --password-stdin", errors.New("denied")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.DefaultAuthHelper, stubAuth{auths: auths, err: test.authErr})
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			chart, version, err := ResolveOCIChart(context.Background(), mockClient{}, test.chart, test.version)
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedChart, chart)
+				t.CheckDeepEqual(test.expectedVersion, version)
+			}
+		})
+	}
+}
@@ -46,6 +46,7 @@ func NewBuildEnvOpts(a *latest.Artifact, image string, pushImage bool) (BuildEnv
 	}
 	return BuildEnvOpts{
 		Image:        image,
+		ImageName:    a.ImageName,
 		PushImage:    pushImage,
 		ImageRepo:    ref.Repo,
 		ImageTag:     ref.Tag,
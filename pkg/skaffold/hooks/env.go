@@ -37,6 +37,7 @@ type StaticEnvOpts struct {
 // BuildEnvOpts contains the environment variables to be set in a build type lifecycle hook executor.
 type BuildEnvOpts struct {
 	Image        string
+	ImageName    string
 	PushImage    bool
 	ImageRepo    string
 	ImageTag     string
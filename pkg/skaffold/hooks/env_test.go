@@ -83,6 +83,7 @@ func TestGetEnv(t *testing.T) {
 			description: "build env opts",
 			input: BuildEnvOpts{
 				Image:        "foo",
+				ImageName:    "img1",
 				PushImage:    true,
 				ImageRepo:    "gcr.io/foo",
 				ImageTag:     "latest",
@@ -90,6 +91,7 @@ func TestGetEnv(t *testing.T) {
 			},
 			expected: []string{
 				"SKAFFOLD_IMAGE=foo",
+				"SKAFFOLD_IMAGE_NAME=img1",
 				"SKAFFOLD_PUSH_IMAGE=true",
 				"SKAFFOLD_IMAGE_REPO=gcr.io/foo",
 				"SKAFFOLD_IMAGE_TAG=latest",
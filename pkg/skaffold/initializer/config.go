@@ -47,8 +47,12 @@ func generateSkaffoldConfig(b build.Initializer, r render.Initializer, d deploy.
 	}
 
 	renderConfig, profiles := r.RenderConfig()
-	deployConfig := d.DeployConfig()
 	buildConfig, portForward := b.BuildConfig()
+	var images []string
+	for _, a := range buildConfig.Artifacts {
+		images = append(images, a.ImageName)
+	}
+	deployConfig := d.DeployConfig(images)
 
 	return &latest.SkaffoldConfig{
 		APIVersion: latest.Version,
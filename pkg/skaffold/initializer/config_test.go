@@ -32,7 +32,7 @@ type stubDeploymentInitializer struct {
 	config latest.DeployConfig
 }
 
-func (s stubDeploymentInitializer) DeployConfig() latest.DeployConfig {
+func (s stubDeploymentInitializer) DeployConfig([]string) latest.DeployConfig {
 	return s.config
 }
 
@@ -18,6 +18,7 @@ package deploy
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/initializer/analyze"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	pkgutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/yaml"
 )
 
@@ -68,8 +70,9 @@ func newHelmInitializer(chartValuesMap map[string][]string) helm {
 }
 
 // DeployConfig implements the Initializer interface and generates
-// a helm configuration
-func (h helm) DeployConfig() latest.DeployConfig {
+// a helm configuration. Chart values referencing one of the built images
+// are set to that image with `setValueTemplates`.
+func (h helm) DeployConfig(images []string) latest.DeployConfig {
 	releases := []latest.HelmRelease{}
 	for _, ch := range h.charts {
 		// to make skaffold.yaml more portable across OS-es we should always generate /-delimited filePaths
@@ -85,6 +88,9 @@ func (h helm) DeployConfig() latest.DeployConfig {
 			Version:     ch.version,
 			ValuesFiles: rVfs,
 		}
+		if templates := imageValueTemplates(ch.valueFiles, images); len(templates) > 0 {
+			r.SetValueTemplates = templates
+		}
 		releases = append(releases, r)
 	}
 	return latest.DeployConfig{
@@ -134,3 +140,65 @@ func getVersion(m map[string]interface{}) string {
 	}
 	return ""
 }
+
+// imageValueTemplates finds the values that reference one of the images in the chart's values files,
+// and maps them to the templates of the built image.
+// A value can either be the image itself, like `image: my-app`, or an object with a
+// `repository`, like `image: {repository: my-app, tag: v1}`.
+func imageValueTemplates(valueFiles []string, images []string) map[string]string {
+	templates := map[string]string{}
+	for _, vf := range valueFiles {
+		in, err := readFile(vf)
+		if err != nil {
+			log.Entry(context.TODO()).Debugf("could not read values file %s: %s", vf, err)
+			continue
+		}
+		values := map[string]interface{}{}
+		if err := yaml.Unmarshal(in, &values); err != nil {
+			log.Entry(context.TODO()).Debugf("could not parse values file %s: %s", vf, err)
+			continue
+		}
+		findImageValues(values, "", images, templates)
+	}
+	return templates
+}
+
+func findImageValues(values map[string]interface{}, prefix string, images []string, templates map[string]string) {
+	for k, v := range values {
+		key := prefix + k
+		switch v := v.(type) {
+		case string:
+			if image := matchImage(v, images); image != "" {
+				templates[key] = fmt.Sprintf("{{.IMAGE_FULLY_QUALIFIED_%s}}", pkgutil.SanitizeHelmTemplateValue(image))
+			}
+		case map[string]interface{}:
+			if repo, ok := v["repository"].(string); ok {
+				if image := matchImage(repo, images); image != "" {
+					templates[key+".repository"] = fmt.Sprintf("{{.IMAGE_REPO_%s}}", pkgutil.SanitizeHelmTemplateValue(image))
+					if _, found := v["tag"]; found {
+						templates[key+".tag"] = fmt.Sprintf("{{.IMAGE_TAG_%[1]s}}@{{.IMAGE_DIGEST_%[1]s}}", pkgutil.SanitizeHelmTemplateValue(image))
+					}
+					continue
+				}
+			}
+			findImageValues(v, key+".", images, templates)
+		}
+	}
+}
+
+// matchImage returns the image that the value references, ignoring any tag or digest.
+func matchImage(value string, images []string) string {
+	name := value
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	for _, image := range images {
+		if name == image {
+			return image
+		}
+	}
+	return ""
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
 
@@ -73,8 +74,58 @@ func TestDeployConfig(t *testing.T) {
 				return []byte{}, nil
 			})
 			h := newHelmInitializer(test.input)
-			d := h.DeployConfig()
+			d := h.DeployConfig(nil)
 			CheckHelmInitStruct(t, test.expected, d.LegacyHelmDeploy.Releases)
 		})
 	}
 }
+
+func TestDeployConfigImageValues(t *testing.T) {
+	tests := []struct {
+		description string
+		values      string
+		images      []string
+		expected    map[string]string
+	}{
+		{
+			description: "image value",
+			values:      "image: my-app\nreplicaCount: 2\n",
+			images:      []string{"my-app"},
+			expected:    map[string]string{"image": "{{.IMAGE_FULLY_QUALIFIED_my_app}}"},
+		},
+		{
+			description: "tagged image value",
+			values:      "frontend:\n  image: gcr.io/project/frontend:v1\n",
+			images:      []string{"gcr.io/project/frontend"},
+			expected:    map[string]string{"frontend.image": "{{.IMAGE_FULLY_QUALIFIED_gcr_io_project_frontend}}"},
+		},
+		{
+			description: "repository and tag values",
+			values:      "image:\n  repository: my-app\n  tag: latest\n  pullPolicy: IfNotPresent\n",
+			images:      []string{"my-app"},
+			expected: map[string]string{
+				"image.repository": "{{.IMAGE_REPO_my_app}}",
+				"image.tag":        "{{.IMAGE_TAG_my_app}}@{{.IMAGE_DIGEST_my_app}}",
+			},
+		},
+		{
+			description: "image not built",
+			values:      "image: nginx\n",
+			images:      []string{"my-app"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&readFile, func(fp string) ([]byte, error) {
+				if fp == "charts/values.yaml" {
+					return []byte(test.values), nil
+				}
+				return []byte{}, nil
+			})
+			h := newHelmInitializer(map[string][]string{"charts": {"charts/values.yaml"}})
+			d := h.DeployConfig(test.images)
+			t.CheckDeepEqual(1, len(d.LegacyHelmDeploy.Releases))
+			t.CheckDeepEqual(util.FlatMap(test.expected), d.LegacyHelmDeploy.Releases[0].SetValueTemplates)
+		})
+	}
+}
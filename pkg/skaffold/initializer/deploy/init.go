@@ -25,13 +25,14 @@ import (
 // Initializer detects a deployment type and is able to extract image names from it
 type Initializer interface {
 	// DeployConfig generates Deploy Config for skaffold configuration.
-	DeployConfig() latest.DeployConfig
+	// images are the names of the artifacts built by the configuration.
+	DeployConfig(images []string) latest.DeployConfig
 }
 
 type emptyDeployInit struct {
 }
 
-func (e *emptyDeployInit) DeployConfig() latest.DeployConfig {
+func (e *emptyDeployInit) DeployConfig([]string) latest.DeployConfig {
 	return latest.DeployConfig{}
 }
 
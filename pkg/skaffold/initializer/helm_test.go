@@ -110,7 +110,7 @@ spec:
 			err := a.Analyze(".")
 			t.CheckError(test.shouldErr, err)
 			d := deploy.NewInitializer(a.HelmChartInfo(), config)
-			dc := d.DeployConfig()
+			dc := d.DeployConfig(nil)
 			deploy.CheckHelmInitStruct(t, test.expected, dc.LegacyHelmDeploy.Releases)
 		})
 	}
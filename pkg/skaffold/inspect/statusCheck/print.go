@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/inspect"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+type statusCheckList struct {
+	Configs []statusCheckConfig `json:"configs"`
+}
+
+type statusCheckConfig struct {
+	Name                          string             `json:"name"`
+	Enabled                       bool               `json:"enabled"`
+	DeadlineSeconds               int                `json:"deadlineSeconds"`
+	PollInterval                  string             `json:"pollInterval"`
+	InitialDelaySeconds           int                `json:"initialDelaySeconds"`
+	StabilizationSeconds          int                `json:"stabilizationSeconds"`
+	TolerateFailuresUntilDeadline bool               `json:"tolerateFailuresUntilDeadline"`
+	Exclude                       []excludedResource `json:"exclude"`
+	RetryableErrors               []string           `json:"retryableErrors"`
+	FailOn                        []string           `json:"failOn"`
+	ReadyPercent                  int                `json:"readyPercent"`
+	SkipPaused                    bool               `json:"skipPaused"`
+	Webhook                       string             `json:"webhook"`
+	Suggestions                   []suggestion       `json:"suggestions"`
+	Readiness                     []readinessRule    `json:"readiness"`
+	LogPrefix                     string             `json:"statusCheckLogPrefix"`
+}
+
+type excludedResource struct {
+	Name   string `json:"name,omitempty"`
+	Labels string `json:"labels,omitempty"`
+}
+
+type suggestion struct {
+	Code   string `json:"code"`
+	Action string `json:"action,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+type readinessRule struct {
+	Kind     string `json:"kind"`
+	Group    string `json:"group,omitempty"`
+	JSONPath string `json:"jsonPath"`
+	Value    string `json:"value"`
+}
+
+// PrintStatusCheckConfig prints the status check configuration of each of the selected modules, with the profiles
+// activated and the defaults of a deploy applied.
+func PrintStatusCheckConfig(ctx context.Context, out io.Writer, opts inspect.Options) error {
+	formatter := inspect.OutputFormatter(out, opts.OutFormat)
+	cfgs, err := inspect.GetConfigSet(ctx, config.SkaffoldOptions{
+		ConfigurationFile:   opts.Filename,
+		ConfigurationFilter: opts.Modules,
+		RemoteCacheDir:      opts.RemoteCacheDir,
+		Profiles:            opts.Profiles,
+		PropagateProfiles:   opts.PropagateProfiles,
+	})
+	if err != nil {
+		formatter.WriteErr(err)
+		return err
+	}
+
+	l := &statusCheckList{Configs: []statusCheckConfig{}}
+	for i, c := range cfgs {
+		// unnamed configs are keyed by their index.
+		name := c.Metadata.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		l.Configs = append(l.Configs, resolveStatusCheckConfig(name, c.Pipeline, opts.StatusCheckOptions))
+	}
+	return formatter.Write(l)
+}
+
+// resolveStatusCheckConfig resolves the status check configuration of a single config the same way as a deploy.
+func resolveStatusCheckConfig(name string, p latest.Pipeline, opts inspect.StatusCheckOptions) statusCheckConfig {
+	ps := runcontext.NewPipelines(map[string]latest.Pipeline{name: p}, []string{name})
+
+	// the `--status-check` flag overrides the setting of the config, which is enabled by default.
+	enabled := true
+	if p.Deploy.StatusCheck != nil {
+		enabled = *p.Deploy.StatusCheck
+	}
+	if v := opts.StatusCheck.Value(); v != nil {
+		enabled = *v
+	}
+	deadline := ps.StatusCheckDeadlineSeconds()
+	if deadline == 0 {
+		deadline = int(status.DefaultStatusCheckDeadline.Seconds())
+	}
+	readyPercent := ps.StatusCheckReadyPercent()
+	if readyPercent == 0 {
+		readyPercent = 100
+	}
+
+	c := statusCheckConfig{
+		Name:                          name,
+		Enabled:                       enabled,
+		DeadlineSeconds:               deadline,
+		PollInterval:                  opts.PollInterval.String(),
+		InitialDelaySeconds:           ps.StatusCheckInitialDelaySeconds(),
+		StabilizationSeconds:          ps.StatusCheckStabilizationSeconds(),
+		TolerateFailuresUntilDeadline: ps.StatusCheckTolerateFailures(),
+		Exclude:                       []excludedResource{},
+		RetryableErrors:               append([]string{}, ps.StatusCheckRetryableErrors()...),
+		FailOn:                        append([]string{}, ps.StatusCheckFailOn()...),
+		ReadyPercent:                  readyPercent,
+		SkipPaused:                    ps.StatusCheckSkipPaused(),
+		Webhook:                       ps.StatusCheckWebhook(),
+		Suggestions:                   []suggestion{},
+		Readiness:                     []readinessRule{},
+		LogPrefix:                     ps.StatusCheckLogPrefix(),
+	}
+	for _, e := range ps.StatusCheckExclude() {
+		c.Exclude = append(c.Exclude, excludedResource{Name: e.Name, Labels: e.Labels})
+	}
+	for _, sg := range ps.StatusCheckSuggestions() {
+		c.Suggestions = append(c.Suggestions, suggestion{Code: sg.Code, Action: sg.Action, URL: sg.URL})
+	}
+	for _, r := range ps.StatusCheckReadiness() {
+		c.Readiness = append(c.Readiness, readinessRule{Kind: r.Kind, Group: r.Group, JSONPath: r.JSONPath, Value: r.Value})
+	}
+	return c
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/inspect"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/parser"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/stringslice"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestPrintStatusCheckConfig(t *testing.T) {
+	withoutStatusCheck := `{"name":"cfg-without-status-check","enabled":true,"deadlineSeconds":600,"pollInterval":"1s","initialDelaySeconds":0,"stabilizationSeconds":0,"tolerateFailuresUntilDeadline":false,"exclude":[],"retryableErrors":[],"failOn":[],"readyPercent":100,"skipPaused":false,"webhook":"","suggestions":[],"readiness":[],"statusCheckLogPrefix":""}`
+	withoutStatusCheckCI := `{"name":"cfg-without-status-check","enabled":false,"deadlineSeconds":120,"pollInterval":"1s","initialDelaySeconds":0,"stabilizationSeconds":0,"tolerateFailuresUntilDeadline":false,"exclude":[{"labels":"app=migration"}],"retryableErrors":[],"failOn":[],"readyPercent":100,"skipPaused":false,"webhook":"","suggestions":[],"readiness":[],"statusCheckLogPrefix":""}`
+	withStatusCheck := `{"name":"cfg-with-status-check","enabled":true,"deadlineSeconds":300,"pollInterval":"1s","initialDelaySeconds":10,"stabilizationSeconds":0,"tolerateFailuresUntilDeadline":true,"exclude":[{"name":"db-migration-*"}],` +
+		`"retryableErrors":["connection refused"],"failOn":["STATUSCHECK_IMAGE_PULL_ERR"],"readyPercent":80,"skipPaused":true,"webhook":"https://example.com/hook",` +
+		`"suggestions":[{"code":"STATUSCHECK_IMAGE_PULL_ERR","action":"check the registry credentials"}],"readiness":[{"kind":"Certificate","group":"cert-manager.io","jsonPath":"{.status.conditions[?(@.type=='Ready')].status}","value":"True"}],"statusCheckLogPrefix":"{{.Pod}}"}`
+
+	tests := []struct {
+		description string
+		profiles    []string
+		module      []string
+		statusCheck config.BoolOrUndefined
+		err         error
+		expected    string
+	}{
+		{
+			description: "defaults",
+			module:      []string{"cfg-without-status-check"},
+			expected:    `{"configs":[` + withoutStatusCheck + `]}` + "\n",
+		},
+		{
+			description: "each module",
+			expected:    `{"configs":[` + withoutStatusCheck + `,` + withStatusCheck + `]}` + "\n",
+		},
+		{
+			description: "profile",
+			profiles:    []string{"ci"},
+			expected:    `{"configs":[` + withoutStatusCheckCI + `,` + withStatusCheck + `]}` + "\n",
+		},
+		{
+			description: "flag overrides the config",
+			module:      []string{"cfg-without-status-check"},
+			profiles:    []string{"ci"},
+			statusCheck: config.NewBoolOrUndefined(util.Ptr(true)),
+			expected:    `{"configs":[` + strings.Replace(withoutStatusCheckCI, `"enabled":false`, `"enabled":true`, 1) + `]}` + "\n",
+		},
+		{
+			description: "generic error",
+			err:         errors.New("some error occurred"),
+			expected:    `{"errorCode":"INSPECT_UNKNOWN_ERR","errorMessage":"some error occurred"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			configSet := parser.SkaffoldConfigSet{
+				&parser.SkaffoldConfigEntry{SkaffoldConfig: &latest.SkaffoldConfig{
+					Metadata: latest.Metadata{Name: "cfg-without-status-check"},
+					Profiles: []latest.Profile{
+						{Name: "ci",
+							Pipeline: latest.Pipeline{
+								Deploy: latest.DeployConfig{
+									StatusCheck:                util.Ptr(false),
+									StatusCheckDeadlineSeconds: 120,
+									StatusCheckExclude:         []latest.StatusCheckExclude{{Labels: "app=migration"}},
+								},
+							},
+						}},
+				}, SourceFile: "path/to/cfg-without-status-check"},
+
+				&parser.SkaffoldConfigEntry{SkaffoldConfig: &latest.SkaffoldConfig{
+					Metadata: latest.Metadata{Name: "cfg-with-status-check"},
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckDeadlineSeconds:    300,
+							InitialDelaySeconds:           10,
+							TolerateFailuresUntilDeadline: true,
+							StatusCheckExclude:            []latest.StatusCheckExclude{{Name: "db-migration-*"}},
+							StatusCheckRetryableErrors:    []string{"connection refused"},
+							StatusCheckFailOn:             []string{"STATUSCHECK_IMAGE_PULL_ERR"},
+							StatusCheckReadyPercent:       80,
+							StatusCheckSkipPaused:         true,
+							StatusCheckWebhook:            "https://example.com/hook",
+							StatusCheckSuggestions:        []latest.StatusCheckSuggestion{{Code: "STATUSCHECK_IMAGE_PULL_ERR", Action: "check the registry credentials"}},
+							StatusCheckReadiness:          []latest.StatusCheckReadiness{{Kind: "Certificate", Group: "cert-manager.io", JSONPath: "{.status.conditions[?(@.type=='Ready')].status}", Value: "True"}},
+							StatusCheckLogPrefix:          "{{.Pod}}",
+						},
+					},
+				}, SourceFile: "path/to/cfg-with-status-check"},
+			}
+			t.Override(&inspect.GetConfigSet, func(_ context.Context, opts config.SkaffoldOptions) (parser.SkaffoldConfigSet, error) {
+				// mock profile activation
+				var set parser.SkaffoldConfigSet
+				for _, c := range configSet {
+					if len(opts.ConfigurationFilter) > 0 && !stringslice.Contains(opts.ConfigurationFilter, c.Metadata.Name) {
+						continue
+					}
+					for _, pName := range opts.Profiles {
+						for _, profile := range c.Profiles {
+							if profile.Name == pName {
+								c.Deploy = profile.Deploy
+							}
+						}
+					}
+					set = append(set, c)
+				}
+				return set, test.err
+			})
+			var buf bytes.Buffer
+			err := PrintStatusCheckConfig(context.Background(), &buf, inspect.Options{
+				OutFormat: "json", Modules: test.module, Profiles: test.profiles,
+				StatusCheckOptions: inspect.StatusCheckOptions{StatusCheck: test.statusCheck, PollInterval: time.Second},
+			})
+			t.CheckError(test.err != nil, err)
+			t.CheckDeepEqual(test.expected, buf.String())
+		})
+	}
+}
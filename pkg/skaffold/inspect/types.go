@@ -17,6 +17,9 @@ limitations under the License.
 package inspect
 
 import (
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/parser"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 )
@@ -43,6 +46,7 @@ type Options struct {
 	ModulesOptions
 	ProfilesOptions
 	BuildEnvOptions
+	StatusCheckOptions
 }
 
 // ModulesOptions holds flag values for various `skaffold inspect modules` commands
@@ -57,6 +61,14 @@ type ProfilesOptions struct {
 	BuildEnv BuildEnv
 }
 
+// StatusCheckOptions holds flag values for the `skaffold inspect status-check` command
+type StatusCheckOptions struct {
+	// StatusCheck is the value of the `--status-check` flag, which overrides the status check setting of the configs.
+	StatusCheck config.BoolOrUndefined
+	// PollInterval is the interval between two status check polls of a resource.
+	PollInterval time.Duration
+}
+
 // BuildEnvOptions holds flag values for various `skaffold inspect build-env` commands
 type BuildEnvOptions struct {
 	// Push specifies if images should be pushed to a registry.
@@ -115,6 +115,18 @@ func AddAttributesToCurrentSpanFromContext(ctx context.Context, attrs map[string
 	}
 }
 
+// AddEventToCurrentSpanFromContext adds an event, with the attributes from the input map, to the span pulled from the current context.
+// This is useful to record errors that don't end the span, like the failure of a resource status check.
+func AddEventToCurrentSpanFromContext(ctx context.Context, name string, attrs map[string]string) {
+	if traceEnabled {
+		var kvs []attribute.KeyValue
+		for k, v := range attrs {
+			kvs = append(kvs, attribute.Key(k).String(v))
+		}
+		trace.SpanFromContext(ctx).AddEvent(name, trace.WithAttributes(kvs...))
+	}
+}
+
 // PII stub function tracking trace attributes that have PII in them.  Currently no trace information is uploaded so
 // PII values are not an issue but if in the future they are uploaded this will need to properly strip PII
 func PII(s string) string {
@@ -136,6 +136,38 @@ func TestInitCloudTrace(t *testing.T) {
 	}
 }
 
+func TestAddEventToCurrentSpanFromContext(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Setenv("SKAFFOLD_TRACE", "stdout")
+		var b bytes.Buffer
+		ctx := context.Background()
+		_, _, err := InitTraceFromEnvVar(WithWriter(&b))
+		t.CheckNoError(err)
+
+		ctx, endTrace := StartTrace(ctx, "StatusCheck")
+		AddEventToCurrentSpanFromContext(ctx, "error", map[string]string{"StatusCode": "STATUSCHECK_IMAGE_PULL_ERR"})
+		endTrace()
+		t.CheckNoError(TracerShutdown(ctx))
+
+		var span struct {
+			Name   string `json:"Name"`
+			Events []struct {
+				Name       string `json:"Name"`
+				Attributes []struct {
+					Key   string `json:"Key"`
+					Value Value  `json:"Value"`
+				} `json:"Attributes"`
+			} `json:"Events"`
+		}
+		t.CheckNoError(json.Unmarshal(b.Bytes(), &span))
+		t.CheckDeepEqual("StatusCheck", span.Name)
+		t.CheckDeepEqual(1, len(span.Events))
+		t.CheckDeepEqual("error", span.Events[0].Name)
+		t.CheckDeepEqual("StatusCode", span.Events[0].Attributes[0].Key)
+		t.CheckDeepEqual("STATUSCHECK_IMAGE_PULL_ERR", span.Events[0].Attributes[0].Value.Value)
+	})
+}
+
 type SpanArray []Span
 
 type Span struct {
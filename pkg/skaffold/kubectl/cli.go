@@ -30,6 +30,8 @@ type CLI struct {
 	KubeContext string
 	KubeConfig  string
 	Namespace   string
+	// Binary is the path of the kubectl binary, or `kubectl` from the PATH if empty.
+	Binary string
 
 	version     ClientVersion
 	versionOnce sync.Once
@@ -59,19 +61,19 @@ func NewCLI(cfg Config, defaultNamespace string) *CLI {
 // Command creates the underlying exec.CommandContext. This allows low-level control of the executed command.
 func (c *CLI) Command(ctx context.Context, command string, arg ...string) *exec.Cmd {
 	args := c.args(command, util.Ptr(""), arg...)
-	return exec.CommandContext(ctx, "kubectl", args...)
+	return exec.CommandContext(ctx, c.binary(), args...)
 }
 
 // Command creates the underlying exec.CommandContext with namespace. This allows low-level control of the executed command.
 func (c *CLI) CommandWithNamespaceArg(ctx context.Context, command string, namespace string, arg ...string) *exec.Cmd {
 	args := c.args(command, util.Ptr(namespace), arg...)
-	return exec.CommandContext(ctx, "kubectl", args...)
+	return exec.CommandContext(ctx, c.binary(), args...)
 }
 
 // Command creates the underlying exec.CommandContext without a namespace. This allows low-level control of the executed command.
 func (c *CLI) CommandWithoutNamespaceArg(ctx context.Context, command string, arg ...string) *exec.Cmd {
 	args := c.args(command, nil, arg...)
-	return exec.CommandContext(ctx, "kubectl", args...)
+	return exec.CommandContext(ctx, c.binary(), args...)
 }
 
 // Run shells out kubectl CLI.
@@ -114,7 +116,7 @@ func (c *CLI) RunOutInput(ctx context.Context, in io.Reader, command string, arg
 // CommandWithStrictCancellation ensures for windows OS that all child process get terminated on cancellation
 func (c *CLI) CommandWithStrictCancellation(ctx context.Context, command string, arg ...string) *Cmd {
 	args := c.args(command, util.Ptr(""), arg...)
-	return CommandContext(ctx, "kubectl", args...)
+	return CommandContext(ctx, c.binary(), args...)
 }
 
 // Kustomize runs `kubectl kustomize` with the provided args
@@ -122,6 +124,14 @@ func (c *CLI) Kustomize(ctx context.Context, args []string) ([]byte, error) {
 	return c.RunOut(ctx, "kustomize", args...)
 }
 
+// binary returns the kubectl binary to run.
+func (c *CLI) binary() string {
+	if c.Binary != "" {
+		return c.Binary
+	}
+	return "kubectl"
+}
+
 // args builds an argument list for calling kubectl and consistently
 // adds the `--context` and `--namespace` flags.
 func (c *CLI) args(command string, namespace *string, arg ...string) []string {
@@ -34,6 +34,7 @@ func TestCLI(t *testing.T) {
 		name            string
 		kubeconfig      string
 		namespace       string
+		binary          string
 		expectedCommand string
 	}{
 		{
@@ -56,6 +57,11 @@ func TestCLI(t *testing.T) {
 			namespace:       "some-namespace",
 			expectedCommand: "kubectl --context some-kubecontext --namespace some-namespace --kubeconfig some-kubeconfig exec arg1 arg2",
 		},
+		{
+			name:            "with kubectl binary",
+			binary:          "/opt/bin/kubectl",
+			expectedCommand: "/opt/bin/kubectl --context some-kubecontext exec arg1 arg2",
+		},
 	}
 
 	// test cli.Run()
@@ -70,6 +76,7 @@ func TestCLI(t *testing.T) {
 				kubeConfig:  test.kubeconfig,
 				namespace:   test.namespace,
 			}, "")
+			cli.Binary = test.binary
 			err := cli.Run(context.Background(), nil, nil, "exec", "arg1", "arg2")
 
 			t.CheckNoError(err)
@@ -89,6 +96,7 @@ func TestCLI(t *testing.T) {
 				kubeConfig:  test.kubeconfig,
 				namespace:   test.namespace,
 			}, "")
+			cli.Binary = test.binary
 			out, err := cli.RunOut(context.Background(), "exec", "arg1", "arg2")
 
 			t.CheckNoError(err)
@@ -109,6 +117,7 @@ func TestCLI(t *testing.T) {
 				kubeConfig:  test.kubeconfig,
 				namespace:   test.namespace,
 			}, "")
+			cli.Binary = test.binary
 			cmd := cli.CommandWithStrictCancellation(context.Background(), "exec", "arg1", "arg2")
 			out, err := util.RunCmdOut(context.Background(), cmd.Cmd)
 
@@ -22,6 +22,8 @@ package kubectl
 import (
 	"context"
 	"os/exec"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 )
 
 // Cmd is a wrapper on exec.Cmd
@@ -38,3 +40,8 @@ func CommandContext(ctx context.Context, name string, arg ...string) *Cmd {
 func (c *Cmd) Terminate() error {
 	return c.Process.Kill()
 }
+
+// RunOut runs the command and returns its output. The process is killed if the context is cancelled.
+func (c *Cmd) RunOut(ctx context.Context) ([]byte, error) {
+	return util.RunCmdOut(ctx, c.Cmd)
+}
@@ -17,14 +17,18 @@ limitations under the License.
 package kubectl
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"reflect"
+	"sync"
 	"unsafe"
 
 	"golang.org/x/sys/windows"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 )
 
 // Cmd represents an external command being prepared to run within a job object
@@ -32,6 +36,9 @@ type Cmd struct {
 	*exec.Cmd
 	handle windows.Handle
 	ctx    context.Context
+	// done is closed once the command completes, to stop watching the context.
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
 // CommandContext creates a new Cmd
@@ -57,31 +64,49 @@ func (c *Cmd) Start() error {
 		windows.JobObjectExtendedLimitInformation,
 		uintptr(unsafe.Pointer(&info)),
 		uint32(unsafe.Sizeof(info))); err != nil {
+		windows.CloseHandle(handle)
 		return fmt.Errorf("could not set information job object: %w", err)
 	}
 
 	if err := c.Cmd.Start(); err != nil {
+		windows.CloseHandle(handle)
 		return fmt.Errorf("could not start the command: %w", err)
 	}
+	c.handle = handle
+	c.done = make(chan struct{})
 
 	processHandle, err := getHandleFromProcess(c.Process)
 	if err != nil {
+		c.Terminate()
 		return fmt.Errorf("could not get handle from process: %w", err)
 	}
 
 	if err := windows.AssignProcessToJobObject(handle, processHandle); err != nil {
+		c.Terminate()
 		return fmt.Errorf("could not assign job object: %w", err)
 	}
 
-	c.handle = handle
 	go func() {
-		<-c.ctx.Done()
-		c.Terminate()
+		select {
+		case <-c.ctx.Done():
+			c.Terminate()
+		case <-c.done:
+		}
 	}()
 
 	return nil
 }
 
+// Wait waits for the command to exit, and closes its job object.
+func (c *Cmd) Wait() error {
+	err := c.Cmd.Wait()
+	if c.done != nil {
+		close(c.done)
+		c.Terminate()
+	}
+	return err
+}
+
 func getHandleFromProcess(p *os.Process) (windows.Handle, error) {
 	// os.Process contains an unexported processHandle struct, which contains
 	// a `handle uintptr` field.
@@ -112,7 +137,27 @@ func (c *Cmd) Run() error {
 	return c.Wait()
 }
 
-// Terminate closes the job object handle which kills all connected processes
+// RunOut runs the command in a job object and returns its output, so that cancelling the context also kills its child processes.
+// Commands still go through util.DefaultExecCommand when it's replaced, as in tests.
+func (c *Cmd) RunOut(ctx context.Context) ([]byte, error) {
+	if _, ok := util.DefaultExecCommand.(*util.Commander); !ok {
+		return util.RunCmdOut(ctx, c.Cmd)
+	}
+	var stdout, stderr bytes.Buffer
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		return stdout.Bytes(), fmt.Errorf("running %s\n - stdout: %q\n - stderr: %q\n - cause: %w", c.Args, stdout.Bytes(), stderr.Bytes(), err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// Terminate closes the job object handle which kills all connected processes.
+// The handle is only closed once.
 func (c *Cmd) Terminate() error {
-	return windows.CloseHandle(c.handle)
+	var err error
+	c.closeOnce.Do(func() {
+		err = windows.CloseHandle(c.handle)
+	})
+	return err
 }
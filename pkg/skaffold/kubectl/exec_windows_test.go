@@ -42,3 +42,21 @@ func TestGetHandleFromProcess(t *testing.T) {
 	err = c.Cmd.Wait()
 	assert.Nil(t, err, "could not wait command")
 }
+
+func TestWaitClosesJobObject(t *testing.T) {
+	if runtime.GOOS != "windows" {
+		t.Skip("job objects only exist on Windows")
+	}
+
+	c := CommandContext(context.TODO(), "cmd", "/c", "exit 0")
+	err := c.Run()
+	assert.Nil(t, err, "could not run command")
+
+	select {
+	case <-c.done:
+	default:
+		t.Error("the context watcher was not stopped")
+	}
+	// the job object handle was closed by Wait, and isn't closed again.
+	assert.Nil(t, c.Terminate(), "the job object handle was closed twice")
+}
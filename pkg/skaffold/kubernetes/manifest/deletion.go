@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// deletionStep returns the step in which a resource of the given kind is deleted:
+// the resources first, then the custom resource definitions of the custom resources, then the namespaces that contain them.
+func deletionStep(group, kind string) int {
+	switch {
+	case group == "" && kind == "Namespace":
+		return 2
+	case group == "apiextensions.k8s.io" && kind == "CustomResourceDefinition":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// DeletionSteps splits the manifest list into the lists to delete one after the other, so that no resource
+// is deleted before the resources that depend on it. Manifests that can't be parsed are deleted in the first step.
+func (l *ManifestList) DeletionSteps() []ManifestList {
+	if l == nil {
+		return nil
+	}
+	steps := make([]ManifestList, 3)
+	for _, yByte := range *l {
+		step := 0
+		var obj unstructured.Unstructured
+		if jByte, err := k8syaml.YAMLToJSON(yByte); err == nil && obj.UnmarshalJSON(jByte) == nil {
+			gvk := obj.GroupVersionKind()
+			step = deletionStep(gvk.Group, gvk.Kind)
+		}
+		steps[step] = append(steps[step], yByte)
+	}
+
+	var result []ManifestList
+	for _, step := range steps {
+		if len(step) > 0 {
+			result = append(result, step)
+		}
+	}
+	return result
+}
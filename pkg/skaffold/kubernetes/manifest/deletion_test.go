@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestDeletionSteps(t *testing.T) {
+	namespace := `apiVersion: v1
+kind: Namespace
+metadata:
+  name: ns`
+	crd := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: crontabs.stable.example.com`
+	cr := `apiVersion: stable.example.com/v1
+kind: CronTab
+metadata:
+  name: crontab`
+
+	tests := []struct {
+		description string
+		manifests   ManifestList
+		expected    []ManifestList
+	}{
+		{
+			description: "empty",
+		},
+		{
+			description: "single step",
+			manifests:   ManifestList{[]byte(pod1), []byte(service)},
+			expected:    []ManifestList{{[]byte(pod1), []byte(service)}},
+		},
+		{
+			description: "namespaces and custom resource definitions last",
+			manifests:   ManifestList{[]byte(namespace), []byte(crd), []byte(cr), []byte(pod1)},
+			expected:    []ManifestList{{[]byte(cr), []byte(pod1)}, {[]byte(crd)}, {[]byte(namespace)}},
+		},
+		{
+			description: "unparseable manifests first",
+			manifests:   ManifestList{[]byte(namespace), []byte("not: [yaml")},
+			expected:    []ManifestList{{[]byte("not: [yaml")}, {[]byte(namespace)}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, test.manifests.DeletionSteps())
+		})
+	}
+}
@@ -18,6 +18,7 @@ package portforward
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -30,7 +31,7 @@ import (
 )
 
 var (
-	portForwardEvent = func(entry *portForwardEntry) {
+	portForwardEvent = func(entry *portForwardEntry, reconnected bool) {
 		event.PortForwarded(
 			int32(entry.localPort),
 			entry.resource.Port,
@@ -40,9 +41,11 @@ var (
 			entry.portName,
 			string(entry.resource.Type),
 			entry.resource.Name,
-			entry.resource.Address)
+			entry.resource.Address,
+			entry.protocol(),
+			reconnected)
 	}
-	portForwardEventV2 = func(entry *portForwardEntry) {
+	portForwardEventV2 = func(entry *portForwardEntry, reconnected bool) {
 		eventV2.PortForwarded(
 			int32(entry.localPort),
 			entry.resource.Port,
@@ -52,7 +55,9 @@ var (
 			entry.portName,
 			string(entry.resource.Type),
 			entry.resource.Name,
-			entry.resource.Address)
+			entry.resource.Address,
+			entry.protocol(),
+			reconnected)
 	}
 )
 
@@ -77,6 +82,19 @@ func NewEntryManager(entryForwarder EntryForwarder) *EntryManager {
 }
 
 func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, out io.Writer, entry *portForwardEntry) {
+	b.forward(ctx, out, entry, false)
+}
+
+// replaceEntry terminates the port forward of a previous entry and forwards the new entry in its place.
+// The local port stays reserved in between, so that a pod restarting under the same owner keeps its local port.
+// The forward is reported as reconnected when the new entry targets another pod.
+func (b *EntryManager) replaceEntry(ctx context.Context, out io.Writer, prev, entry *portForwardEntry) {
+	b.forwardedResources.Delete(prev.key())
+	b.entryForwarder.Terminate(prev)
+	b.forward(ctx, out, entry, prev.podName != entry.podName)
+}
+
+func (b *EntryManager) forward(ctx context.Context, out io.Writer, entry *portForwardEntry, reconnected bool) {
 	out, ctx = output.WithEventContext(ctx, out, constants.PortForward, fmt.Sprintf("%s/%s", entry.resource.Type, entry.resource.Name))
 
 	// Check if this resource has already been forwarded
@@ -85,20 +103,32 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, out io.Write
 	}
 
 	if err := b.entryForwarder.Forward(ctx, entry); err == nil {
+		scheme := "http"
+		if entry.isUDP() {
+			scheme = "udp"
+		}
+		status := ""
+		if reconnected {
+			status = " reconnected"
+		}
 		output.Green.Fprintln(
 			out,
-			fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> http://%s:%d",
+			fmt.Sprintf("Port forwarding %s/%s in namespace %s%s, remote port %s -> %s://%s:%d",
 				entry.resource.Type,
 				entry.resource.Name,
 				entry.resource.Namespace,
+				status,
 				entry.resource.Port.String(),
+				scheme,
 				entry.resource.Address,
 				entry.localPort))
+	} else if errors.Is(err, errCreateRelayPod) {
+		output.Yellow.Fprintln(out, err)
 	} else {
 		output.Red.Fprintln(out, err)
 	}
-	portForwardEvent(entry)
-	portForwardEventV2(entry)
+	portForwardEvent(entry, reconnected)
+	portForwardEventV2(entry, reconnected)
 }
 
 // Start ensures the underlying entryForwarder is ready to forward.
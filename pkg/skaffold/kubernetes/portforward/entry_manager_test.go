@@ -17,7 +17,9 @@ limitations under the License.
 package portforward
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -25,6 +27,7 @@ import (
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
@@ -59,6 +62,99 @@ func TestStop(t *testing.T) {
 	testutil.CheckDeepEqual(t, 0, fakeForwarder.forwardedPorts.Length())
 }
 
+func TestReplaceEntry(t *testing.T) {
+	tests := []struct {
+		description         string
+		newPodName          string
+		expectedReconnected bool
+	}{
+		{
+			description:         "pod restarted by its owner",
+			newPodName:          "pod-2",
+			expectedReconnected: true,
+		},
+		{
+			description: "new generation of the same pod",
+			newPodName:  "pod-1",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latest.Pipeline{{}})
+			var reconnected []bool
+			t.Override(&portForwardEvent, func(_ *portForwardEntry, r bool) { reconnected = append(reconnected, r) })
+			t.Override(&portForwardEventV2, func(*portForwardEntry, bool) {})
+
+			prev := newPortForwardEntry(1, latest.PortForwardResource{
+				Type:      constants.Pod,
+				Name:      "pod-1",
+				Namespace: "default",
+			}, "pod-1", "container", "http", "owner", 9000, true)
+			entry := newPortForwardEntry(2, latest.PortForwardResource{
+				Type:      constants.Pod,
+				Name:      test.newPodName,
+				Namespace: "default",
+			}, test.newPodName, "container", "http", "owner", 9000, true)
+
+			fakeForwarder := newTestForwarder()
+			em := NewEntryManager(fakeForwarder)
+			em.forwardedPorts.Set(9000)
+			em.forwardPortForwardEntry(context.Background(), io.Discard, prev)
+			em.replaceEntry(context.Background(), io.Discard, prev, entry)
+
+			t.CheckDeepEqual([]bool{false, test.expectedReconnected}, reconnected)
+			t.CheckDeepEqual([]int{9000}, em.forwardedPorts.List())
+			forwarded, _ := em.forwardedResources.Load(entry.key())
+			t.CheckDeepEqual(test.newPodName, forwarded.(*portForwardEntry).podName)
+			t.CheckDeepEqual(1, length(&fakeForwarder.forwardedResources))
+		})
+	}
+}
+
+type failingForwarder struct {
+	testForwarder
+	err error
+}
+
+func (f *failingForwarder) Forward(context.Context, *portForwardEntry) error { return f.err }
+
+func TestForwardFailure(t *testing.T) {
+	tests := []struct {
+		description   string
+		err           error
+		expectedColor string
+	}{
+		{
+			description:   "port forward failure",
+			err:           errors.New("port forward failed"),
+			expectedColor: "\033[31m",
+		},
+		{
+			description:   "relay pod can't be created",
+			err:           fmt.Errorf("%w for pod-dns-default-53: %w", errCreateRelayPod, errors.New("forbidden: violates PodSecurity")),
+			expectedColor: "\033[33m",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latest.Pipeline{{}})
+			entry := newPortForwardEntry(0, latest.PortForwardResource{
+				Type:      constants.Pod,
+				Name:      "dns",
+				Namespace: "default",
+				Protocol:  "UDP",
+			}, "", "", "", "", 5353, false)
+
+			defer output.SetupColors(context.Background(), nil, output.DefaultColorCode, false)
+			var out bytes.Buffer
+			em := NewEntryManager(&failingForwarder{err: test.err})
+			em.forwardPortForwardEntry(context.Background(), output.SetupColors(context.Background(), &out, 0, true), entry)
+
+			t.CheckContains(test.expectedColor+test.err.Error(), out.String())
+		})
+	}
+}
+
 // length returns the number of elements in a sync.Map
 func length(m *sync.Map) int {
 	n := 0
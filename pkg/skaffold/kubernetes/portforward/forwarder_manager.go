@@ -39,6 +39,7 @@ type Config interface {
 	kubectl.Config
 
 	Mode() config.RunMode
+	GlobalConfig() string
 	PortForwardResources() []*latest.PortForwardResource
 	PortForwardOptions() config.PortForwardOptions
 }
@@ -62,12 +63,15 @@ type ForwarderManager struct {
 
 // NewForwarderManager returns a new port manager which handles starting and stopping port forwarding
 func NewForwarderManager(cli *kubectl.CLI, podSelector kubernetes.PodSelector, label string, runMode config.RunMode, namespaces *[]string,
-	options config.PortForwardOptions, userDefined []*latest.PortForwardResource) *ForwarderManager {
+	options config.PortForwardOptions, userDefined []*latest.PortForwardResource, udpRelayImage string) *ForwarderManager {
 	if !options.Enabled() {
 		return nil
 	}
 
-	entryManager := NewEntryManager(NewKubectlForwarder(cli))
+	kubectlForwarder := NewKubectlForwarder(cli)
+	kubectlForwarder.udpRelayImage = udpRelayImage
+	kubectlForwarder.udpRelayLabels = udpRelayLabels(label)
+	entryManager := NewEntryManager(kubectlForwarder)
 
 	// The order matters to ensure user-defined port-forwards with local-ports are processed first.
 	var forwarders []Forwarder
@@ -63,7 +63,8 @@ func TestNewForwarderManager(t *testing.T) {
 				"",
 				nil,
 				options,
-				nil)
+				nil,
+				"")
 
 			if fm != nil {
 				t.CheckDeepEqual(test.expectedForwarders, len(fm.forwarders))
@@ -129,7 +130,7 @@ func TestAddForwarder(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			options := config.PortForwardOptions{}
 			options.Set(test.fmOptions)
-			fm := NewForwarderManager(&kubectl.CLI{}, &kubernetes.ImageList{}, "", "", nil, options, nil)
+			fm := NewForwarderManager(&kubectl.CLI{}, &kubernetes.ImageList{}, "", "", nil, options, nil, "")
 
 			if fm != nil {
 				t.CheckDeepEqual(test.expectedForwarders[0], len(fm.forwarders))
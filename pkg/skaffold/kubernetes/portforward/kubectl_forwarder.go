@@ -51,6 +51,10 @@ type KubectlForwarder struct {
 	started int32
 	out     io.Writer
 	kubectl *kubectl.CLI
+
+	// udpRelayImage and udpRelayLabels are the image and labels of the relay pods of UDP port forwards.
+	udpRelayImage  string
+	udpRelayLabels map[string]string
 }
 
 // NewKubectlForwarder returns a new KubectlForwarder
@@ -76,9 +80,14 @@ func (k *KubectlForwarder) Start(out io.Writer) {
 
 // Forward port-forwards a pod using kubectl port-forward in the background.
 // It kills the command on errors in the kubectl port-forward log
-// It restarts the command if it was not cancelled by skaffold
+// It restarts the command if it was not cancelled by skaffold, such as when the forwarded pod restarts,
+// and reports the forward as reconnected on the same local port
 // It retries in case the port is taken
+// UDP ports are forwarded through a relay pod, see forwardUDP.
 func (k *KubectlForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	if pfe != nil && pfe.isUDP() {
+		return k.forwardUDP(parentCtx, pfe)
+	}
 	errChan := make(chan error, 1)
 	go k.forward(parentCtx, pfe, errChan)
 	l := log.Entry(parentCtx)
@@ -103,6 +112,8 @@ func (k *KubectlForwarder) forward(ctx context.Context, pfe *portForwardEntry, e
 		return
 	}
 	var notifiedUser bool
+	// reconnecting is set when an established forward is lost, until kubectl forwards the port again.
+	var reconnecting atomic.Bool
 	defer deferFunc()
 
 	for {
@@ -151,7 +162,15 @@ func (k *KubectlForwarder) forward(ctx context.Context, pfe *portForwardEntry, e
 		}
 
 		// Kill kubectl on port forwarding error logs
-		go k.monitorLogs(ctx, &buf, cmd, pfe, errChan)
+		var forwarding atomic.Bool
+		go k.monitorLogs(ctx, &buf, cmd, pfe, errChan, func() {
+			forwarding.Store(true)
+			if reconnecting.CompareAndSwap(true, false) {
+				output.Green.Fprintf(k.out, "port forwarding %v reconnected on port %d\n", pfe, pfe.localPort)
+				portForwardEvent(pfe, true)
+				portForwardEventV2(pfe, true)
+			}
+		})
 		if err := cmd.Wait(); err != nil {
 			if ctx.Err() == context.Canceled {
 				log.Entry(ctx).Debugf("terminated %v due to context cancellation", pfe)
@@ -162,6 +181,9 @@ func (k *KubectlForwarder) forward(ctx context.Context, pfe *portForwardEntry, e
 
 			s := buf.String()
 			log.Entry(ctx).Debugf("port forwarding %v got terminated: %s, output: %s", pfe, err, s)
+			if forwarding.Load() && !reconnecting.Swap(true) {
+				output.Yellow.Fprintf(k.out, "port forwarding %v lost connection, reconnecting...\n", pfe)
+			}
 			if !strings.Contains(s, "address already in use") {
 				select {
 				case errChan <- fmt.Errorf("port forwarding %v got terminated: output: %s", pfe, s):
@@ -209,12 +231,18 @@ func (*KubectlForwarder) Terminate(p *portForwardEntry) {
 		p.cancel()
 	}
 	p.terminated = true
+
+	// Wait for the UDP relay pod to be deleted
+	if p.relayDone != nil {
+		<-p.relayDone
+	}
 }
 
 // Monitor monitors the logs for a kubectl port forward command
 // If it sees an error, it calls back to the EntryManager to
 // retry the entire port forward operation.
-func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *kubectl.Cmd, p *portForwardEntry, err chan error) {
+// It calls forwarding once kubectl forwards the port.
+func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *kubectl.Cmd, p *portForwardEntry, err chan error, forwarding func()) {
 	ticker := time.NewTicker(waitErrorLogs)
 	defer ticker.Stop()
 
@@ -245,6 +273,7 @@ func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *k
 				}
 				return
 			} else if strings.Contains(s, "Forwarding from") {
+				forwarding()
 				select {
 				case err <- nil:
 				default:
@@ -25,6 +25,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -112,6 +113,7 @@ func TestMonitorErrorLogs(t *testing.T) {
 		input       string
 		cmdRunning  bool
 		shouldError bool
+		forwarding  bool
 	}{
 		{
 			description: "no error logs appear",
@@ -137,6 +139,7 @@ func TestMonitorErrorLogs(t *testing.T) {
 			description: "match on successful port forwarding message",
 			input:       "Forwarding from 127.0.0.1:8080 -> 8080",
 			cmdRunning:  true,
+			forwarding:  true,
 		},
 	}
 
@@ -156,17 +159,19 @@ func TestMonitorErrorLogs(t *testing.T) {
 			}
 
 			errChan := make(chan error, 1)
+			var forwarding atomic.Bool
 			go func() {
 				logs := strings.NewReader(test.input)
 
 				k := KubectlForwarder{}
-				k.monitorLogs(ctx, logs, cmd, &portForwardEntry{}, errChan)
+				k.monitorLogs(ctx, logs, cmd, &portForwardEntry{}, errChan, func() { forwarding.Store(true) })
 
 				errChan <- nil
 			}()
 
 			err := <-errChan
 			t.CheckError(test.shouldError, err)
+			t.CheckDeepEqual(test.forwarding, forwarding.Load())
 
 			// make sure the command is running or killed based on what's expected
 			if test.cmdRunning {
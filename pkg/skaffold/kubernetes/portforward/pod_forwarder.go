@@ -113,6 +113,11 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 	ownerReference := topLevelOwnerKey(ctx, pod, p.kubeContext, pod.Kind)
 	for _, c := range pod.Spec.Containers {
 		for _, port := range p.containerPorts(pod, c) {
+			// UDP ports are forwarded through a relay pod, which is only created for user-defined port forwards.
+			if port.Protocol == v1.ProtocolUDP {
+				log.Entry(ctx).Debugf("not forwarding UDP port %d of container %s/%s: define a port forward with `protocol: UDP` to forward it", port.ContainerPort, pod.Name, c.Name)
+				continue
+			}
 			// get current entry for this container
 			resource := latest.PortForwardResource{
 				Type:      constants.Pod,
@@ -131,9 +136,10 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 			}
 			if pe, ok := p.entryManager.forwardedResources.Load(entry.key()); ok {
 				prevEntry := pe.(*portForwardEntry)
-				// Check if this is a new generation of pod
+				// Check if this is a new generation of pod, such as a pod restarted by its owner
 				if entry.resourceVersion > prevEntry.resourceVersion {
-					p.entryManager.Terminate(prevEntry)
+					p.entryManager.replaceEntry(ctx, p.output, prevEntry, entry)
+					continue
 				}
 			}
 			p.entryManager.forwardPortForwardEntry(ctx, p.output, entry)
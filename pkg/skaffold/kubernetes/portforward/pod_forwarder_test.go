@@ -92,6 +92,57 @@ func TestAutomaticPortForwardPod(t *testing.T) {
 				},
 			},
 		},
+		{
+			description:    "udp container port isn't forwarded automatically",
+			availablePorts: []int{8080},
+			expectedPorts:  []int{8080},
+			expectedEntries: map[string]*portForwardEntry{
+				"owner-containername-namespace-portname-8080": {
+					resourceVersion: 1,
+					podName:         "podname",
+					containerName:   "containername",
+					resource: latest.PortForwardResource{
+						Type:      "pod",
+						Name:      "podname",
+						Namespace: "namespace",
+						Port:      schemautil.FromInt(8080),
+						Address:   "127.0.0.1",
+						LocalPort: 0,
+					},
+					ownerReference:         "owner",
+					automaticPodForwarding: true,
+					portName:               "portname",
+					localPort:              8080,
+				},
+			},
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "podname",
+						ResourceVersion: "1",
+						Namespace:       "namespace",
+					},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name: "containername",
+								Ports: []v1.ContainerPort{
+									{
+										ContainerPort: 7777,
+										Name:          "game",
+										Protocol:      v1.ProtocolUDP,
+									},
+									{
+										ContainerPort: 8080,
+										Name:          "portname",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			description:    "unavailable container port",
 			availablePorts: []int{9000},
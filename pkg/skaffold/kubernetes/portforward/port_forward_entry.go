@@ -22,6 +22,8 @@ import (
 	"strings"
 	"sync"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 )
 
@@ -37,6 +39,8 @@ type portForwardEntry struct {
 	terminated             bool
 	terminationLock        sync.Mutex
 	cancel                 context.CancelFunc
+	// relayDone is closed once the UDP relay of the entry has been cleaned up.
+	relayDone chan struct{}
 }
 
 // newPortForwardEntry returns a port forward entry.
@@ -56,14 +60,38 @@ func newPortForwardEntry(resourceVersion int, resource latest.PortForwardResourc
 // key is an identifier for the lock on a port during the skaffold dev cycle.
 // if automaticPodForwarding is set, we return a key that doesn't include podName, since we want the key
 // to be the same whenever pods restart
+// UDP entries get a distinct key since a port can be exposed for both TCP and UDP.
 func (p *portForwardEntry) key() string {
+	var key string
 	if p.automaticPodForwarding {
-		return fmt.Sprintf("%s-%s-%s-%s-%s", p.ownerReference, p.containerName, p.resource.Namespace, p.portName, p.resource.Port.String())
+		key = fmt.Sprintf("%s-%s-%s-%s-%s", p.ownerReference, p.containerName, p.resource.Namespace, p.portName, p.resource.Port.String())
+	} else {
+		key = fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
+	}
+	if p.isUDP() {
+		key += "-udp"
+	}
+	return key
+}
+
+// protocol returns the protocol of the forwarded port, as reported in port forward events.
+func (p *portForwardEntry) protocol() string {
+	if p.isUDP() {
+		return string(corev1.ProtocolUDP)
 	}
-	return fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
+	return string(corev1.ProtocolTCP)
+}
+
+// isUDP returns true if the entry forwards a UDP port.
+func (p *portForwardEntry) isUDP() bool {
+	return strings.EqualFold(p.resource.Protocol, string(corev1.ProtocolUDP))
 }
 
 // String is a utility function that returns the port forward entry as a user-readable string
 func (p *portForwardEntry) String() string {
-	return fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
+	s := fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
+	if p.isUDP() {
+		s += "-udp"
+	}
+	return s
 }
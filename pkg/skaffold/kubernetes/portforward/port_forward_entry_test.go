@@ -58,6 +58,16 @@ func TestPortForwardEntryKey(t *testing.T) {
 				Port:      schemautil.FromInt(9000),
 			}, "", "", "", "", 0, false),
 			expected: "deployment-depName-namespace-9000",
+		}, {
+			description: "entry for UDP service",
+			pfe: newPortForwardEntry(0, latest.PortForwardResource{
+				Type:      "service",
+				Name:      "dns",
+				Namespace: "default",
+				Port:      schemautil.FromInt(53),
+				Protocol:  "UDP",
+			}, "", "", "", "", 0, false),
+			expected: "service-dns-default-53-udp",
 		},
 	}
 
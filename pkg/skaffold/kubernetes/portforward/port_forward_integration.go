@@ -37,7 +37,7 @@ func SimulateDevCycle(t *testing.T, kubectlCLI *kubectl.CLI, namespace string, l
 	em := NewEntryManager(NewKubectlForwarder(kubectlCLI))
 	portForwardEventHandler := portForwardEvent
 	defer func() { portForwardEvent = portForwardEventHandler }()
-	portForwardEvent = func(entry *portForwardEntry) {}
+	portForwardEvent = func(*portForwardEntry, bool) {}
 	ctx := context.Background()
 	localPort := retrieveAvailablePort(util.Loopback, 9000, &em.forwardedPorts)
 	pfe := newPortForwardEntry(0, latest.PortForwardResource{
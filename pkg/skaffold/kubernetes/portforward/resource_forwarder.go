@@ -22,6 +22,7 @@ import (
 	"io"
 	"sync"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
@@ -188,6 +189,11 @@ func retrieveServiceResources(ctx context.Context, label string, namespaces []st
 		}
 		for _, s := range services.Items {
 			for _, p := range s.Spec.Ports {
+				// UDP ports are forwarded through a relay pod, which is only created for user-defined port forwards.
+				if p.Protocol == v1.ProtocolUDP {
+					log.Entry(ctx).Debugf("not forwarding UDP port %d of service %s/%s: define a port forward with `protocol: UDP` to forward it", p.Port, s.Namespace, s.Name)
+					continue
+				}
 				resources = append(resources, &latest.PortForwardResource{
 					Type:      constants.Service,
 					Name:      s.Name,
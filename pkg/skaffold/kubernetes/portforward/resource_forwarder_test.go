@@ -411,6 +411,28 @@ func TestRetrieveServices(t *testing.T) {
 					Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8080}}},
 				},
 			},
+		}, {
+			description: "udp ports aren't forwarded automatically",
+			namespaces:  []string{"test"},
+			services: []*v1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dns",
+						Namespace: "test",
+						Labels: map[string]string{
+							label.RunIDLabel: "9876-6789",
+						},
+					},
+					Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 53, Protocol: v1.ProtocolUDP}, {Port: 8080, Protocol: v1.ProtocolTCP}}},
+				},
+			},
+			expected: []*latest.PortForwardResource{{
+				Type:      constants.Service,
+				Name:      "dns",
+				Namespace: "test",
+				Port:      schemautil.FromInt(8080),
+				Address:   "127.0.0.1",
+			}},
 		}, {
 			description: "services present but does not expose any port",
 			namespaces:  []string{"test"},
@@ -0,0 +1,456 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
+	kubernetesutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+)
+
+const (
+	// udpRelayPort is the TCP port the relay pod listens on.
+	udpRelayPort = 5000
+
+	// udpRelayUser is the non-root user the relay runs as, `nobody` in most images.
+	udpRelayUser = 65534
+
+	// maxDatagramSize is the size of the largest datagram that can be relayed, as its length is sent on two bytes.
+	maxDatagramSize = 1<<16 - 1
+
+	// udpRelayScript bridges the TCP connections of `kubectl port-forward` to the UDP port. Each datagram is sent
+	// on the TCP stream after its length, as a two bytes big-endian integer, to preserve the datagram boundaries.
+	udpRelayScript = `import socket, struct, sys, threading
+
+host, port, listen_port = sys.argv[1], int(sys.argv[2]), int(sys.argv[3])
+
+
+def read(conn, n):
+    data = b""
+    while len(data) < n:
+        chunk = conn.recv(n - len(data))
+        if not chunk:
+            raise EOFError()
+        data += chunk
+    return data
+
+
+def to_udp(conn, udp):
+    try:
+        while True:
+            (n,) = struct.unpack("!H", read(conn, 2))
+            udp.send(read(conn, n))
+    except (EOFError, OSError):
+        pass
+    finally:
+        conn.close()
+        udp.close()
+
+
+def to_tcp(conn, udp):
+    while True:
+        try:
+            data = udp.recv(65535)
+        except ConnectionRefusedError:
+            continue
+        except OSError:
+            return
+        try:
+            conn.sendall(struct.pack("!H", len(data)) + data)
+        except OSError:
+            return
+
+
+server = socket.create_server(("", listen_port))
+while True:
+    conn, _ = server.accept()
+    try:
+        family, kind, proto, _, addr = socket.getaddrinfo(host, port, type=socket.SOCK_DGRAM)[0]
+        udp = socket.socket(family, kind, proto)
+        udp.connect(addr)
+    except OSError as e:
+        print("connecting to %s:%d: %s" % (host, port, e), file=sys.stderr, flush=True)
+        conn.close()
+        continue
+    threading.Thread(target=to_udp, args=(conn, udp), daemon=True).start()
+    threading.Thread(target=to_tcp, args=(conn, udp), daemon=True).start()
+`
+)
+
+// errCreateRelayPod is returned when the relay pod of a UDP port forward can't be created,
+// for example because the namespace doesn't allow it. It's reported as a warning.
+var errCreateRelayPod = errors.New("creating UDP relay pod")
+
+var (
+	// For testing
+	waitForRelayPod      = kubernetesutil.WaitForPodRunning
+	relayPodStartTimeout = 2 * time.Minute
+	relayCleanupTimeout  = 10 * time.Second
+)
+
+// forwardUDP port-forwards a UDP port. Since `kubectl port-forward` only supports TCP, a relay pod is created next
+// to the resource to translate between a TCP stream and UDP datagrams. The relay pod is port-forwarded with kubectl
+// and a local UDP listener sends the datagrams it receives through that TCP forward.
+// The relay pod is deleted when the entry is terminated, or garbage collected with the resource.
+func (k *KubectlForwarder) forwardUDP(ctx context.Context, pfe *portForwardEntry) error {
+	client, err := kubernetesclient.Client(k.kubectl.KubeContext)
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	target, err := findUDPTarget(ctx, client, pfe.resource)
+	if err != nil {
+		return fmt.Errorf("port forwarding %v: %w", pfe, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	pfe.terminationLock.Lock()
+	if pfe.terminated {
+		pfe.terminationLock.Unlock()
+		cancel()
+		return nil
+	}
+	pfe.cancel = cancel
+	pfe.relayDone = done
+	pfe.terminationLock.Unlock()
+
+	pods := client.CoreV1().Pods(pfe.resource.Namespace)
+	image := k.udpRelayImage
+	if image == "" {
+		image = constants.DefaultUDPRelayImage
+	}
+	relayPod, err := pods.Create(ctx, udpRelayPod(pfe.resource.Namespace, image, k.udpRelayLabels, target), metav1.CreateOptions{})
+	if err != nil {
+		cancel()
+		close(done)
+		return fmt.Errorf("%w for %v: %w", errCreateRelayPod, pfe, err)
+	}
+	go func() {
+		defer close(done)
+		<-ctx.Done()
+		deleteCtx, deleteCancel := context.WithTimeout(context.Background(), relayCleanupTimeout)
+		defer deleteCancel()
+		if err := pods.Delete(deleteCtx, relayPod.Name, metav1.DeleteOptions{}); err != nil {
+			log.Entry(ctx).Warnf("deleting UDP relay pod %s/%s: %v", relayPod.Namespace, relayPod.Name, err)
+		}
+	}()
+
+	if err := waitForRelayPod(ctx, pods, relayPod.Name, relayPodStartTimeout); err != nil {
+		cancel()
+		return fmt.Errorf("waiting for UDP relay pod %s: %w", relayPod.Name, err)
+	}
+
+	// The relay is forwarded on an ephemeral port since it's only used by the local UDP listener.
+	relayPort, err := ephemeralPort()
+	if err != nil {
+		cancel()
+		return err
+	}
+	relayEntry := newPortForwardEntry(0, latest.PortForwardResource{
+		Type:      constants.Pod,
+		Name:      relayPod.Name,
+		Namespace: relayPod.Namespace,
+		Port:      schemautil.FromInt(udpRelayPort),
+		Address:   util.Loopback,
+	}, relayPod.Name, "", "", "", relayPort, false)
+	if err := k.Forward(ctx, relayEntry); err != nil {
+		cancel()
+		return err
+	}
+
+	conn, err := net.ListenPacket("udp", net.JoinHostPort(pfe.resource.Address, strconv.Itoa(pfe.localPort)))
+	if err != nil {
+		cancel()
+		return fmt.Errorf("listening on UDP port %d: %w", pfe.localPort, err)
+	}
+	relay := newUDPRelay(conn, net.JoinHostPort(util.Loopback, strconv.Itoa(relayEntry.localPort)))
+	go func() {
+		<-ctx.Done()
+		relay.close()
+	}()
+	go relay.serve(ctx)
+	return nil
+}
+
+func ephemeralPort() (int, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(util.Loopback, "0"))
+	if err != nil {
+		return -1, fmt.Errorf("finding a free local port: %w", err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// udpTarget is the in-cluster host and port that the relay pod sends datagrams to, and the resource that owns the relay pod.
+type udpTarget struct {
+	host  string
+	port  int
+	owner metav1.OwnerReference
+}
+
+// findUDPTarget returns the target of the relay pod of a forwarded resource.
+func findUDPTarget(ctx context.Context, client kubernetes.Interface, resource latest.PortForwardResource) (udpTarget, error) {
+	switch latest.ResourceType(strings.ToLower(string(resource.Type))) {
+	case constants.Service:
+		svc, err := client.CoreV1().Services(resource.Namespace).Get(ctx, resource.Name, metav1.GetOptions{})
+		if err != nil {
+			return udpTarget{}, fmt.Errorf("getting service %s/%s: %w", resource.Namespace, resource.Name, err)
+		}
+		svcPort, err := findServicePort(*svc, resource.Port)
+		if err != nil {
+			return udpTarget{}, err
+		}
+		return udpTarget{
+			host:  fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace),
+			port:  int(svcPort.Port),
+			owner: ownerReference("Service", svc.ObjectMeta),
+		}, nil
+
+	case constants.Pod:
+		pod, err := client.CoreV1().Pods(resource.Namespace).Get(ctx, resource.Name, metav1.GetOptions{})
+		if err != nil {
+			return udpTarget{}, fmt.Errorf("getting pod %s/%s: %w", resource.Namespace, resource.Name, err)
+		}
+		if pod.Status.PodIP == "" {
+			return udpTarget{}, fmt.Errorf("pod %s/%s has no IP", resource.Namespace, resource.Name)
+		}
+		target := udpTarget{host: pod.Status.PodIP, port: -1, owner: ownerReference("Pod", pod.ObjectMeta)}
+		if resource.Port.Type == schemautil.Int {
+			target.port = resource.Port.IntVal
+			return target, nil
+		}
+		for _, c := range pod.Spec.Containers {
+			for _, p := range c.Ports {
+				if p.Name == resource.Port.StrVal && p.Protocol == corev1.ProtocolUDP {
+					target.port = int(p.ContainerPort)
+					return target, nil
+				}
+			}
+		}
+		return udpTarget{}, fmt.Errorf("pod %s/%s does not expose UDP port %s", resource.Namespace, resource.Name, resource.Port.String())
+
+	default:
+		return udpTarget{}, fmt.Errorf("UDP port forwarding is only supported for pods and services, not %s", resource.Type)
+	}
+}
+
+func ownerReference(kind string, meta metav1.ObjectMeta) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       kind,
+		Name:       meta.Name,
+		UID:        meta.UID,
+	}
+}
+
+// udpRelayLabels returns the labels of the relay pods of a run. The run id isn't set on the run-id label, so that the
+// relay pods aren't mistaken for deployed pods by the status check or the log tailer.
+func udpRelayLabels(runIDSelector string) map[string]string {
+	runID := "true"
+	if m, err := labels.ConvertSelectorToLabelsMap(runIDSelector); err == nil && m[label.RunIDLabel] != "" {
+		runID = m[label.RunIDLabel]
+	}
+	return map[string]string{kubernetesutil.UDPRelayLabel: runID}
+}
+
+// udpRelayPod returns a pod that accepts TCP connections and relays the datagrams they carry to a UDP port.
+func udpRelayPod(namespace, image string, relayLabels map[string]string, target udpTarget) *corev1.Pod {
+	podLabels := map[string]string{
+		"app.kubernetes.io/managed-by": "skaffold",
+	}
+	for k, v := range relayLabels {
+		podLabels[k] = v
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    "skaffold-udp-relay-",
+			Namespace:       namespace,
+			Labels:          podLabels,
+			OwnerReferences: []metav1.OwnerReference{target.owner},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "relay",
+				Image:   image,
+				Command: []string{"python3", "-u", "-c", udpRelayScript},
+				Args:    []string{target.host, strconv.Itoa(target.port), strconv.Itoa(udpRelayPort)},
+				Ports:   []corev1.ContainerPort{{ContainerPort: udpRelayPort}},
+				// the relay complies with the "restricted" Pod Security Standard, so that it can run in any namespace.
+				SecurityContext: &corev1.SecurityContext{
+					RunAsNonRoot:             util.Ptr(true),
+					RunAsUser:                util.Ptr(int64(udpRelayUser)),
+					RunAsGroup:               util.Ptr(int64(udpRelayUser)),
+					AllowPrivilegeEscalation: util.Ptr(false),
+					Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+					SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("10m"),
+						corev1.ResourceMemory: resource.MustParse("32Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				},
+			}},
+		},
+	}
+}
+
+// udpRelay sends the datagrams received on a local UDP port through a TCP connection per client,
+// and sends back the datagrams it reads from that connection to the client.
+// Each datagram is sent on the TCP connection after its length, as a two bytes big-endian integer.
+type udpRelay struct {
+	conn    net.PacketConn
+	tcpAddr string
+
+	lock    sync.Mutex
+	streams map[string]net.Conn
+}
+
+func newUDPRelay(conn net.PacketConn, tcpAddr string) *udpRelay {
+	return &udpRelay{
+		conn:    conn,
+		tcpAddr: tcpAddr,
+		streams: map[string]net.Conn{},
+	}
+}
+
+func (r *udpRelay) serve(ctx context.Context) {
+	buf := make([]byte, maxDatagramSize+1)
+	for {
+		n, addr, err := r.conn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Entry(ctx).Debugf("reading from UDP port %s: %v", r.conn.LocalAddr(), err)
+			}
+			return
+		}
+
+		if n > maxDatagramSize {
+			log.Entry(ctx).Debugf("dropping datagram of %d bytes from %s", n, addr)
+			continue
+		}
+		stream, err := r.stream(ctx, addr)
+		if err != nil {
+			log.Entry(ctx).Debugf("connecting UDP client %s to relay: %v", addr, err)
+			continue
+		}
+		if err := writeDatagram(stream, buf[:n]); err != nil {
+			log.Entry(ctx).Debugf("relaying datagram from %s: %v", addr, err)
+			r.closeStream(addr.String(), stream)
+		}
+	}
+}
+
+// stream returns the TCP connection of a UDP client, opening it on the first datagram.
+func (r *udpRelay) stream(ctx context.Context, addr net.Addr) (net.Conn, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if stream, found := r.streams[addr.String()]; found {
+		return stream, nil
+	}
+	stream, err := net.Dial("tcp", r.tcpAddr)
+	if err != nil {
+		return nil, err
+	}
+	r.streams[addr.String()] = stream
+
+	go func() {
+		defer r.closeStream(addr.String(), stream)
+		buf := make([]byte, maxDatagramSize)
+		for {
+			datagram, err := readDatagram(stream, buf)
+			if err != nil {
+				return
+			}
+			if _, err := r.conn.WriteTo(datagram, addr); err != nil {
+				log.Entry(ctx).Debugf("relaying datagram to %s: %v", addr, err)
+				return
+			}
+		}
+	}()
+	return stream, nil
+}
+
+// writeDatagram writes a datagram after its length.
+func writeDatagram(w io.Writer, datagram []byte) error {
+	frame := make([]byte, 2+len(datagram))
+	binary.BigEndian.PutUint16(frame, uint16(len(datagram)))
+	copy(frame[2:], datagram)
+	_, err := w.Write(frame)
+	return err
+}
+
+// readDatagram reads a datagram written by writeDatagram into buf.
+func readDatagram(r io.Reader, buf []byte) ([]byte, error) {
+	var length [2]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, err
+	}
+	datagram := buf[:binary.BigEndian.Uint16(length[:])]
+	if _, err := io.ReadFull(r, datagram); err != nil {
+		return nil, err
+	}
+	return datagram, nil
+}
+
+func (r *udpRelay) closeStream(key string, stream net.Conn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	stream.Close()
+	if r.streams[key] == stream {
+		delete(r.streams, key)
+	}
+}
+
+func (r *udpRelay) close() {
+	r.conn.Close()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for key, stream := range r.streams {
+		stream.Close()
+		delete(r.streams, key)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestFindUDPTarget(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "dns", Namespace: "default", UID: "svc-uid"},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP, TargetPort: intstr.FromInt(5353)}},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "game", Namespace: "default", UID: "pod-uid"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:  "server",
+				Ports: []v1.ContainerPort{{Name: "game", ContainerPort: 7777, Protocol: v1.ProtocolUDP}},
+			}},
+		},
+		Status: v1.PodStatus{PodIP: "10.0.0.5"},
+	}
+	svcOwner := metav1.OwnerReference{APIVersion: "v1", Kind: "Service", Name: "dns", UID: "svc-uid"}
+	podOwner := metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "game", UID: "pod-uid"}
+	tests := []struct {
+		description string
+		resource    latest.PortForwardResource
+		expected    udpTarget
+		shouldErr   bool
+	}{
+		{
+			description: "service by port number",
+			resource:    latest.PortForwardResource{Type: "service", Name: "dns", Namespace: "default", Port: schemautil.FromInt(53)},
+			expected:    udpTarget{host: "dns.default.svc", port: 53, owner: svcOwner},
+		},
+		{
+			description: "service by port name",
+			resource:    latest.PortForwardResource{Type: "Service", Name: "dns", Namespace: "default", Port: schemautil.FromString("dns")},
+			expected:    udpTarget{host: "dns.default.svc", port: 53, owner: svcOwner},
+		},
+		{
+			description: "unknown service port",
+			resource:    latest.PortForwardResource{Type: "service", Name: "dns", Namespace: "default", Port: schemautil.FromInt(54)},
+			shouldErr:   true,
+		},
+		{
+			description: "pod by port number",
+			resource:    latest.PortForwardResource{Type: "pod", Name: "game", Namespace: "default", Port: schemautil.FromInt(7777)},
+			expected:    udpTarget{host: "10.0.0.5", port: 7777, owner: podOwner},
+		},
+		{
+			description: "pod by port name",
+			resource:    latest.PortForwardResource{Type: "pod", Name: "game", Namespace: "default", Port: schemautil.FromString("game")},
+			expected:    udpTarget{host: "10.0.0.5", port: 7777, owner: podOwner},
+		},
+		{
+			description: "unsupported resource type",
+			resource:    latest.PortForwardResource{Type: "deployment", Name: "game", Namespace: "default", Port: schemautil.FromInt(7777)},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(svc, pod)
+
+			target, err := findUDPTarget(context.Background(), client, test.resource)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, target, cmp.AllowUnexported(udpTarget{}))
+		})
+	}
+}
+
+func TestUDPRelayPod(t *testing.T) {
+	owner := metav1.OwnerReference{APIVersion: "v1", Kind: "Service", Name: "dns", UID: "svc-uid"}
+	pod := udpRelayPod("ns", "relay:latest", udpRelayLabels("skaffold.dev/run-id=1234"), udpTarget{host: "dns.ns.svc", port: 53, owner: owner})
+
+	testutil.CheckDeepEqual(t, "ns", pod.Namespace)
+	testutil.CheckDeepEqual(t, map[string]string{"app.kubernetes.io/managed-by": "skaffold", "skaffold.dev/udp-relay": "1234"}, pod.Labels)
+	testutil.CheckDeepEqual(t, []metav1.OwnerReference{owner}, pod.OwnerReferences)
+	testutil.CheckDeepEqual(t, "relay:latest", pod.Spec.Containers[0].Image)
+	testutil.CheckDeepEqual(t, []string{"dns.ns.svc", "53", "5000"}, pod.Spec.Containers[0].Args)
+	testutil.CheckDeepEqual(t, "64Mi", pod.Spec.Containers[0].Resources.Limits.Memory().String())
+
+	sc := pod.Spec.Containers[0].SecurityContext
+	testutil.CheckDeepEqual(t, true, *sc.RunAsNonRoot)
+	testutil.CheckDeepEqual(t, int64(65534), *sc.RunAsUser)
+	testutil.CheckDeepEqual(t, false, *sc.AllowPrivilegeEscalation)
+	testutil.CheckDeepEqual(t, []v1.Capability{"ALL"}, sc.Capabilities.Drop)
+	testutil.CheckDeepEqual(t, v1.SeccompProfileTypeRuntimeDefault, sc.SeccompProfile.Type)
+}
+
+func TestUDPRelay(t *testing.T) {
+	// A TCP echo server stands in for the port-forwarded relay pod.
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.CheckError(t, false, err)
+	defer tcp.Close()
+	go func() {
+		for {
+			c, err := tcp.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(c, c)
+		}
+	}()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	testutil.CheckError(t, false, err)
+	relay := newUDPRelay(conn, tcp.Addr().String())
+	defer relay.close()
+	go relay.serve(context.Background())
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	testutil.CheckError(t, false, err)
+	defer client.Close()
+
+	// datagrams sent in quick succession keep their boundaries.
+	for _, datagram := range []string{"ping", "pong", ""} {
+		_, err = client.Write([]byte(datagram))
+		testutil.CheckError(t, false, err)
+	}
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, 16)
+	for _, expected := range []string{"ping", "pong", ""} {
+		n, err := client.Read(buf)
+		testutil.CheckErrorAndDeepEqual(t, false, err, expected, string(buf[:n]))
+	}
+}
+
+func TestDatagramFraming(t *testing.T) {
+	var stream bytes.Buffer
+	testutil.CheckError(t, false, writeDatagram(&stream, []byte("ping")))
+	testutil.CheckError(t, false, writeDatagram(&stream, []byte("ab")))
+	testutil.CheckDeepEqual(t, []byte{0, 4, 'p', 'i', 'n', 'g', 0, 2, 'a', 'b'}, stream.Bytes())
+
+	buf := make([]byte, maxDatagramSize)
+	for _, expected := range []string{"ping", "ab"} {
+		datagram, err := readDatagram(&stream, buf)
+		testutil.CheckErrorAndDeepEqual(t, false, err, expected, string(datagram))
+	}
+	_, err := readDatagram(&stream, buf)
+	testutil.CheckDeepEqual(t, true, errors.Is(err, io.EOF))
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// dependencies orders the status checks of the resources declaring dependencies with the `skaffold.dev/status-check-depends-on`
+// annotation after the status checks of the resources they depend on.
+type dependencies struct {
+	dependsOn map[*resource.Resource][]*resource.Resource
+	done      map[*resource.Resource]chan struct{}
+}
+
+// newDependencies resolves the dependencies of the resources among the checked resources of the same namespace.
+// Dependencies that aren't checked, like resources that weren't redeployed, are ignored, and so are the dependencies
+// of the first resource of a dependency cycle, which breaks the cycle.
+func newDependencies(ctx context.Context, resources []*resource.Resource) *dependencies {
+	d := &dependencies{
+		dependsOn: map[*resource.Resource][]*resource.Resource{},
+		done:      make(map[*resource.Resource]chan struct{}, len(resources)),
+	}
+	byRef := make(map[string]*resource.Resource, len(resources))
+	for _, r := range resources {
+		byRef[dependencyRef(r.Namespace(), string(r.Type())+"/"+r.Name())] = r
+		d.done[r] = make(chan struct{})
+	}
+	for _, r := range resources {
+		for _, ref := range r.DependsOn() {
+			dep, found := byRef[dependencyRef(r.Namespace(), ref)]
+			if !found || dep == r {
+				log.Entry(ctx).Debugf("Ignoring status check dependency %s of %s: it isn't checked", ref, r)
+				continue
+			}
+			d.dependsOn[r] = append(d.dependsOn[r], dep)
+		}
+	}
+	for _, r := range resources {
+		if d.inCycle(r, r, map[*resource.Resource]bool{}) {
+			log.Entry(ctx).Warnf("Ignoring the status check dependencies of %s: they form a cycle", r)
+			delete(d.dependsOn, r)
+		}
+	}
+	return d
+}
+
+// dependencyRef returns the lowercase `<type>/<name>` reference of a resource, qualified with its namespace.
+func dependencyRef(namespace, ref string) string {
+	return namespace + ":" + strings.ToLower(ref)
+}
+
+// inCycle returns whether the resource r is reached again from the dependencies of current.
+func (d *dependencies) inCycle(r, current *resource.Resource, visited map[*resource.Resource]bool) bool {
+	for _, dep := range d.dependsOn[current] {
+		if dep == r {
+			return true
+		}
+		if visited[dep] {
+			continue
+		}
+		visited[dep] = true
+		if d.inCycle(r, dep, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// wait reports the resource as waiting for its dependencies until they all complete their status check,
+// whether they succeed or fail, or until the context is done.
+func (d *dependencies) wait(ctx context.Context, r *resource.Resource) {
+	deps := d.dependsOn[r]
+	for i, dep := range deps {
+		var pending []string
+		for _, p := range deps[i:] {
+			pending = append(pending, p.String())
+		}
+		r.UpdateStatus(&proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_WAITING_FOR_DEPENDENCIES,
+			Message: fmt.Sprintf("waiting for %s", strings.Join(pending, ", ")),
+		})
+		select {
+		case <-d.done[dep]:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// complete releases the resources depending on r once its status check completed.
+func (d *dependencies) complete(r *resource.Resource) {
+	close(d.done[r])
+}
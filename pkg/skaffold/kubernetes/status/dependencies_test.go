@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func newDependentResource(name string, rType resource.Type, ns string, dependsOn string) *resource.Resource {
+	r := resource.NewResource(name, rType, ns, time.Minute, false)
+	if dependsOn != "" {
+		r.WithAnnotations(map[string]string{resource.DependsOnAnnotation: dependsOn})
+	}
+	return r
+}
+
+func TestNewDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		resources   []*resource.Resource
+		expected    map[string][]string
+	}{
+		{
+			description: "dependency",
+			resources: []*resource.Resource{
+				newDependentResource("app", resource.ResourceTypes.Deployment, "test", "statefulset/db, Service/cache"),
+				newDependentResource("db", resource.ResourceTypes.StatefulSet, "test", ""),
+				newDependentResource("cache", resource.ResourceTypes.Service, "test", ""),
+			},
+			expected: map[string][]string{"test:deployment/app": {"test:statefulset/db", "test:service/cache"}},
+		},
+		{
+			description: "dependency not checked",
+			resources: []*resource.Resource{
+				newDependentResource("app", resource.ResourceTypes.Deployment, "test", "statefulset/db"),
+			},
+			expected: map[string][]string{},
+		},
+		{
+			description: "dependency in another namespace",
+			resources: []*resource.Resource{
+				newDependentResource("app", resource.ResourceTypes.Deployment, "test", "statefulset/db"),
+				newDependentResource("db", resource.ResourceTypes.StatefulSet, "other", ""),
+			},
+			expected: map[string][]string{},
+		},
+		{
+			description: "cycle",
+			resources: []*resource.Resource{
+				newDependentResource("app", resource.ResourceTypes.Deployment, "test", "statefulset/db"),
+				newDependentResource("db", resource.ResourceTypes.StatefulSet, "test", "deployment/app"),
+			},
+			expected: map[string][]string{"test:statefulset/db": {"test:deployment/app"}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			d := newDependencies(context.Background(), test.resources)
+
+			actual := map[string][]string{}
+			for r, deps := range d.dependsOn {
+				for _, dep := range deps {
+					actual[r.String()] = append(actual[r.String()], dep.String())
+				}
+			}
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+func TestDependenciesWait(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		app := newDependentResource("app", resource.ResourceTypes.Deployment, "test", "statefulset/db")
+		db := newDependentResource("db", resource.ResourceTypes.StatefulSet, "test", "")
+		d := newDependencies(context.Background(), []*resource.Resource{app, db})
+
+		waited := make(chan struct{})
+		go func() {
+			d.wait(context.Background(), app)
+			close(waited)
+		}()
+
+		select {
+		case <-waited:
+			t.Fatal("the dependent resource should wait for its dependency")
+		case <-time.After(50 * time.Millisecond):
+		}
+		t.CheckDeepEqual(proto.StatusCode_STATUSCHECK_WAITING_FOR_DEPENDENCIES, app.StatusCode())
+		t.CheckDeepEqual("waiting for test:statefulset/db", app.Status().ActionableError().Message)
+
+		d.complete(db)
+		select {
+		case <-waited:
+		case <-time.After(time.Second):
+			t.Fatal("the dependent resource should stop waiting once its dependency completed")
+		}
+	})
+}
+
+func TestDependenciesWaitCancelled(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		app := newDependentResource("app", resource.ResourceTypes.Deployment, "test", "statefulset/db")
+		db := newDependentResource("db", resource.ResourceTypes.StatefulSet, "test", "")
+		d := newDependencies(context.Background(), []*resource.Resource{app, db})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		d.wait(ctx, app)
+	})
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"path"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// exclusion selects resources that are deployed but not waited for by the status check.
+type exclusion struct {
+	name   string
+	labels labels.Selector
+}
+
+func newExclusions(excludes []latest.StatusCheckExclude) ([]exclusion, error) {
+	var result []exclusion
+	for _, e := range excludes {
+		selector := labels.Everything()
+		if e.Labels != "" {
+			var err error
+			if selector, err = labels.Parse(e.Labels); err != nil {
+				return nil, fmt.Errorf("parsing statusCheckExclude label selector %q: %w", e.Labels, err)
+			}
+		}
+		result = append(result, exclusion{name: e.Name, labels: selector})
+	}
+	return result, nil
+}
+
+// matches returns whether the resource name matches the name pattern and its labels match the label selector.
+func (e exclusion) matches(r *resource.Resource) bool {
+	if e.name != "" {
+		if matched, _ := path.Match(e.name, r.Name()); !matched {
+			return false
+		}
+	}
+	return e.labels.Matches(labels.Set(r.Labels()))
+}
+
+// excluded returns whether the resource matches any of the exclusions.
+func excluded(exclusions []exclusion, r *resource.Resource) bool {
+	for _, e := range exclusions {
+		if e.matches(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+)
+
+// imageIDTag matches the unique tag of the local images, which is their image ID.
+var imageIDTag = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// staleImage is a container running another image than the one built by Skaffold.
+type staleImage struct {
+	pod       string
+	container string
+	image     string
+	expected  string
+	running   string
+}
+
+// builtDigest returns the digest a container running the given built image is expected to report,
+// which is the digest of the image reference or, for local images tagged with their image ID, the image ID.
+// It returns an empty string if the digest can't be known from the reference.
+func builtDigest(tag string) string {
+	parsed, err := docker.ParseReference(tag)
+	if err != nil {
+		return ""
+	}
+	if parsed.Digest != "" {
+		return parsed.Digest
+	}
+	if imageIDTag.MatchString(parsed.Tag) {
+		return "sha256:" + parsed.Tag
+	}
+	return ""
+}
+
+// staleImages returns the containers of the pods whose image ID doesn't match the digest of the built image they reference.
+// Containers that didn't report an image ID yet are skipped.
+func staleImages(pods []v1.Pod, digests map[string]string) []staleImage {
+	var stale []staleImage
+	for _, pod := range pods {
+		images := map[string]string{}
+		for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			images[c.Name] = c.Image
+		}
+		for _, cs := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			image := images[cs.Name]
+			expected := digests[image]
+			if expected == "" || cs.ImageID == "" || strings.HasSuffix(cs.ImageID, expected) {
+				continue
+			}
+			stale = append(stale, staleImage{
+				pod:       pod.Name,
+				container: cs.Name,
+				image:     image,
+				expected:  expected,
+				running:   cs.ImageID,
+			})
+		}
+	}
+	return stale
+}
+
+// checkRunningImages warns about the containers of the pods deployed by the current run that don't run the images built by Skaffold,
+// typically because a node reused an older image with the same tag with `imagePullPolicy: IfNotPresent`.
+func (s *monitor) checkRunningImages(ctx context.Context, out io.Writer, client kubernetes.Interface) error {
+	var stale []staleImage
+	for _, ns := range s.listedNamespaces() {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: runIDSelector(s.labeller),
+		})
+		if err != nil {
+			return fmt.Errorf("could not fetch pods: %w", err)
+		}
+		stale = append(stale, staleImages(pods.Items, s.builtImages)...)
+	}
+	for _, i := range stale {
+		output.Yellow.Fprintf(out, "%s pod/%s container %s runs image %s instead of the built %s (%s). Check the imagePullPolicy of the container.\n",
+			tabHeader, i.pod, i.container, i.running, i.expected, i.image)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+const (
+	builtID  = "a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+	staleID  = "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+	digestID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+func TestBuiltDigest(t *testing.T) {
+	tests := []struct {
+		description string
+		tag         string
+		expected    string
+	}{
+		{
+			description: "digest",
+			tag:         "gcr.io/project/app:v1@sha256:" + digestID,
+			expected:    "sha256:" + digestID,
+		},
+		{
+			description: "local image tagged with its image id",
+			tag:         "app:" + builtID,
+			expected:    "sha256:" + builtID,
+		},
+		{
+			description: "plain tag",
+			tag:         "app:v1",
+		},
+		{
+			description: "invalid reference",
+			tag:         "app:v1:v2",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, builtDigest(test.tag))
+		})
+	}
+}
+
+func TestStaleImages(t *testing.T) {
+	pod := func(name, image, imageID string) v1.Pod {
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: image}}},
+			Status:     v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{Name: "app", ImageID: imageID}}},
+		}
+	}
+	digests := map[string]string{
+		"app:" + builtID: "sha256:" + builtID,
+		"gcr.io/project/app:v1@sha256:" + digestID: "sha256:" + digestID,
+	}
+	tests := []struct {
+		description string
+		pods        []v1.Pod
+		expected    []staleImage
+	}{
+		{
+			description: "local image running",
+			pods:        []v1.Pod{pod("web", "app:"+builtID, "docker://sha256:"+builtID)},
+		},
+		{
+			description: "pushed image running",
+			pods:        []v1.Pod{pod("web", "gcr.io/project/app:v1@sha256:"+digestID, "gcr.io/project/app@sha256:"+digestID)},
+		},
+		{
+			description: "older image running",
+			pods:        []v1.Pod{pod("web", "app:"+builtID, "docker://sha256:"+staleID)},
+			expected: []staleImage{{
+				pod:       "web",
+				container: "app",
+				image:     "app:" + builtID,
+				expected:  "sha256:" + builtID,
+				running:   "docker://sha256:" + staleID,
+			}},
+		},
+		{
+			description: "image not reported yet",
+			pods:        []v1.Pod{pod("web", "app:"+builtID, "")},
+		},
+		{
+			description: "image not built by skaffold",
+			pods:        []v1.Pod{pod("db", "postgres:16", "docker.io/library/postgres@sha256:"+staleID)},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, staleImages(test.pods, digests), cmp.AllowUnexported(staleImage{}))
+		})
+	}
+}
+
+func TestCheckRunningImages(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	newPod := func(name string, labels map[string]string, imageID string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test", Labels: labels},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: "app:" + builtID}}},
+			Status:     v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{Name: "app", ImageID: imageID}}},
+		}
+	}
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := fakekubeclientset.NewSimpleClientset(
+			newPod("current", runLabels, "docker://sha256:"+builtID),
+			newPod("stale", runLabels, "docker://sha256:"+staleID),
+			newPod("other-run", map[string]string{label.RunIDLabel: "other"}, "docker://sha256:"+staleID),
+		)
+		m := &monitor{labeller: labeller, namespaces: &[]string{"test"}}
+		m.SetBuiltImages([]string{"app:" + builtID, "app:v1"})
+		t.CheckDeepEqual(map[string]string{"app:" + builtID: "sha256:" + builtID}, m.builtImages)
+
+		var out bytes.Buffer
+		err := m.checkRunningImages(context.Background(), &out, client)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(" - pod/stale container app runs image docker://sha256:"+staleID+" instead of the built sha256:"+builtID+" (app:"+builtID+"). Check the imagePullPolicy of the container.\n", out.String())
+	})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+const junitSuiteName = "skaffold status-check"
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemErr string        `xml:"system-err,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// newJUnitReport reports each status checked resource as a test case: successful resources pass,
+// cancelled resources are skipped and the other ones fail with their actionable error, including the custom suggestions of cfg.
+func newJUnitReport(cfg Config, resources []*resource.Resource, elapsed time.Duration) junitTestSuites {
+	suite := junitTestSuite{
+		Name:  junitSuiteName,
+		Tests: len(resources),
+		Time:  fmt.Sprintf("%.3f", elapsed.Seconds()),
+	}
+	for _, r := range resources {
+		tc := junitTestCase{
+			Name:      r.String(),
+			ClassName: junitSuiteName,
+		}
+		ae := sErrors.WithCustomSuggestions(cfg, r.Status().ActionableError(), r.StatusCode())
+		switch r.StatusCode() {
+		case proto.StatusCode_STATUSCHECK_SUCCESS:
+		case proto.StatusCode_STATUSCHECK_USER_CANCELLED:
+			suite.Skipped++
+			tc.Skipped = &junitSkipped{Message: trimNewLine(r.StatusMessage())}
+		default:
+			suite.Failures++
+			tc.Failure = &junitFailure{
+				Message: trimNewLine(r.StatusMessage()),
+				Type:    r.StatusCode().String(),
+			}
+			if ae != nil {
+				var suggestions []string
+				for _, s := range ae.Suggestions {
+					suggestions = append(suggestions, s.Action)
+				}
+				tc.Failure.Text = strings.Join(suggestions, "\n")
+			}
+			tc.SystemErr = strings.Join(r.PodLogs(), "\n")
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	return junitTestSuites{Suites: []junitTestSuite{suite}}
+}
+
+// writeJUnitReport writes the status check results of the resources as a JUnit XML file.
+func writeJUnitReport(cfg Config, path string, resources []*resource.Resource, elapsed time.Duration) error {
+	b, err := xml.MarshalIndent(newJUnitReport(cfg, resources, elapsed), "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling junit report: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating junit report directory: %w", err)
+	}
+	return os.WriteFile(path, append([]byte(xml.Header), b...), 0644)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestWriteJUnitReport(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		resources := []*resource.Resource{
+			withStatus(resource.NewResource("ready", resource.ResourceTypes.Deployment, "test", time.Second, false),
+				&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}),
+			withStatus(resource.NewResource("failed", resource.ResourceTypes.Deployment, "test", time.Second, false),
+				&proto.ActionableErr{
+					ErrCode:     proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR,
+					Message:     "image can't be pulled\n",
+					Suggestions: []*proto.Suggestion{{SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_IMAGE, Action: "Check the image"}},
+				}),
+			withStatus(resource.NewResource("cancelled", resource.ResourceTypes.StatefulSet, "test", time.Second, false),
+				&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED, Message: "check cancelled\n"}),
+		}
+		path := t.NewTempDir().Path("reports/status-check.xml")
+
+		err := writeJUnitReport(nil, path, resources, 1500*time.Millisecond)
+		t.CheckNoError(err)
+
+		b, err := os.ReadFile(path)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="skaffold status-check" tests="3" failures="1" skipped="1" time="1.500">
+    <testcase name="test:deployment/ready" classname="skaffold status-check"></testcase>
+    <testcase name="test:deployment/failed" classname="skaffold status-check">
+      <failure message="image can&#39;t be pulled" type="STATUSCHECK_IMAGE_PULL_ERR">Check the image</failure>
+    </testcase>
+    <testcase name="test:statefulset/cancelled" classname="skaffold status-check">
+      <skipped message="check cancelled"></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>`, string(b))
+	})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// Update is the status of a resource reported by the status check, whenever it changes and once its check completes.
+type Update struct {
+	// Resource identifies the resource as printed, like `test:deployment/leeroy-app`.
+	Resource   string
+	Kind       string
+	Name       string
+	Namespace  string
+	StatusCode proto.StatusCode
+	Message    string
+	Progress   resource.Progress
+	// Pods are the statuses of the resource pods, sorted by name.
+	Pods []PodUpdate
+	// Done is true for the final update of a resource, once its status check succeeded or failed.
+	Done bool
+	// Text is the terminal rendering of the update.
+	Text string
+}
+
+// PodUpdate is the status of a pod of a status checked resource.
+type PodUpdate struct {
+	Name       string
+	StatusCode proto.StatusCode
+	Message    string
+}
+
+// Reporter reports the status check updates of the deployed resources, for example to render them in another tool.
+type Reporter interface {
+	Report(out io.Writer, u Update)
+}
+
+// terminalReporter prints the updates to the terminal.
+type terminalReporter struct{}
+
+func (terminalReporter) Report(out io.Writer, u Update) {
+	fmt.Fprintln(out, trimNewLine(u.Text))
+}
+
+// MonitorOption configures a status monitor.
+type MonitorOption func(*monitor)
+
+// WithReporter replaces the reporter of the status check updates, which prints them to the terminal by default.
+// The resource tree of `--watch-resources` is only drawn by the default reporter.
+func WithReporter(r Reporter) MonitorOption {
+	return func(m *monitor) {
+		m.reporter = r
+	}
+}
+
+// report reports an update with the reporter of the monitor.
+func (s *monitor) report(out io.Writer, u Update) {
+	if s.reporter == nil {
+		terminalReporter{}.Report(out, u)
+		return
+	}
+	s.reporter.Report(out, u)
+}
+
+// reportsToTerminal returns whether the updates are printed to the terminal by the default reporter.
+func (s *monitor) reportsToTerminal() bool {
+	_, isTerminal := s.reporter.(terminalReporter)
+	return s.reporter == nil || isTerminal
+}
+
+// newUpdate returns the update of a resource with its terminal rendering.
+func newUpdate(r *resource.Resource, text string, done bool) Update {
+	u := Update{
+		Resource:   r.String(),
+		Kind:       string(r.Type()),
+		Name:       r.Name(),
+		Namespace:  r.Namespace(),
+		StatusCode: r.StatusCode(),
+		Message:    trimNewLine(r.StatusMessage()),
+		Progress:   r.Status().Progress(),
+		Done:       done,
+		Text:       text,
+	}
+	for _, p := range r.Pods() {
+		u.Pods = append(u.Pods, PodUpdate{
+			Name:       p.Name(),
+			StatusCode: p.ActionableError().GetErrCode(),
+			Message:    p.ActionableError().GetMessage(),
+		})
+	}
+	return u
+}
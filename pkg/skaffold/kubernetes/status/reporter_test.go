@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+type recordingReporter struct {
+	updates []Update
+}
+
+func (r *recordingReporter) Report(_ io.Writer, u Update) {
+	r.updates = append(r.updates, u)
+}
+
+func TestReporter(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		recorder := &recordingReporter{}
+		testEvent.InitializeState([]latest.Pipeline{{}})
+
+		cfg := &statusConfig{RunContext: runcontext.RunContext{
+			Pipelines: runcontext.NewPipelines(map[string]latest.Pipeline{"": {}}, []string{""}),
+		}}
+		monitor := NewStatusMonitor(cfg, label.NewLabeller(true, nil, "run-id"), nil, nil, WithReporter(recorder)).(*monitor)
+		out := new(bytes.Buffer)
+
+		pending := withStatus(
+			resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1, false).
+				WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}),
+			&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: "Waiting for rollout to finish: 1 of 2 updated replicas are available..."},
+		)
+		monitor.printStatus([]*resource.Resource{pending}, out)
+
+		ready := withStatus(
+			resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", 1, false),
+			&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+		)
+		monitor.printStatusCheckSummary(out, ready, counter{total: 2, pending: 1})
+
+		t.CheckEmpty(out.String())
+		t.CheckDeepEqual([]Update{
+			{
+				Resource:   "test:deployment/r1",
+				Kind:       "deployment",
+				Name:       "r1",
+				Namespace:  "test",
+				StatusCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR,
+				Message:    "pod failed",
+				Progress:   resource.Progress{Current: 1, Total: 2},
+				Pods: []PodUpdate{
+					{Name: "foo", StatusCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, Message: "pod failed"},
+				},
+				Text: " - test:deployment/r1: pod failed\n    - test:pod/foo: pod failed\n",
+			},
+			{
+				Resource:   "test:deployment/r2",
+				Kind:       "deployment",
+				Name:       "r2",
+				Namespace:  "test",
+				StatusCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Done:       true,
+				Text:       " - test:deployment/r2 is ready. [1/2 deployment(s) still pending]",
+			},
+		}, recorder.updates)
+	})
+}
+
+func TestReportsToTerminal(t *testing.T) {
+	cfg := &statusConfig{RunContext: runcontext.RunContext{
+		Pipelines: runcontext.NewPipelines(map[string]latest.Pipeline{"": {}}, []string{""}),
+	}}
+	labeller := label.NewLabeller(true, nil, "run-id")
+
+	testutil.CheckDeepEqual(t, true, NewStatusMonitor(cfg, labeller, nil, nil).(*monitor).reportsToTerminal())
+	testutil.CheckDeepEqual(t, false, NewStatusMonitor(cfg, labeller, nil, nil, WithReporter(&recordingReporter{})).(*monitor).reportsToTerminal())
+}
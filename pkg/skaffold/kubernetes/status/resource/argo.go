@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+const (
+	argoRolloutKind = "rollouts.argoproj.io"
+	// argoRolloutJSONPath prints the fields of the rollout status, with the message last since it can contain the separator.
+	argoRolloutJSONPath = "jsonpath={.metadata.generation}|{.status.observedGeneration}|{.status.phase}|{.status.pauseConditions[*].reason}|{.status.message}"
+)
+
+// checkArgoRolloutStatus checks the phase of an Argo Rollout, which is Healthy once the rollout completed,
+// and Degraded once it failed or was aborted.
+func (r *Resource) checkArgoRolloutStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", argoRolloutKind, r.name, "-o", argoRolloutJSONPath, "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	fields := strings.SplitN(strings.TrimSpace(string(b)), "|", 5)
+	for len(fields) < 5 {
+		fields = append(fields, "")
+	}
+	generation, observedGeneration, phase, pauseReasons, message := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	// the phase is the one of the previous spec until the controller observed the latest generation.
+	if observedGeneration != generation {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING,
+			Message: "waiting for the rollout spec update to be observed",
+		}
+	}
+	switch phase {
+	case "Healthy":
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+	case "Degraded":
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_DEGRADED,
+			Message: fmt.Sprintf("rollout is degraded: %s", message),
+		}
+	case "Paused":
+		if r.skipPaused {
+			return &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "rollout is paused, skipping the status check",
+			}
+		}
+		paused := "rollout is paused"
+		if pauseReasons != "" {
+			paused = fmt.Sprintf("%s (%s)", paused, pauseReasons)
+		}
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PAUSED,
+			Message: fmt.Sprintf("%s. Promote it with `kubectl argo rollouts promote %s` or set `statusCheckSkipPaused` to skip paused rollouts", paused, r.name),
+		}
+	default:
+		if message == "" {
+			message = "waiting for the rollout to be healthy"
+		}
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING,
+			Message: message,
+		}
+	}
+}
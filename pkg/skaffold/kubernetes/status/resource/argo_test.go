@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestArgoRolloutCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get rollouts.argoproj.io web -o " + argoRolloutJSONPath + " --namespace test"
+	tests := []struct {
+		description     string
+		output          string
+		skipPaused      bool
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+		complete        bool
+	}{
+		{
+			description:     "healthy",
+			output:          "2|2|Healthy||",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description:     "progressing",
+			output:          "2|2|Progressing||more replicas need to be updated",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING,
+			expectedMessage: "more replicas need to be updated",
+		},
+		{
+			description:     "spec update not observed",
+			output:          "3|2|Healthy||",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING,
+			expectedMessage: "waiting for the rollout spec update to be observed",
+		},
+		{
+			description:     "degraded",
+			output:          "2|2|Degraded||RolloutAborted: Rollout aborted update to revision 2",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_DEGRADED,
+			expectedMessage: "rollout is degraded: RolloutAborted: Rollout aborted update to revision 2",
+			complete:        true,
+		},
+		{
+			description:     "paused",
+			output:          "2|2|Paused|CanaryPauseStep|CanaryPauseStep",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PAUSED,
+			expectedMessage: "rollout is paused (CanaryPauseStep). Promote it with `kubectl argo rollouts promote web` or set `statusCheckSkipPaused` to skip paused rollouts",
+		},
+		{
+			description:     "paused and skipped",
+			output:          "2|2|Paused|CanaryPauseStep|CanaryPauseStep",
+			skipPaused:      true,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedMessage: "rollout is paused, skipping the status check",
+			complete:        true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(getCmd, test.output))
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("web", ResourceTypes.ArgoRollout, "test", 0, false)
+			if test.skipPaused {
+				r.WithSkipPaused()
+			}
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.status.ae.GetErrCode())
+			t.CheckDeepEqual(test.expectedMessage, r.status.ae.GetMessage())
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import "time"
+
+// Clock tells the time to the status check of a resource, so that the initial delay, the stabilization window
+// and the timestamps of the history can be tested without sleeping.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock is the wall clock.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// WithClock measures the time of the status check of the resource with the given clock.
+func (r *Resource) WithClock(clock Clock) *Resource {
+	r.clock = clock
+	return r
+}
+
+// since returns the time elapsed since t, according to the clock of the resource.
+func (r *Resource) since(t time.Time) time.Duration {
+	return r.clock.Now().Sub(t)
+}
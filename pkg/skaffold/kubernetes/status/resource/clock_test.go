@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+// fakeClock is a clock that only moves forward when it's advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestInitialDelayFromClock(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	r := NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false).WithClock(clock).WithInitialDelay(time.Minute)
+	testutil.CheckDeepEqual(t, time.Unix(0, 0), r.watchedSince)
+	testutil.CheckDeepEqual(t, true, r.inInitialDelay())
+
+	clock.advance(59 * time.Second)
+	testutil.CheckDeepEqual(t, true, r.inInitialDelay())
+
+	clock.advance(time.Second)
+	testutil.CheckDeepEqual(t, false, r.inInitialDelay())
+}
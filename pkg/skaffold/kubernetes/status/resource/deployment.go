@@ -18,8 +18,11 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os/exec"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -38,44 +41,85 @@ import (
 const (
 	deploymentRolloutSuccess   = "successfully rolled out"
 	connectionErrMsg           = "Unable to connect to the server"
+	unauthorizedErrMsg         = "You must be logged in to the server"
+	credentialsRequiredErrMsg  = "the server has asked for the client to provide credentials"
 	killedErrMsg               = "signal: killed"
+	signalErrMsg               = "signal: "
 	clientSideThrottleErrMsg   = "due to client-side throttling"
 	couldNotFindResourceErrMsg = "the server could not find the requested resource"
+	notFoundErrMsg             = "(NotFound)"
+	noRolloutHistoryErrMsg     = "no rollout history found"
+	progressDeadlineErrMsg     = "exceeded its progress deadline"
 	defaultPodCheckDeadline    = 30 * time.Second
 	tabHeader                  = " -"
 	tab                        = "  "
-	maxLogLines                = 3
+	// defaultLogLines is the number of last log lines of each pod reported when the logs are muted.
+	defaultLogLines    = 3
+	generationJSONPath = "jsonpath={.metadata.generation} {.status.observedGeneration} {.spec.replicas} {.status.updatedReplicas} {.status.replicas} {.status.availableReplicas} {.spec.paused}"
+
+	// MuteLogsAnnotation overrides, when set to "true" or "false" on a workload or on its pod template,
+	// whether the logs of its pods are muted in the status check.
+	MuteLogsAnnotation = "skaffold.dev/status-check-mute-logs"
+	// DependsOnAnnotation lists, on a workload or on its pod template, the resources in the same namespace that must
+	// complete their status check before the status check of the workload starts, like `statefulset/db,service/cache`.
+	DependsOnAnnotation = "skaffold.dev/status-check-depends-on"
+	// ScaleDirectionAnnotation sets, on a deployment, the direction of the scaling that its status check waits for:
+	// "up", the default, waits for its rollout to complete, and "down" waits for it to be scaled down to zero available replicas.
+	ScaleDirectionAnnotation = "skaffold.dev/status-check-scale-direction"
 )
 
 // Type represents a kubernetes resource type to health check.
 type Type string
 
 var (
+	// kubectlWaitDelay bounds the wait for the output of a cancelled kubectl command,
+	// which can be held open by processes it spawned, like credential plugins.
+	kubectlWaitDelay = 2 * time.Second
+
+	// deletedGracePeriod is how long a resource that can't be found anymore is still checked before
+	// its deletion completes or fails the status check.
+	deletedGracePeriod = 5 * time.Second
+
+	// maxCredentialRetries is the number of consecutive retries after which expired credentials fail the resource.
+	maxCredentialRetries = 3
+
 	statefulsetRolloutSuccess = regexp.MustCompile("(roll out|rolling update) complete")
 
 	msgKubectlKilled            = "kubectl rollout status command interrupted\n"
 	MsgKubectlConnection        = "kubectl connection error\n"
+	msgKubectlAuthExpired       = "kubectl credentials expired or were rejected, retrying"
 	msgStrategyTypeNotSupported = "rollout status is only available for RollingUpdate strategy type"
 
 	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
-		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:       {},
-		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    {},
-		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED: {},
-		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING: {},
+		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:          {},
+		proto.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH: {},
+		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:       {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED:    {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING:    {},
 	}
 
 	ResourceTypes = struct {
-		StandalonePods  Type
-		Deployment      Type
-		StatefulSet     Type
-		ConfigConnector Type
-		CustomResource  Type
+		StandalonePods        Type
+		Deployment            Type
+		StatefulSet           Type
+		ReplicaSet            Type
+		ConfigConnector       Type
+		CustomResource        Type
+		Service               Type
+		Ingress               Type
+		PersistentVolumeClaim Type
+		ArgoRollout           Type
 	}{
-		StandalonePods:  "standalone-pods",
-		Deployment:      "deployment",
-		StatefulSet:     "statefulset",
-		ConfigConnector: "config-connector-resource",
-		CustomResource:  "custom-resource",
+		StandalonePods:        "standalone-pods",
+		Deployment:            "deployment",
+		StatefulSet:           "statefulset",
+		ReplicaSet:            "replicaset",
+		ConfigConnector:       "config-connector-resource",
+		CustomResource:        "custom-resource",
+		Service:               "service",
+		Ingress:               "ingress",
+		PersistentVolumeClaim: "persistentvolumeclaim",
+		ArgoRollout:           "rollout",
 	}
 )
 
@@ -100,6 +144,8 @@ type Resource struct {
 	name             string
 	namespace        string
 	rType            Type
+	labels           map[string]string
+	images           []string
 	status           Status
 	statusCode       proto.StatusCode
 	done             bool
@@ -107,6 +153,32 @@ type Resource struct {
 	deadline         time.Duration
 	resources        map[string]validator.Resource
 	resoureValidator diag.Diagnose
+	logTailer        *podLogTailer
+	waitForHPA       bool
+	waitForEndpoints bool
+	watchedSince     time.Time
+	initialDelay     time.Duration
+	stabilization    time.Duration
+	healthySince     time.Time
+	deletedSince     time.Time
+	healthyOnDelete  bool
+	transitions      []Transition
+	becameReady      bool
+	logLines         int
+	maxLogLines      int
+	muteLogs         *bool
+	dependsOn        []string
+	retryableErrors  []string
+	failOn           map[proto.StatusCode]bool
+	skipPaused       bool
+	readyPercent     int
+	scaleDown        bool
+	readiness        *readiness
+	kubectlBinary    string
+	clock            Clock
+
+	// credentialRetries counts the consecutive retries after kubectl reported expired credentials.
+	credentialRetries int
 }
 
 func (r *Resource) ID() string {
@@ -117,6 +189,11 @@ func (r *Resource) Deadline() time.Duration {
 	return r.deadline
 }
 
+// StatusChanged returns true if the resource or its pods status changed during the last status check.
+func (r *Resource) StatusChanged() bool {
+	return r.status.changed
+}
+
 func (r *Resource) UpdateStatus(ae *proto.ActionableErr) {
 	updated := newStatus(ae)
 	if r.status.Equal(updated) {
@@ -126,7 +203,8 @@ func (r *Resource) UpdateStatus(ae *proto.ActionableErr) {
 	r.status = updated
 	r.statusCode = updated.ActionableError().ErrCode
 	r.status.changed = true
-	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || isErrAndNotRetryAble(ae.ErrCode) {
+	r.recordTransition()
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || r.isFailure(ae.ErrCode) {
 		r.done = true
 	}
 }
@@ -140,6 +218,7 @@ func NewResource(name string, rType Type, ns string, deadline time.Duration, tol
 		deadline:         deadline,
 		resoureValidator: diag.New(nil),
 		tolerateFailures: tolerateFailures,
+		clock:            realClock{},
 	}
 }
 
@@ -148,18 +227,252 @@ func (r *Resource) WithValidator(pd diag.Diagnose) *Resource {
 	return r
 }
 
+// WithLabels records the labels of the resource, matched against the status check exclusions.
+func (r *Resource) WithLabels(labels map[string]string) *Resource {
+	r.labels = labels
+	return r
+}
+
+// WithImages records the images of the containers of the resource.
+func (r *Resource) WithImages(images []string) *Resource {
+	r.images = images
+	return r
+}
+
+// WithLogTailing follows the logs of the resource's unready pods into out while the status check is in progress.
+// The logs are followed with the kubectl binary set before.
+func (r *Resource) WithLogTailing(cfg kubectl.Config, out *LogWriter) *Resource {
+	r.logTailer = newPodLogTailer(cfg, r.kubectlBinary, out)
+	return r
+}
+
+// WithInitialDelay suppresses the resource failures until the initial delay has elapsed since the status check started
+// watching the resource. The resource's creation time isn't used, since an updated resource may have been created long ago.
+func (r *Resource) WithInitialDelay(delay time.Duration) *Resource {
+	r.watchedSince = r.clock.Now()
+	r.initialDelay = delay
+	return r
+}
+
+func (r *Resource) inInitialDelay() bool {
+	return r.initialDelay > 0 && r.since(r.watchedSince) < r.initialDelay
+}
+
+// WithStabilization requires the resource to stay healthy for the stabilization window before its status check completes.
+func (r *Resource) WithStabilization(window time.Duration) *Resource {
+	r.stabilization = window
+	return r
+}
+
+// WithLogLines sets the number of last log lines of each pod reported when the logs are muted.
+func (r *Resource) WithLogLines(n int) *Resource {
+	r.logLines = n
+	return r
+}
+
+// WithMaxLogLines caps the number of last log lines of each pod reported when the logs aren't muted.
+func (r *Resource) WithMaxLogLines(n int) *Resource {
+	r.maxLogLines = n
+	return r
+}
+
+// WithAnnotations reads the status check settings of the resource from the annotations of the workload and of its pod template,
+// the annotations of the workload taking precedence.
+func (r *Resource) WithAnnotations(annotations ...map[string]string) *Resource {
+	for _, a := range annotations {
+		if muted, err := strconv.ParseBool(a[MuteLogsAnnotation]); err == nil {
+			r.muteLogs = &muted
+			break
+		}
+	}
+	for _, a := range annotations {
+		if deps, found := a[DependsOnAnnotation]; found {
+			r.dependsOn = nil
+			for _, dep := range strings.Split(deps, ",") {
+				if dep = strings.TrimSpace(dep); dep != "" {
+					r.dependsOn = append(r.dependsOn, dep)
+				}
+			}
+			break
+		}
+	}
+	for _, a := range annotations {
+		if direction, found := a[ScaleDirectionAnnotation]; found {
+			r.scaleDown = strings.EqualFold(strings.TrimSpace(direction), "down")
+			break
+		}
+	}
+	return r
+}
+
+// DependsOn returns the `<type>/<name>` references of the resources that must complete their status check first.
+func (r *Resource) DependsOn() []string {
+	return r.dependsOn
+}
+
+// reportedLogLines returns the number of last log lines of each pod to report, out of the available lines.
+// The other lines are written to a log file.
+func (r *Resource) reportedLogLines(isMuted bool, available int) int {
+	if r.muteLogs != nil {
+		isMuted = *r.muteLogs
+	}
+	switch {
+	case !isMuted && r.maxLogLines > 0:
+		return r.maxLogLines
+	case !isMuted:
+		return available
+	case r.logLines > 0:
+		return r.logLines
+	default:
+		return defaultLogLines
+	}
+}
+
+// WithFailOn sets the status codes that fail the resource right away, even if they're normally retried.
+func (r *Resource) WithFailOn(codes map[proto.StatusCode]bool) *Resource {
+	r.failOn = codes
+	return r
+}
+
+// WithRetryableErrors sets the substrings of kubectl errors that are retried instead of failing the resource.
+func (r *Resource) WithRetryableErrors(errs []string) *Resource {
+	r.retryableErrors = errs
+	return r
+}
+
+// WithSkipPaused completes the status check of paused deployments instead of failing them.
+func (r *Resource) WithSkipPaused() *Resource {
+	r.skipPaused = true
+	return r
+}
+
+// WithReadyPercent completes the rollout of deployments once the percentage of their desired replicas is updated and available.
+func (r *Resource) WithReadyPercent(percent int) *Resource {
+	r.readyPercent = percent
+	return r
+}
+
+// WithKubectlBinary runs the kubectl commands of the status check with the given kubectl binary.
+func (r *Resource) WithKubectlBinary(path string) *Resource {
+	r.kubectlBinary = path
+	return r
+}
+
+// Options are the status check settings shared by all the resources of a status check.
+type Options struct {
+	KubectlBinary   string
+	InitialDelay    time.Duration
+	Stabilization   time.Duration
+	LogLines        int
+	MaxLogLines     int
+	RetryableErrors []string
+	FailOn          map[proto.StatusCode]bool
+	SkipPaused      bool
+	ReadyPercent    int
+}
+
+// WithOptions applies the status check settings to the resource.
+func (r *Resource) WithOptions(o Options) *Resource {
+	r.WithKubectlBinary(o.KubectlBinary).WithStabilization(o.Stabilization).WithLogLines(o.LogLines).WithMaxLogLines(o.MaxLogLines).
+		WithRetryableErrors(o.RetryableErrors).WithFailOn(o.FailOn).WithReadyPercent(o.ReadyPercent)
+	if o.InitialDelay > 0 {
+		r.WithInitialDelay(o.InitialDelay)
+	}
+	if o.SkipPaused {
+		r.WithSkipPaused()
+	}
+	return r
+}
+
+// options returns the status check settings of the resource.
+func (r *Resource) options() Options {
+	return Options{
+		KubectlBinary:   r.kubectlBinary,
+		InitialDelay:    r.initialDelay,
+		Stabilization:   r.stabilization,
+		LogLines:        r.logLines,
+		MaxLogLines:     r.maxLogLines,
+		RetryableErrors: r.retryableErrors,
+		FailOn:          r.failOn,
+		SkipPaused:      r.skipPaused,
+		ReadyPercent:    r.readyPercent,
+	}
+}
+
+// stabilized records when the resource was first seen healthy and returns whether it has stayed healthy since
+// for the stabilization window.
+func (r *Resource) stabilized() bool {
+	if r.stabilization <= 0 {
+		return true
+	}
+	if r.healthySince.IsZero() {
+		r.healthySince = r.clock.Now()
+	}
+	return r.since(r.healthySince) >= r.stabilization
+}
+
+// checkDeleted gives a resource that isn't found anymore, like a completed job cleaned up by the TTL controller,
+// a grace period to show up again. After the grace period, the deletion completes the status check of a resource
+// that was healthy before it was deleted, and fails it otherwise.
+func (r *Resource) checkDeleted(ae *proto.ActionableErr) *proto.ActionableErr {
+	if ae.ErrCode != proto.StatusCode_STATUSCHECK_RESOURCE_DELETED {
+		r.deletedSince = time.Time{}
+		return ae
+	}
+	if r.deletedSince.IsZero() {
+		r.deletedSince = r.clock.Now()
+		r.healthyOnDelete = r.becameReady || !r.healthySince.IsZero()
+	}
+	switch {
+	case r.since(r.deletedSince) < deletedGracePeriod:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: fmt.Sprintf("resource not found, waiting up to %v for it to show up again", deletedGracePeriod),
+		}
+	case r.healthyOnDelete:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "resource was deleted after becoming healthy",
+		}
+	default:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_DELETED,
+			Message: "resource was deleted before becoming healthy",
+		}
+	}
+}
+
+// checkCredentials retries the check when kubectl reports that the credentials were rejected by the server. Every kubectl
+// command gets its credentials from the exec plugin or auth provider of the kube context again, so the next check runs
+// with refreshed credentials if the plugin could renew them. The resource fails once the credentials are still rejected
+// after maxCredentialRetries retries.
+func (r *Resource) checkCredentials(ctx context.Context, ae *proto.ActionableErr) *proto.ActionableErr {
+	if ae.ErrCode != proto.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED {
+		r.credentialRetries = 0
+		return ae
+	}
+	r.credentialRetries++
+	if r.credentialRetries > maxCredentialRetries {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED,
+			Message: fmt.Sprintf("kubectl credentials were still rejected after %d retries. Check that you're logged in to the cluster", maxCredentialRetries),
+		}
+	}
+	log.Entry(ctx).Debugf("retrying the status check of %s after kubectl reported expired credentials", r)
+	return ae
+}
+
 func (r *Resource) checkStandalonePodsStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
 	if len(r.resources) == 0 {
 		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING}
 	}
-	kubeCtl := kubectl.NewCLI(cfg, "")
 	var pendingPods []string
 	for _, pod := range r.resources {
 		switch pod.Status() {
 		case "Failed":
 			return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: fmt.Sprintf("pod %s failed", pod.Name())}
 		case "Running":
-			b, _ := kubeCtl.RunOut(ctx, "get", "pod", pod.Name(), "-o", `jsonpath={..status.conditions[?(@.type=="Ready")].status}`, "--namespace", pod.Namespace())
+			b, _ := r.runKubectlOut(ctx, cfg, "get", "pod", pod.Name(), "-o", `jsonpath={..status.conditions[?(@.type=="Ready")].status}`, "--namespace", pod.Namespace())
 			if ctx.Err() != nil {
 				return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
 			}
@@ -209,14 +522,253 @@ func (r *Resource) checkConfigConnectorStatus() *proto.ActionableErr {
 	return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
 }
 
+// checkLoadBalancerStatus waits for a service of type LoadBalancer to be assigned its load balancer ingress.
+func (r *Resource) checkLoadBalancerStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "service", r.name, "-o", "jsonpath={.status.loadBalancer.ingress}", "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	if ingress := strings.TrimSpace(string(b)); ingress == "" || ingress == "[]" {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SERVICE_PENDING_LB,
+			Message: "waiting for load balancer ingress",
+		}
+	}
+	return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+}
+
+// runKubectlOut runs a kubectl command bound to the status check context with strict cancellation:
+// cancelling the context terminates kubectl and its child processes instead of leaving them running in the background.
+func (r *Resource) runKubectlOut(ctx context.Context, cfg kubectl.Config, command string, arg ...string) ([]byte, error) {
+	cli := kubectl.NewCLI(cfg, "")
+	cli.Binary = r.kubectlBinary
+	cmd := cli.CommandWithStrictCancellation(ctx, command, arg...)
+	cmd.WaitDelay = kubectlWaitDelay
+	return cmd.RunOut(ctx)
+}
+
 func (r *Resource) checkRolloutStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
-	b, err := kubectl.NewCLI(cfg, "").RunOut(ctx, "rollout", "status", string(r.rType), r.name, "--namespace", r.namespace, "--watch=false")
+	if r.rType == ResourceTypes.Deployment {
+		if r.scaleDown {
+			return r.checkScaledDown(ctx, cfg)
+		}
+		ae, status := r.getDeploymentStatus(ctx, cfg)
+		if ae != nil {
+			return ae
+		}
+		if status.paused {
+			return r.pausedStatus()
+		}
+		if ae := status.observedGenerationStatus(); ae != nil {
+			return ae
+		}
+		// the replica counts don't depend on the locale of kubectl, unlike the message of its rollout status.
+		if replicasRolledOut(status.counts, r.readyPercent) {
+			return r.checkRolledOut(ctx, cfg, &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: deploymentRolloutSuccess,
+			})
+		}
+	}
+	b, err := r.runKubectlOut(ctx, cfg, "rollout", "status", string(r.rType), r.name, "--namespace", r.namespace, "--watch=false")
 	if ctx.Err() != nil {
 		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
 	}
 	details := r.cleanupStatus(string(b))
 
-	return parseKubectlRolloutError(details, r.deadline, r.tolerateFailures, err)
+	return r.checkRolledOut(ctx, cfg, parseKubectlRolloutError(details, r.deadline, r.tolerateFailures, r.retryableErrors, err))
+}
+
+// checkRolledOut checks the HPA and the service endpoints of a rolled out deployment, when required.
+func (r *Resource) checkRolledOut(ctx context.Context, cfg kubectl.Config, ae *proto.ActionableErr) *proto.ActionableErr {
+	if r.waitForHPA && ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS {
+		ae = r.checkHPAMinReplicas(ctx, cfg)
+	}
+	if r.waitForEndpoints && ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS {
+		ae = r.checkServiceEndpoints(ctx, cfg)
+	}
+	return ae
+}
+
+// checkScaledDown waits for a deployment scaled down to zero replicas, like the old version of a blue/green cutover,
+// to have no available replica left.
+func (r *Resource) checkScaledDown(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	ae, status := r.getDeploymentStatus(ctx, cfg)
+	if ae != nil {
+		return ae
+	}
+	if ae := status.observedGenerationStatus(); ae != nil {
+		return ae
+	}
+	return scaledDownStatus(status.counts)
+}
+
+// deploymentStatus is the generation, the replica counts and the paused state of a deployment,
+// queried together with a single `kubectl get`.
+type deploymentStatus struct {
+	generation, observedGeneration int64
+	counts                         []string
+	paused                         bool
+}
+
+// getDeploymentStatus queries the status of the deployment.
+func (r *Resource) getDeploymentStatus(ctx context.Context, cfg kubectl.Config) (*proto.ActionableErr, deploymentStatus) {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "deployment", r.name, "-o", generationJSONPath, "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}, deploymentStatus{}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err), deploymentStatus{}
+	}
+	return nil, parseDeploymentStatus(string(b))
+}
+
+// parseDeploymentStatus parses the output of the generationJSONPath query.
+func parseDeploymentStatus(out string) deploymentStatus {
+	var status deploymentStatus
+	// the fields are split on single spaces so that the omitted ones keep their position.
+	fields := strings.Split(strings.TrimSuffix(out, "\n"), " ")
+	if fields[0] == "" {
+		return status
+	}
+	status.generation, _ = strconv.ParseInt(fields[0], 10, 64)
+	// observedGeneration is omitted until the controller has processed the deployment.
+	if len(fields) > 1 {
+		status.observedGeneration, _ = strconv.ParseInt(fields[1], 10, 64)
+	}
+	if len(fields) > 2 {
+		status.counts = fields[2:min(len(fields), 6)]
+	}
+	status.paused = len(fields) > 6 && fields[6] == "true"
+	return status
+}
+
+// observedGenerationStatus returns a pending status until the deployment controller has observed the latest
+// generation of the deployment, so that the rollout status right after an apply isn't the one of the previous
+// replica set. It returns nil once the generation is observed.
+func (s deploymentStatus) observedGenerationStatus() *proto.ActionableErr {
+	if s.observedGeneration < s.generation {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: fmt.Sprintf("waiting for deployment spec update to be observed: generation %d, observed generation %d", s.generation, s.observedGeneration),
+		}
+	}
+	return nil
+}
+
+// replicaCounts are the desired replicas of a deployment, and its updated, total and available replicas.
+type replicaCounts struct {
+	desired, updated, total, available int64
+}
+
+// parseReplicaCounts parses the replica counts of a deployment. It returns false if they're unknown or invalid.
+func parseReplicaCounts(counts []string) (replicaCounts, bool) {
+	if len(counts) != 4 {
+		return replicaCounts{}, false
+	}
+	// spec.replicas defaults to 1 and the status counts are omitted when they're 0.
+	c := replicaCounts{desired: 1}
+	for i, n := range []*int64{&c.desired, &c.updated, &c.total, &c.available} {
+		if counts[i] == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(counts[i], 10, 64)
+		if err != nil {
+			return replicaCounts{}, false
+		}
+		*n = v
+	}
+	return c, true
+}
+
+// replicasRolledOut returns whether the replica counts of a deployment show that its rollout completed, like kubectl rollout status:
+// all the desired replicas are updated and available, and no old replica is left.
+// With a ready percentage below 100, the rollout completes once that percentage of the desired replicas is updated and available.
+func replicasRolledOut(counts []string, readyPercent int) bool {
+	c, ok := parseReplicaCounts(counts)
+	if !ok {
+		return false
+	}
+	if readyPercent <= 0 || readyPercent >= 100 {
+		return c.updated >= c.desired && c.total <= c.updated && c.available >= c.updated
+	}
+	// the available replicas can include old ones, so the old replicas are assumed available, and the available replicas
+	// only count the pods that have been ready for minReadySeconds.
+	ready := (c.desired*int64(readyPercent) + 99) / 100
+	return c.updated >= ready && c.available-(c.total-c.updated) >= ready
+}
+
+// scaledDownStatus returns the status of a deployment scaled down to zero replicas, which completes once its
+// spec is scaled to zero and none of its replicas is available anymore.
+func scaledDownStatus(counts []string) *proto.ActionableErr {
+	c, ok := parseReplicaCounts(counts)
+	switch {
+	case !ok:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: "waiting for the deployment to scale down to 0 replicas",
+		}
+	case c.desired > 0:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: fmt.Sprintf("waiting for the deployment to be scaled down to 0 replicas: %d desired", c.desired),
+		}
+	case c.available > 0:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: fmt.Sprintf("waiting for the deployment to scale down to 0 replicas: %d still available", c.available),
+		}
+	default:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "scaled down to 0 replicas",
+		}
+	}
+}
+
+// pausedStatus fails a paused deployment, or completes its status check with skipPaused,
+// as the rollout status would never complete.
+func (r *Resource) pausedStatus() *proto.ActionableErr {
+	if r.skipPaused {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "rollout is paused, skipping the status check",
+		}
+	}
+	return &proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_PAUSED,
+		Message: fmt.Sprintf("rollout is paused. Resume it with `kubectl rollout resume deployment/%s` or set `statusCheckSkipPaused` to skip paused deployments", r.name),
+	}
+}
+
+// CanRollBack returns true if the resource is a deployment or a statefulset whose rollout failed with a non-retriable error,
+// or was cancelled by fail fast after it started, so that the other failure doesn't leave it half rolled out.
+// Paused deployments can't be rolled back.
+func (r *Resource) CanRollBack() bool {
+	if r.rType != ResourceTypes.Deployment && r.rType != ResourceTypes.StatefulSet {
+		return false
+	}
+	sc := r.StatusCode()
+	if sc == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+		return r.rolloutStarted()
+	}
+	return sc != proto.StatusCode_STATUSCHECK_SUCCESS && sc != proto.StatusCode_STATUSCHECK_DEPLOYMENT_PAUSED && r.isFailure(sc)
+}
+
+// RollBack reverts the resource to its previous revision with `kubectl rollout undo`
+// and returns a new resource, with the same status check settings, to status check the rollback.
+func (r *Resource) RollBack(ctx context.Context, cfg kubectl.Config) (*Resource, error) {
+	if _, err := r.runKubectlOut(ctx, cfg, "rollout", "undo", string(r.rType), r.name, "--namespace", r.namespace); err != nil {
+		return nil, fmt.Errorf("rolling back %s: %w", r, err)
+	}
+	rb := NewResource(r.name, r.rType, r.namespace, r.deadline, r.tolerateFailures).WithClock(r.clock).WithLabels(r.labels).WithOptions(r.options())
+	rb.muteLogs = r.muteLogs
+	rb.waitForHPA = r.waitForHPA
+	rb.waitForEndpoints = r.waitForEndpoints
+	return rb, nil
 }
 
 func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
@@ -228,8 +780,47 @@ func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
 		ae = r.checkConfigConnectorStatus()
 	case ResourceTypes.CustomResource:
 		ae = r.checkCustomResourceStatus()
+	case ResourceTypes.Service:
+		ae = r.checkLoadBalancerStatus(ctx, cfg)
+	case ResourceTypes.Ingress:
+		ae = r.checkIngressStatus(ctx, cfg)
+	case ResourceTypes.ReplicaSet:
+		ae = r.checkReplicaSetStatus(ctx, cfg)
+	case ResourceTypes.PersistentVolumeClaim:
+		ae = r.checkPVCStatus(ctx, cfg)
+	case ResourceTypes.ArgoRollout:
+		ae = r.checkArgoRolloutStatus(ctx, cfg)
 	default:
-		ae = r.checkRolloutStatus(ctx, cfg)
+		if r.readiness != nil {
+			ae = r.checkReadinessStatus(ctx, cfg)
+		} else {
+			ae = r.checkRolloutStatus(ctx, cfg)
+		}
+	}
+	ae = r.checkDeleted(ae)
+	ae = r.checkCredentials(ctx, ae)
+
+	// a resource that regresses has to stay healthy for the whole stabilization window again.
+	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+		r.healthySince = time.Time{}
+	}
+
+	// don't evaluate failures, nor the resource pods, during the initial delay.
+	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS && ae.ErrCode != proto.StatusCode_STATUSCHECK_USER_CANCELLED && r.inInitialDelay() {
+		log.Entry(ctx).Debugf("ignoring status %s of %s during the initial delay: %s", ae.ErrCode, r, ae.Message)
+		r.UpdateStatus(&proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_INITIAL_DELAY,
+			Message: fmt.Sprintf("waiting for the initial delay of %v", r.initialDelay),
+		})
+		return
+	}
+
+	// keep checking the resource and its pods until it has stayed healthy for the stabilization window.
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS && !r.stabilized() {
+		ae = &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_STABILIZING,
+			Message: fmt.Sprintf("waiting for the resource to stay healthy for %v", r.stabilization),
+		}
 	}
 
 	r.UpdateStatus(ae)
@@ -238,6 +829,9 @@ func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
 	// all pod are marked as success in V2
 	// See https://github.com/GoogleCloudPlatform/cloud-code-vscode-internal/issues/5277
 	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS {
+		if r.logTailer != nil {
+			r.logTailer.stop()
+		}
 		for _, pod := range r.resources {
 			if pod.Status() == "Succeeded" {
 				continue // Skip terminated pods
@@ -260,6 +854,10 @@ func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
 			return
 		}
 		log.Entry(ctx).Debugf("pod statuses could not be fetched this time due to %s", err)
+		return
+	}
+	if r.logTailer != nil {
+		r.logTailer.sync(ctx, r.resources)
 	}
 }
 
@@ -280,18 +878,64 @@ func (r *Resource) Name() string {
 	return r.name
 }
 
+func (r *Resource) Namespace() string {
+	return r.namespace
+}
+
+func (r *Resource) Type() Type {
+	return r.rType
+}
+
+func (r *Resource) Labels() map[string]string {
+	return r.labels
+}
+
+func (r *Resource) Images() []string {
+	return r.images
+}
+
 func (r *Resource) Status() Status {
 	return r.status
 }
 
+// PodLogs returns the container logs collected for the resource pods, prefixed by pod name.
+func (r *Resource) PodLogs() []string {
+	var names []string
+	for name := range r.resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var logs []string
+	for _, name := range names {
+		for _, l := range r.resources[name].Logs() {
+			logs = append(logs, fmt.Sprintf("%s > %s", name, strings.TrimSuffix(l, "\n")))
+		}
+	}
+	return logs
+}
+
+// Pods returns the resource pods, sorted by name.
+func (r *Resource) Pods() []validator.Resource {
+	var names []string
+	for name := range r.resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pods := make([]validator.Resource, len(names))
+	for i, name := range names {
+		pods[i] = r.resources[name]
+	}
+	return pods
+}
+
 func (r *Resource) IsStatusCheckCompleteOrCancelled() bool {
 	return r.done || r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
 }
 
 func (r *Resource) StatusMessage() string {
 	for _, p := range r.resources {
-		if s := p.ActionableError(); s.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
-			return fmt.Sprintf("%s\n", s.Message)
+		if s := p.ActionableError(); s.GetErrCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return fmt.Sprintf("%s\n", s.GetMessage())
 		}
 	}
 	return r.status.String()
@@ -323,16 +967,17 @@ func (r *Resource) ReportSinceLastUpdated(isMuted bool) string {
 	for _, p := range r.resources {
 		if s := p.ActionableError().Message; s != "" {
 			result.WriteString(fmt.Sprintf("%s %s %s: %s\n", tab, tabHeader, p, s))
-			// if logs are muted, write container logs to file and last 3 lines to
-			// result.
-			out, writeTrimLines, err := withLogFile(p.Name(), &result, p.Logs(), isMuted)
+			// if there are more log lines than reported, write container logs to file
+			// and the last lines to result.
+			logLines := r.reportedLogLines(isMuted, len(p.Logs()))
+			out, writeTrimLines, err := withLogFile(p.Name(), &result, p.Logs(), logLines)
 			if err != nil {
 				log.Entry(context.TODO()).Debugf("could not create log file %v", err)
 			}
 			trimLines := []string{}
 			for i, l := range p.Logs() {
 				formattedLine := fmt.Sprintf("%s %s > %s\n", tab, tab, strings.TrimSuffix(l, "\n"))
-				if isMuted && i >= len(p.Logs())-maxLogLines {
+				if i >= len(p.Logs())-logLines {
 					trimLines = append(trimLines, formattedLine)
 				}
 				out.Write([]byte(formattedLine))
@@ -364,16 +1009,9 @@ func (r *Resource) cleanupStatus(msg string) string {
 // $kubectl logs testPod  -f
 // 2020/06/18 17:28:31 service is running
 // Killed: 9
-func parseKubectlRolloutError(details string, deadline time.Duration, tolerateFailures bool, err error) *proto.ActionableErr {
+func parseKubectlRolloutError(details string, deadline time.Duration, tolerateFailures bool, retryableErrors []string, err error) *proto.ActionableErr {
 	switch {
-	// deployment rollouts have success messages like `deployment "skaffold-foo" successfully rolled out`
-	case err == nil && strings.Contains(details, deploymentRolloutSuccess):
-		return &proto.ActionableErr{
-			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
-			Message: details,
-		}
-	// statefulset rollouts have success messages like `statefulset rolling update complete 2 pods at revision skaffold-foo`
-	case err == nil && statefulsetRolloutSuccess.MatchString(details):
+	case err == nil && isRolloutSuccess(details):
 		return &proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
 			Message: details,
@@ -383,11 +1021,37 @@ func parseKubectlRolloutError(details string, deadline time.Duration, tolerateFa
 			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 			Message: details,
 		}
+	// an interrupted kubectl can still have written the success message before it was interrupted.
+	// only complete lines are trusted, as the output can stop in the middle of a line.
+	case isInterrupted(err) && isRolloutSuccess(completeLines(details)):
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: completeLines(details),
+		}
 	case strings.Contains(err.Error(), killedErrMsg):
 		return &proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
 			Message: fmt.Sprintf("received Ctrl-C or deployments could not stabilize within %v: %s", deadline, msgKubectlKilled),
 		}
+	// the credentials cached by an exec or auth provider plugin can expire during a long status check.
+	case strings.Contains(err.Error(), unauthorizedErrMsg) || strings.Contains(err.Error(), credentialsRequiredErrMsg):
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED,
+			Message: msgKubectlAuthExpired,
+		}
+	// kubernetes has marked the rollout as failed, so there's no point in waiting for skaffold's own deadline.
+	case strings.Contains(err.Error(), progressDeadlineErrMsg):
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED,
+			Message: fmt.Sprintf("rollout %s. Increase `spec.progressDeadlineSeconds` in the manifest if the rollout needs more time", progressDeadlineErrMsg),
+		}
+	case isRetryableError(err, retryableErrors):
+		log.Entry(context.TODO()).Debugf("kubectl rollout encountered error but deployment continuing "+
+			"as it matches the configured retryable errors, err: %s", err)
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: details,
+		}
 	case tolerateFailures:
 		log.Entry(context.TODO()).Debugf("kubectl rollout encountered error but deployment continuing "+
 			"as skaffold is currently configured to tolerate failures, err: %s", err)
@@ -396,13 +1060,20 @@ func parseKubectlRolloutError(details string, deadline time.Duration, tolerateFa
 			Message: details,
 		}
 	case strings.Contains(err.Error(), clientSideThrottleErrMsg) ||
-		strings.Contains(err.Error(), couldNotFindResourceErrMsg):
+		strings.Contains(err.Error(), couldNotFindResourceErrMsg) ||
+		strings.Contains(err.Error(), noRolloutHistoryErrMsg):
 		log.Entry(context.TODO()).Debugf("kubectl rollout encountered error but deployment continuing "+
 			"as it is likely a transient error, err: %s", err)
 		return &proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 			Message: details,
 		}
+	// kubectl also reports the transient error above as `(NotFound)`, so it has to be matched first.
+	case strings.Contains(err.Error(), notFoundErrMsg):
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_DELETED,
+			Message: err.Error(),
+		}
 	case strings.Contains(err.Error(), connectionErrMsg):
 		return &proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
@@ -415,6 +1086,14 @@ func parseKubectlRolloutError(details string, deadline time.Duration, tolerateFa
 			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
 			Message: details,
 		}
+	// the output of an interrupted kubectl can be truncated, so that the markers of the errors above aren't recognized.
+	// the status is checked again instead of failing with an unknown error.
+	case isInterrupted(err):
+		log.Entry(context.TODO()).Debugf("kubectl rollout was interrupted, deployment continuing, err: %s", err)
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: completeLines(details),
+		}
 	default:
 		return &proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
@@ -423,12 +1102,62 @@ func parseKubectlRolloutError(details string, deadline time.Duration, tolerateFa
 	}
 }
 
+// isRolloutSuccess returns whether the kubectl rollout output reports a completed rollout:
+// deployment rollouts have success messages like `deployment "skaffold-foo" successfully rolled out` and
+// statefulset rollouts like `statefulset rolling update complete 2 pods at revision skaffold-foo`.
+func isRolloutSuccess(details string) bool {
+	return strings.Contains(details, deploymentRolloutSuccess) || statefulsetRolloutSuccess.MatchString(details)
+}
+
+// isInterrupted returns whether kubectl was stopped by a signal, or its output wasn't fully read after it exited.
+func isInterrupted(err error) bool {
+	return errors.Is(err, exec.ErrWaitDelay) || strings.Contains(err.Error(), signalErrMsg)
+}
+
+// completeLines returns the output up to its last newline, dropping the partial trailing line of an interrupted kubectl.
+func completeLines(details string) string {
+	if i := strings.LastIndex(details, "\n"); i >= 0 {
+		return details[:i+1]
+	}
+	return ""
+}
+
+// isRetryableError returns whether the error contains one of the retryable error substrings.
+func isRetryableError(err error, retryableErrors []string) bool {
+	for _, e := range retryableErrors {
+		if strings.Contains(err.Error(), e) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFailure returns whether the status code fails the resource, either because it isn't retriable
+// or because it's configured to fail the status check.
+func (r *Resource) isFailure(statusCode proto.StatusCode) bool {
+	if statusCode == proto.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED {
+		return r.credentialRetries > maxCredentialRetries || r.failOn[statusCode]
+	}
+	return isErrAndNotRetryAble(statusCode) || r.failOn[statusCode]
+}
+
 func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
 	return statusCode != proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR &&
 		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING &&
 		statusCode != proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING &&
 		statusCode != proto.StatusCode_STATUSCHECK_CONFIG_CONNECTOR_IN_PROGRESS &&
 		statusCode != proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_IN_PROGRESS &&
+		statusCode != proto.StatusCode_STATUSCHECK_SERVICE_PENDING_LB &&
+		statusCode != proto.StatusCode_STATUSCHECK_INGRESS_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_INITIAL_DELAY &&
+		statusCode != proto.StatusCode_STATUSCHECK_STABILIZING &&
+		statusCode != proto.StatusCode_STATUSCHECK_WAITING_FOR_DEPENDENCIES &&
+		statusCode != proto.StatusCode_STATUSCHECK_SERVICE_ENDPOINTS_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_REPLICASET_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_READINESS_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_PVC_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PAUSED &&
 		statusCode != proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE &&
 		statusCode != proto.StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE
 }
@@ -440,6 +1169,9 @@ func (r *Resource) HasEncounteredUnrecoverableError() bool {
 		if _, ok := nonRetryContainerErrors[p.ActionableError().ErrCode]; ok {
 			return true
 		}
+		if r.failOn[p.ActionableError().ErrCode] {
+			return true
+		}
 	}
 	return false
 }
@@ -456,6 +1188,10 @@ func (r *Resource) fetchPods(ctx context.Context) error {
 	r.status.changed = false
 	for _, p := range pods {
 		originalPod, found := r.resources[p.String()]
+		// keep the last retrievable logs of a failing pod whose container is already gone.
+		if found && len(p.Logs()) == 0 && p.ActionableError().ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+			p = p.WithLogs(originalPod.Logs())
+		}
 		if !found || originalPod.StatusUpdated(p) {
 			r.status.changed = true
 			prefix := fmt.Sprintf("%s %s:", tabHeader, p.String())
@@ -471,6 +1207,7 @@ func (r *Resource) fetchPods(ctx context.Context) error {
 		newResources[p.String()] = p
 	}
 	r.resources = newResources
+	r.recordTransition()
 	return nil
 }
 
@@ -486,7 +1223,7 @@ func (r *Resource) StatusCode() proto.StatusCode {
 		return r.statusCode
 	}
 	for _, p := range r.resources {
-		if s := p.ActionableError().ErrCode; s != proto.StatusCode_STATUSCHECK_SUCCESS {
+		if s := p.ActionableError().GetErrCode(); s != proto.StatusCode_STATUSCHECK_SUCCESS {
 			return s
 		}
 	}
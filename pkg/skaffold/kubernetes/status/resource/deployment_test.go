@@ -21,12 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"google.golang.org/protobuf/testing/protocmp"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag/validator"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
@@ -38,6 +40,7 @@ import (
 
 func TestDeploymentCheckStatus(t *testing.T) {
 	rolloutCmd := "kubectl --context kubecontext rollout status deployment graph --namespace test --watch=false"
+	generationCmd := "kubectl --context kubecontext get deployment graph -o " + generationJSONPath + " --namespace test"
 	tests := []struct {
 		description     string
 		commands        util.Command
@@ -48,31 +51,55 @@ func TestDeploymentCheckStatus(t *testing.T) {
 	}{
 		{
 			description: "rollout status success",
-			commands: testutil.CmdRunOut(
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(
 				rolloutCmd,
 				"deployment \"graph\" successfully rolled out",
 			),
 			expectedDetails: "successfully rolled out",
 			complete:        true,
 		},
+		{
+			description:     "replicas rolled out, whatever the locale of the rollout status",
+			commands:        testutil.CmdRunOut(generationCmd, "1 1 3 3 3 3"),
+			expectedDetails: "successfully rolled out",
+			complete:        true,
+		},
+		{
+			description: "localized rollout status with replicas not available",
+			commands: testutil.CmdRunOut(generationCmd, "1 1 3 3 3 2").AndRunOut(
+				rolloutCmd,
+				"Warten auf die Verfügbarkeit der Replikate",
+			),
+			expectedDetails: "warten auf die Verfügbarkeit der Replikate",
+		},
 		{
 			description: "resource not complete",
-			commands: testutil.CmdRunOut(
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(
 				rolloutCmd,
 				"Waiting for replicas to be available",
 			),
 			expectedDetails: "waiting for replicas to be available",
 		},
+		{
+			description:     "generation not observed yet",
+			commands:        testutil.CmdRunOut(generationCmd, "2 1"),
+			expectedDetails: "waiting for deployment spec update to be observed: generation 2, observed generation 1",
+		},
+		{
+			description:     "deployment not processed yet",
+			commands:        testutil.CmdRunOut(generationCmd, "1 "),
+			expectedDetails: "waiting for deployment spec update to be observed: generation 1, observed generation 0",
+		},
 		{
 			description: "no output",
-			commands: testutil.CmdRunOut(
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(
 				rolloutCmd,
 				"",
 			),
 		},
 		{
 			description: "rollout status error",
-			commands: testutil.CmdRunOutErr(
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOutErr(
 				rolloutCmd,
 				"",
 				errors.New("error"),
@@ -80,9 +107,28 @@ func TestDeploymentCheckStatus(t *testing.T) {
 			expectedErr: "error",
 			complete:    true,
 		},
+		{
+			description: "rollout status interrupted with partial output",
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOutErr(
+				rolloutCmd,
+				"Waiting for deployment \"graph\" rollout to finish: 1 of 2 updated replicas are available...\nWaiting for deployment \"graph\" rollout to fi",
+				errors.New("signal: terminated"),
+			),
+			expectedDetails: "waiting for rollout to finish: 1 of 2 updated replicas are available...\n",
+		},
+		{
+			description: "rollout status interrupted after the success message",
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOutErr(
+				rolloutCmd,
+				"deployment \"graph\" successfully rolled out\n",
+				exec.ErrWaitDelay,
+			),
+			expectedDetails: "successfully rolled out\n",
+			complete:        true,
+		},
 		{
 			description: "rollout kubectl client connection error",
-			commands: testutil.CmdRunOutErr(
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOutErr(
 				rolloutCmd,
 				"",
 				errors.New("Unable to connect to the server"),
@@ -91,7 +137,7 @@ func TestDeploymentCheckStatus(t *testing.T) {
 		},
 		{
 			description: "set status to cancel",
-			commands: testutil.CmdRunOutErr(
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOutErr(
 				rolloutCmd,
 				"",
 				errors.New("waiting for replicas to be available"),
@@ -126,6 +172,285 @@ func TestDeploymentCheckStatus(t *testing.T) {
 	}
 }
 
+func TestReplicasRolledOut(t *testing.T) {
+	tests := []struct {
+		description  string
+		counts       []string
+		readyPercent int
+		expected     bool
+	}{
+		{description: "all replicas updated and available", counts: []string{"3", "3", "3", "3"}, expected: true},
+		{description: "default replicas", counts: []string{"", "1", "1", "1"}, expected: true},
+		{description: "scaled to zero", counts: []string{"0", "", "", ""}, expected: true},
+		{description: "replicas not updated", counts: []string{"3", "2", "3", "3"}},
+		{description: "old replicas left", counts: []string{"3", "3", "4", "3"}},
+		{description: "replicas not available", counts: []string{"3", "3", "3", "2"}},
+		{description: "unknown counts"},
+		{description: "invalid count", counts: []string{"3", "three", "3", "3"}},
+		{description: "ready percent reached", counts: []string{"10", "8", "8", "8"}, readyPercent: 80, expected: true},
+		{description: "ready percent rounded up", counts: []string{"3", "2", "2", "2"}, readyPercent: 50, expected: true},
+		{description: "ready percent not reached", counts: []string{"10", "8", "8", "7"}, readyPercent: 80},
+		{description: "ready percent counting old replicas", counts: []string{"10", "8", "10", "8"}, readyPercent: 80},
+		{description: "ready percent with old replicas left", counts: []string{"10", "9", "10", "10"}, readyPercent: 80, expected: true},
+		{description: "full ready percent", counts: []string{"10", "9", "9", "9"}, readyPercent: 100},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, replicasRolledOut(test.counts, test.readyPercent))
+		})
+	}
+}
+
+func TestScaledDownStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		counts          []string
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+	}{
+		{
+			description:     "scaled down",
+			counts:          []string{"0", "", "", ""},
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedMessage: "scaled down to 0 replicas",
+		},
+		{
+			description:     "unavailable replicas left",
+			counts:          []string{"0", "", "1", ""},
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedMessage: "scaled down to 0 replicas",
+		},
+		{
+			description:     "replicas still available",
+			counts:          []string{"0", "3", "3", "2"},
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedMessage: "waiting for the deployment to scale down to 0 replicas: 2 still available",
+		},
+		{
+			description:     "not scaled down",
+			counts:          []string{"3", "3", "3", "3"},
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedMessage: "waiting for the deployment to be scaled down to 0 replicas: 3 desired",
+		},
+		{
+			description:     "default replicas",
+			counts:          []string{"", "", "", ""},
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedMessage: "waiting for the deployment to be scaled down to 0 replicas: 1 desired",
+		},
+		{
+			description:     "unknown counts",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedMessage: "waiting for the deployment to scale down to 0 replicas",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ae := scaledDownStatus(test.counts)
+
+			t.CheckDeepEqual(test.expectedErrCode, ae.ErrCode)
+			t.CheckDeepEqual(test.expectedMessage, ae.Message)
+		})
+	}
+}
+
+func TestCheckStatusScaleDown(t *testing.T) {
+	generationCmd := "kubectl --context kubecontext get deployment graph -o " + generationJSONPath + " --namespace test"
+	tests := []struct {
+		description     string
+		annotations     map[string]string
+		commands        util.Command
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+	}{
+		{
+			description:     "scaled down",
+			annotations:     map[string]string{ScaleDirectionAnnotation: "down"},
+			commands:        testutil.CmdRunOut(generationCmd, "2 2 0   "),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedMessage: "scaled down to 0 replicas",
+		},
+		{
+			description:     "replicas still available",
+			annotations:     map[string]string{ScaleDirectionAnnotation: "Down"},
+			commands:        testutil.CmdRunOut(generationCmd, "2 2 0 3 3 3"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedMessage: "waiting for the deployment to scale down to 0 replicas: 3 still available",
+		},
+		{
+			description:     "scale up",
+			annotations:     map[string]string{ScaleDirectionAnnotation: "up"},
+			commands:        testutil.CmdRunOut(generationCmd, "2 2 0 3 3 3"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedMessage: deploymentRolloutSuccess,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0, false).WithAnnotations(test.annotations)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.StatusCode())
+			t.CheckDeepEqual(test.expectedMessage, r.status.ae.GetMessage())
+		})
+	}
+}
+
+func TestCheckStatusPaused(t *testing.T) {
+	generationCmd := "kubectl --context kubecontext get deployment graph -o " + generationJSONPath + " --namespace test"
+	tests := []struct {
+		description     string
+		skipPaused      bool
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+	}{
+		{
+			description:     "paused rollout fails",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_PAUSED,
+			expectedMessage: "rollout is paused. Resume it with `kubectl rollout resume deployment/graph` or set `statusCheckSkipPaused` to skip paused deployments",
+		},
+		{
+			description:     "paused rollout skipped",
+			skipPaused:      true,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedMessage: "rollout is paused, skipping the status check",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(generationCmd, "2 2 1 1 1 1 true"))
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0, false)
+			if test.skipPaused {
+				r.WithSkipPaused()
+			}
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.StatusCode())
+			t.CheckDeepEqual(test.expectedMessage, r.status.ae.GetMessage())
+			t.CheckTrue(r.IsStatusCheckCompleteOrCancelled())
+			t.CheckFalse(r.CanRollBack())
+		})
+	}
+}
+
+func TestCheckStatusInitialDelay(t *testing.T) {
+	rolloutCmd := "kubectl --context kubecontext rollout status deployment graph --namespace test --watch=false"
+	generationCmd := "kubectl --context kubecontext get deployment graph -o " + generationJSONPath + " --namespace test"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		initialDelay    time.Duration
+		elapsed         time.Duration
+		expectedErrCode proto.StatusCode
+		complete        bool
+	}{
+		{
+			description:     "failure suppressed during initial delay",
+			commands:        testutil.CmdRunOut(generationCmd, "1 1").AndRunOutErr(rolloutCmd, "", errors.New("error")),
+			initialDelay:    time.Minute,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_INITIAL_DELAY,
+		},
+		{
+			description:     "success during initial delay",
+			commands:        testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, `deployment "graph" successfully rolled out`),
+			initialDelay:    time.Minute,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description:     "failure reported after initial delay",
+			commands:        testutil.CmdRunOut(generationCmd, "1 1").AndRunOutErr(rolloutCmd, "", errors.New("error")),
+			initialDelay:    time.Minute,
+			elapsed:         time.Minute,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			complete:        true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			clock := &fakeClock{now: time.Now()}
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0, false).WithClock(clock).WithInitialDelay(test.initialDelay)
+			clock.advance(test.elapsed)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.StatusCode())
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
+
+func TestCheckStatusStabilization(t *testing.T) {
+	rolloutCmd := "kubectl --context kubecontext rollout status deployment graph --namespace test --watch=false"
+	generationCmd := "kubectl --context kubecontext get deployment graph -o " + generationJSONPath + " --namespace test"
+	succeeded := `deployment "graph" successfully rolled out`
+	pending := `Waiting for deployment "graph" rollout to finish: 0 of 1 updated replicas are available...`
+	tests := []struct {
+		description     string
+		rollouts        []string
+		stabilization   time.Duration
+		expectedErrCode proto.StatusCode
+		complete        bool
+	}{
+		{
+			description:     "no stabilization window",
+			rollouts:        []string{succeeded},
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description:     "healthy during the stabilization window",
+			rollouts:        []string{succeeded, succeeded},
+			stabilization:   time.Minute,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_STABILIZING,
+		},
+		{
+			description:     "healthy for the whole stabilization window",
+			rollouts:        []string{succeeded, succeeded, succeeded},
+			stabilization:   time.Minute,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description:     "regression restarts the stabilization window",
+			rollouts:        []string{succeeded, pending, succeeded},
+			stabilization:   30 * time.Second,
+			expectedErrCode: proto.StatusCode_STATUSCHECK_STABILIZING,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			commands := testutil.CmdRunOut(generationCmd, "1 1")
+			for i, rollout := range test.rollouts {
+				if i > 0 {
+					commands = commands.AndRunOut(generationCmd, "1 1")
+				}
+				commands = commands.AndRunOut(rolloutCmd, rollout)
+			}
+			t.Override(&util.DefaultExecCommand, commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			// the rollouts are checked 30 seconds apart.
+			clock := &fakeClock{now: time.Now()}
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0, false).WithClock(clock).WithStabilization(test.stabilization)
+			for range test.rollouts {
+				r.CheckStatus(context.Background(), &statusConfig{})
+				clock.advance(30 * time.Second)
+			}
+
+			t.CheckDeepEqual(test.expectedErrCode, r.StatusCode())
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
+
 func TestStandalonePodsCheckStatus(t *testing.T) {
 	ns := "test-ns"
 	tests := []struct {
@@ -178,12 +503,57 @@ func TestStandalonePodsCheckStatus(t *testing.T) {
 	}
 }
 
+func TestServiceCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get service svc -o jsonpath={.status.loadBalancer.ingress} --namespace test"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+		complete        bool
+	}{
+		{
+			description:     "load balancer ingress provisioned",
+			commands:        testutil.CmdRunOut(getCmd, `[{"ip":"34.1.2.3"}]`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description:     "load balancer ingress pending",
+			commands:        testutil.CmdRunOut(getCmd, ""),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SERVICE_PENDING_LB,
+			expectedMessage: "waiting for load balancer ingress",
+		},
+		{
+			description:     "kubectl connection error",
+			commands:        testutil.CmdRunOutErr(getCmd, "", errors.New("Unable to connect to the server")),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+			expectedMessage: MsgKubectlConnection,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("svc", ResourceTypes.Service, "test", 0, false)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.status.ae.GetErrCode())
+			t.CheckDeepEqual(test.expectedMessage, r.status.ae.GetMessage())
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
+
 func TestParseKubectlError(t *testing.T) {
 	tests := []struct {
-		description string
-		details     string
-		err         error
-		expectedAe  *proto.ActionableErr
+		description     string
+		details         string
+		retryableErrors []string
+		err             error
+		expectedAe      *proto.ActionableErr
 	}{
 		{
 			description: "rollout status connection error",
@@ -193,6 +563,22 @@ func TestParseKubectlError(t *testing.T) {
 				Message: MsgKubectlConnection,
 			},
 		},
+		{
+			description: "rollout status unauthorized",
+			err:         errors.New("error: You must be logged in to the server (Unauthorized)"),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED,
+				Message: msgKubectlAuthExpired,
+			},
+		},
+		{
+			description: "rollout status credential plugin failure",
+			err:         errors.New("Unable to connect to the server: getting credentials: exec: executable gke-gcloud-auth-plugin failed with exit code 1"),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+				Message: MsgKubectlConnection,
+			},
+		},
 		{
 			description: "rollout status kubectl command killed",
 			err:         errors.New("signal: killed"),
@@ -201,6 +587,31 @@ func TestParseKubectlError(t *testing.T) {
 				Message: "received Ctrl-C or deployments could not stabilize within 10s: kubectl rollout status command interrupted\n",
 			},
 		},
+		{
+			description: "rollout status progress deadline exceeded",
+			err:         errors.New(`error: deployment "test" exceeded its progress deadline`),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED,
+				Message: "rollout exceeded its progress deadline. Increase `spec.progressDeadlineSeconds` in the manifest if the rollout needs more time",
+			},
+		},
+		{
+			description: "rollout status resource deleted",
+			err:         errors.New(`Error from server (NotFound): jobs.batch "migrate" not found`),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_DELETED,
+				Message: `Error from server (NotFound): jobs.batch "migrate" not found`,
+			},
+		},
+		{
+			description: "rollout status resource type not found yet",
+			details:     "Waiting for deployment test rollout to finish",
+			err:         errors.New("Error from server (NotFound): the server could not find the requested resource"),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+				Message: "Waiting for deployment test rollout to finish",
+			},
+		},
 		{
 			description: "rollout status random error",
 			err:         errors.New("deployment test not found"),
@@ -209,6 +620,32 @@ func TestParseKubectlError(t *testing.T) {
 				Message: "deployment test not found",
 			},
 		},
+		{
+			description: "rollout status of a new deployment without rollout history",
+			err:         errors.New(`error: no rollout history found for deployment "test"`),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			},
+		},
+		{
+			description:     "rollout status retryable error",
+			details:         "Waiting for deployment test rollout to finish",
+			retryableErrors: []string{"etcdserver: request timed out"},
+			err:             errors.New("Error from server: etcdserver: request timed out"),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+				Message: "Waiting for deployment test rollout to finish",
+			},
+		},
+		{
+			description:     "rollout status error not matching retryable errors",
+			retryableErrors: []string{"etcdserver: request timed out"},
+			err:             errors.New("deployment test not found"),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+				Message: "deployment test not found",
+			},
+		},
 		{
 			description: "rollout status nil error",
 			details:     "successfully rolled out",
@@ -217,10 +654,35 @@ func TestParseKubectlError(t *testing.T) {
 				Message: "successfully rolled out",
 			},
 		},
+		{
+			description: "rollout status interrupted after the success message",
+			details:     "Waiting for rollout to finish: 1 of 2 updated replicas are available...\ndeployment \"test\" successfully rolled out\n",
+			err:         fmt.Errorf("running kubectl: %w", exec.ErrWaitDelay),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "Waiting for rollout to finish: 1 of 2 updated replicas are available...\ndeployment \"test\" successfully rolled out\n",
+			},
+		},
+		{
+			description: "rollout status interrupted in the middle of a line",
+			details:     "Waiting for rollout to finish: 1 of 2 updated replicas are available...\nWaiting for rollout to fin",
+			err:         errors.New("signal: interrupt"),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+				Message: "Waiting for rollout to finish: 1 of 2 updated replicas are available...\n",
+			},
+		},
+		{
+			description: "rollout status interrupted in the middle of the error message",
+			err:         errors.New("error: deployment \"test\" exceeded its progress dead\n - cause: signal: terminated"),
+			expectedAe: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			ae := parseKubectlRolloutError(test.details, 10*time.Second, false, test.err)
+			ae := parseKubectlRolloutError(test.details, 10*time.Second, false, test.retryableErrors, test.err)
 			t.CheckDeepEqual(test.expectedAe, ae, protocmp.Transform())
 		})
 	}
@@ -246,6 +708,11 @@ func TestIsErrAndNotRetriable(t *testing.T) {
 			statusCode:  proto.StatusCode_STATUSCHECK_UNKNOWN,
 			expected:    true,
 		},
+		{
+			description: "rollout exceeded progress deadline",
+			statusCode:  proto.StatusCode_STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED,
+			expected:    true,
+		},
 		{
 			description: "rollout status parent context canceled",
 			statusCode:  proto.StatusCode_STATUSCHECK_USER_CANCELLED,
@@ -256,6 +723,14 @@ func TestIsErrAndNotRetriable(t *testing.T) {
 			statusCode:  proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED,
 			expected:    true,
 		},
+		{
+			description: "service load balancer pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_SERVICE_PENDING_LB,
+		},
+		{
+			description: "ingress pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_INGRESS_PENDING,
+		},
 		{
 			description: "unschedule error code",
 			statusCode:  proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE,
@@ -278,12 +753,200 @@ func TestIsErrAndNotRetriable(t *testing.T) {
 	}
 }
 
+func TestFailOn(t *testing.T) {
+	failOn := map[proto.StatusCode]bool{proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE: true}
+	tests := []struct {
+		description     string
+		failOn          map[proto.StatusCode]bool
+		statusCode      proto.StatusCode
+		podStatusCode   proto.StatusCode
+		expectedDone    bool
+		expectedFailure bool
+	}{
+		{
+			description:   "retried by default",
+			statusCode:    proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE,
+			podStatusCode: proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE,
+		},
+		{
+			description:     "configured to fail",
+			failOn:          failOn,
+			statusCode:      proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE,
+			podStatusCode:   proto.StatusCode_STATUSCHECK_NODE_UNSCHEDULABLE,
+			expectedDone:    true,
+			expectedFailure: true,
+		},
+		{
+			description:   "other retried code",
+			failOn:        failOn,
+			statusCode:    proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			podStatusCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description:   "non retriable code",
+			failOn:        failOn,
+			statusCode:    proto.StatusCode_STATUSCHECK_UNKNOWN,
+			podStatusCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDone:  true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			r := NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false).WithFailOn(test.failOn)
+			r.UpdateStatus(&proto.ActionableErr{ErrCode: test.statusCode})
+			t.CheckDeepEqual(test.expectedDone, r.IsStatusCheckCompleteOrCancelled())
+
+			r = NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false).WithFailOn(test.failOn).WithPodStatuses([]proto.StatusCode{test.podStatusCode})
+			t.CheckDeepEqual(test.expectedFailure, r.HasEncounteredUnrecoverableError())
+		})
+	}
+}
+
+func TestCheckDeleted(t *testing.T) {
+	deleted := &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_DELETED, Message: "not found"}
+	tests := []struct {
+		description  string
+		ae           *proto.ActionableErr
+		becameReady  bool
+		gracePeriod  time.Duration
+		expectedCode proto.StatusCode
+	}{
+		{
+			description:  "resource found",
+			ae:           &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_READINESS_PENDING},
+			gracePeriod:  time.Minute,
+			expectedCode: proto.StatusCode_STATUSCHECK_READINESS_PENDING,
+		},
+		{
+			description:  "deleted within the grace period",
+			ae:           deleted,
+			becameReady:  true,
+			gracePeriod:  time.Minute,
+			expectedCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+		},
+		{
+			description:  "deleted after becoming healthy",
+			ae:           deleted,
+			becameReady:  true,
+			expectedCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description:  "deleted before becoming healthy",
+			ae:           deleted,
+			expectedCode: proto.StatusCode_STATUSCHECK_RESOURCE_DELETED,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&deletedGracePeriod, test.gracePeriod)
+			r := NewResource("migrate", ResourceTypes.Deployment, "test", time.Second, false)
+			r.becameReady = test.becameReady
+
+			t.CheckDeepEqual(test.expectedCode, r.checkDeleted(test.ae).ErrCode)
+		})
+	}
+}
+
+func TestCheckDeletedResourceFoundAgain(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&deletedGracePeriod, time.Minute)
+		r := NewResource("migrate", ResourceTypes.Deployment, "test", time.Second, false)
+
+		r.checkDeleted(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_DELETED})
+		t.CheckFalse(r.deletedSince.IsZero())
+
+		r.checkDeleted(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS})
+		t.CheckTrue(r.deletedSince.IsZero())
+	})
+}
+
+func TestCheckCredentials(t *testing.T) {
+	expired := &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED, Message: msgKubectlAuthExpired}
+	r := NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false)
+
+	for i := 0; i < maxCredentialRetries; i++ {
+		r.UpdateStatus(r.checkCredentials(context.Background(), expired))
+		testutil.CheckDeepEqual(t, false, r.IsStatusCheckCompleteOrCancelled())
+	}
+
+	// accepted credentials reset the count.
+	r.checkCredentials(context.Background(), &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING})
+	testutil.CheckDeepEqual(t, 0, r.credentialRetries)
+
+	for i := 0; i <= maxCredentialRetries; i++ {
+		r.UpdateStatus(r.checkCredentials(context.Background(), expired))
+	}
+	testutil.CheckDeepEqual(t, true, r.IsStatusCheckCompleteOrCancelled())
+	testutil.CheckDeepEqual(t, proto.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED, r.StatusCode())
+}
+
+func TestCanRollBack(t *testing.T) {
+	tests := []struct {
+		description        string
+		rType              Type
+		previousStatusCode proto.StatusCode
+		statusCode         proto.StatusCode
+		expected           bool
+	}{
+		{
+			description: "failed deployment",
+			rType:       ResourceTypes.Deployment,
+			statusCode:  proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			expected:    true,
+		},
+		{
+			description: "statefulset exceeded its deadline",
+			rType:       ResourceTypes.StatefulSet,
+			statusCode:  proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED,
+			expected:    true,
+		},
+		{
+			description: "successful deployment",
+			rType:       ResourceTypes.Deployment,
+			statusCode:  proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "cancelled deployment",
+			rType:       ResourceTypes.Deployment,
+			statusCode:  proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+		},
+		{
+			description:        "deployment cancelled during its rollout",
+			rType:              ResourceTypes.Deployment,
+			previousStatusCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			statusCode:         proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+			expected:           true,
+		},
+		{
+			description: "pending deployment",
+			rType:       ResourceTypes.Deployment,
+			statusCode:  proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+		},
+		{
+			description: "failed standalone pods",
+			rType:       ResourceTypes.StandalonePods,
+			statusCode:  proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			r := NewResource("dep", test.rType, "test", time.Second, false)
+			if test.previousStatusCode != proto.StatusCode_OK {
+				r.UpdateStatus(&proto.ActionableErr{ErrCode: test.previousStatusCode})
+			}
+			r.UpdateStatus(&proto.ActionableErr{ErrCode: test.statusCode})
+			t.CheckDeepEqual(test.expected, r.CanRollBack())
+		})
+	}
+}
+
 func TestReportSinceLastUpdated(t *testing.T) {
 	tmpDir := filepath.Clean(os.TempDir())
 	var tests = []struct {
 		description  string
 		ae           *proto.ActionableErr
 		logs         []string
+		logLines     int
 		expected     string
 		expectedMute string
 	}{
@@ -332,6 +995,46 @@ func TestReportSinceLastUpdated(t *testing.T) {
       > [pod container] Waiting for mongodb to start...
       > [pod container] Waiting for connection for 2 sec
       > [pod container] Terminating with exit code 11
+`,
+		},
+		{
+			description: "logs more than configured lines",
+			ae:          &proto.ActionableErr{Message: "waiting for 0/1 deplotment to rollout\n"},
+			logs: []string{
+				"[pod container] Waiting for mongodb to start...",
+				"[pod container] Retrying 1st attempt ....",
+				"[pod container] Terminating with exit code 11",
+			},
+			logLines: 1,
+			expectedMute: fmt.Sprintf(` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Terminating with exit code 11
+      Full logs at %s
+`, filepath.Join(tmpDir, "skaffold", "statuscheck", "foo.log")),
+			expected: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Waiting for mongodb to start...
+      > [pod container] Retrying 1st attempt ....
+      > [pod container] Terminating with exit code 11
+`,
+		},
+		{
+			description: "logs less than configured lines",
+			ae:          &proto.ActionableErr{Message: "waiting for 0/1 deplotment to rollout\n"},
+			logs: []string{
+				"[pod container] Waiting for mongodb to start...",
+				"[pod container] Terminating with exit code 11",
+			},
+			logLines: 10,
+			expected: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Waiting for mongodb to start...
+      > [pod container] Terminating with exit code 11
+`,
+			expectedMute: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Waiting for mongodb to start...
+      > [pod container] Terminating with exit code 11
 `,
 		},
 		{
@@ -347,7 +1050,7 @@ func TestReportSinceLastUpdated(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			dep := NewResource("test", ResourceTypes.Deployment, "test-ns", 1, false)
+			dep := NewResource("test", ResourceTypes.Deployment, "test-ns", 1, false).WithLogLines(test.logLines)
 			dep.resources = map[string]validator.Resource{
 				"foo": validator.NewResource(
 					"test",
@@ -370,6 +1073,53 @@ func TestReportSinceLastUpdated(t *testing.T) {
 	}
 }
 
+func TestReportedLogLines(t *testing.T) {
+	tests := []struct {
+		description string
+		logLines    int
+		maxLogLines int
+		muted       bool
+		annotations []map[string]string
+		expected    int
+	}{
+		{description: "muted default", muted: true, expected: defaultLogLines},
+		{description: "muted configured", logLines: 10, muted: true, expected: 10},
+		{description: "muted ignores the cap", logLines: 10, maxLogLines: 5, muted: true, expected: 10},
+		{description: "not muted reports all lines", logLines: 10, expected: 200},
+		{description: "not muted is capped", logLines: 10, maxLogLines: 100, expected: 100},
+		{
+			description: "unmuted by the workload annotation",
+			muted:       true,
+			annotations: []map[string]string{{MuteLogsAnnotation: "false"}, nil},
+			expected:    200,
+		},
+		{
+			description: "muted by the pod template annotation",
+			annotations: []map[string]string{nil, {MuteLogsAnnotation: "true"}},
+			expected:    defaultLogLines,
+		},
+		{
+			description: "workload annotation takes precedence",
+			muted:       true,
+			annotations: []map[string]string{{MuteLogsAnnotation: "false"}, {MuteLogsAnnotation: "true"}},
+			expected:    200,
+		},
+		{
+			description: "invalid annotation is ignored",
+			muted:       true,
+			annotations: []map[string]string{{MuteLogsAnnotation: "never"}},
+			expected:    defaultLogLines,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			r := NewResource("test", ResourceTypes.Deployment, "test-ns", 1, false).WithLogLines(test.logLines).WithMaxLogLines(test.maxLogLines).WithAnnotations(test.annotations...)
+
+			t.CheckDeepEqual(test.expected, r.reportedLogLines(test.muted, 200))
+		})
+	}
+}
+
 func TestReportSinceLastUpdatedMultipleTimes(t *testing.T) {
 	var tests = []struct {
 		description     string
@@ -492,6 +1242,43 @@ func TestStatusCode(t *testing.T) {
 	}
 }
 
+func TestFetchPodsKeepsLastLogs(t *testing.T) {
+	crashing := func(logs []string) validator.Resource {
+		return validator.NewResource("test", "pod", "foo", "Running",
+			&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING, Message: "container foo is backing off waiting to restart"}, logs)
+	}
+	ready := validator.NewResource("test", "pod", "foo", "Running", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}, nil)
+	testEvent.InitializeState([]latest.Pipeline{{}})
+
+	r := NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false).WithValidator(&fakeDiagnose{pods: [][]validator.Resource{
+		{crashing([]string{"[foo foo] panic"})},
+		{crashing(nil)},
+		{ready},
+	}})
+
+	testutil.CheckError(t, false, r.fetchPods(context.Background()))
+	testutil.CheckError(t, false, r.fetchPods(context.Background()))
+	testutil.CheckDeepEqual(t, []string{"[foo foo] panic"}, r.Pods()[0].Logs())
+
+	testutil.CheckError(t, false, r.fetchPods(context.Background()))
+	testutil.CheckDeepEqual(t, 0, len(r.Pods()[0].Logs()))
+}
+
+// fakeDiagnose returns the next pods on every run.
+type fakeDiagnose struct {
+	pods [][]validator.Resource
+}
+
+func (f *fakeDiagnose) Run(context.Context) ([]validator.Resource, error) {
+	pods := f.pods[0]
+	f.pods = f.pods[1:]
+	return pods, nil
+}
+
+func (f *fakeDiagnose) WithLabel(string, string) diag.Diagnose { return f }
+
+func (f *fakeDiagnose) WithValidators([]validator.Validator) diag.Diagnose { return f }
+
 type statusConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 }
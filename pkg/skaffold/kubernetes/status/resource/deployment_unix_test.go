@@ -0,0 +1,77 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestCheckStatusCancelledKillsKubectl(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		// fake kubectl that records its pid and never completes.
+		tmpDir := t.NewTempDir()
+		pidFile := tmpDir.Path("pid")
+		tmpDir.Write("kubectl", fmt.Sprintf("#!/bin/sh\necho $$ > %s\nexec sleep 60\n", pidFile))
+		t.CheckNoError(os.Chmod(tmpDir.Path("kubectl"), 0755))
+		t.SetEnvs(map[string]string{"PATH": tmpDir.Root() + string(os.PathListSeparator) + os.Getenv("PATH")})
+		testEvent.InitializeState([]latest.Pipeline{{}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		r := NewResource("graph", ResourceTypes.Deployment, "test", time.Minute, false)
+		done := make(chan struct{})
+		go func() {
+			r.CheckStatus(ctx, &statusConfig{})
+			close(done)
+		}()
+
+		// cancel the status check once kubectl is running.
+		var pid int
+		err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 10*time.Second, true, func(context.Context) (bool, error) {
+			b, err := os.ReadFile(pidFile)
+			if err != nil {
+				return false, nil
+			}
+			pid, err = strconv.Atoi(strings.TrimSpace(string(b)))
+			return err == nil, nil
+		})
+		t.CheckNoError(err)
+		cancel()
+		<-done
+		t.CheckDeepEqual(proto.StatusCode_STATUSCHECK_USER_CANCELLED, r.StatusCode())
+
+		// the kubectl process was killed and doesn't linger.
+		t.CheckErrorContains("no such process", syscall.Kill(pid, 0))
+	})
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+const (
+	// podTemplateJSONPath prints the `<replicas> <pod labels>` of a deployment.
+	podTemplateJSONPath = `jsonpath={.spec.replicas} {.spec.template.metadata.labels}`
+	// serviceSelectorsJSONPath lists the `<name> <selector>` of the services in a namespace.
+	serviceSelectorsJSONPath = `jsonpath={range .items[*]}{.metadata.name} {.spec.selector}{"\n"}{end}`
+	// readyAddressesJSONPath lists the ready addresses of an Endpoints object.
+	readyAddressesJSONPath = `jsonpath={.subsets[*].addresses[*].ip}`
+)
+
+// WithServiceEndpoints makes a successful deployment rollout wait until the services selecting its pods
+// have as many ready endpoints as the deployment has replicas.
+func (r *Resource) WithServiceEndpoints() *Resource {
+	r.waitForEndpoints = r.rType == ResourceTypes.Deployment
+	return r
+}
+
+// checkServiceEndpoints returns a pending status until every service selecting the pods of the deployment
+// has a ready endpoint for each of its replicas.
+func (r *Resource) checkServiceEndpoints(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "deployment", r.name, "-o", podTemplateJSONPath, "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	replicas, podLabels := parsePodTemplate(string(b))
+
+	b, err = r.runKubectlOut(ctx, cfg, "get", "services", "-o", serviceSelectorsJSONPath, "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+
+	for _, service := range selectingServices(string(b), podLabels) {
+		b, err := r.runKubectlOut(ctx, cfg, "get", "endpoints", service, "-o", readyAddressesJSONPath, "--namespace", r.namespace)
+		if ctx.Err() != nil {
+			return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+		}
+		// the endpoints of a new service may not be created yet.
+		ready := 0
+		if err == nil {
+			ready = len(uniqueFields(string(b)))
+		}
+		if ready < replicas {
+			return &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SERVICE_ENDPOINTS_PENDING,
+				Message: fmt.Sprintf("waiting for service %s endpoints: %d of %d endpoints ready", service, ready, replicas),
+			}
+		}
+	}
+	return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+}
+
+// parsePodTemplate parses the replicas and pod labels of a deployment printed with podTemplateJSONPath.
+func parsePodTemplate(s string) (int, map[string]string) {
+	replicas, labels, _ := strings.Cut(strings.TrimSpace(s), " ")
+	// replicas defaults to 1.
+	n, err := strconv.Atoi(replicas)
+	if err != nil {
+		n = 1
+	}
+	var podLabels map[string]string
+	_ = json.Unmarshal([]byte(labels), &podLabels)
+	return n, podLabels
+}
+
+// selectingServices returns the names of the services, listed with serviceSelectorsJSONPath, whose selector matches the pod labels.
+// Services without a selector don't select any pod.
+func selectingServices(services string, podLabels map[string]string) []string {
+	var names []string
+	for _, line := range strings.Split(services, "\n") {
+		name, selector, _ := strings.Cut(strings.TrimSpace(line), " ")
+		var labels map[string]string
+		if name == "" || json.Unmarshal([]byte(selector), &labels) != nil || len(labels) == 0 {
+			continue
+		}
+		if matchesLabels(labels, podLabels) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func matchesLabels(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// uniqueFields returns the distinct whitespace separated fields of s, the addresses of endpoints exposing multiple ports being listed once per port.
+func uniqueFields(s string) map[string]bool {
+	fields := map[string]bool{}
+	for _, f := range strings.Fields(s) {
+		fields[f] = true
+	}
+	return fields
+}
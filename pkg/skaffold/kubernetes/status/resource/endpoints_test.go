@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestDeploymentCheckStatusWithServiceEndpoints(t *testing.T) {
+	rolloutCmd := "kubectl --context kubecontext rollout status deployment graph --namespace test --watch=false"
+	generationCmd := "kubectl --context kubecontext get deployment graph -o " + generationJSONPath + " --namespace test"
+	templateCmd := "kubectl --context kubecontext get deployment graph -o " + podTemplateJSONPath + " --namespace test"
+	servicesCmd := "kubectl --context kubecontext get services -o " + serviceSelectorsJSONPath + " --namespace test"
+	endpointsCmd := "kubectl --context kubecontext get endpoints graph-svc -o " + readyAddressesJSONPath + " --namespace test"
+	rolledOut := func() *testutil.FakeCmd {
+		return testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, `deployment "graph" successfully rolled out`)
+	}
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+	}{
+		{
+			description: "no service selecting the deployment pods",
+			commands: rolledOut().AndRunOut(templateCmd, `2 {"app":"graph","tier":"web"}`).
+				AndRunOut(servicesCmd, "other {\"app\":\"other\"}\nexternal \n"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "waiting for endpoints",
+			commands: rolledOut().AndRunOut(templateCmd, `2 {"app":"graph","tier":"web"}`).
+				AndRunOut(servicesCmd, "graph-svc {\"app\":\"graph\"}\n").
+				AndRunOut(endpointsCmd, "10.0.0.1"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SERVICE_ENDPOINTS_PENDING,
+			expectedMessage: "waiting for service graph-svc endpoints: 1 of 2 endpoints ready",
+		},
+		{
+			description: "endpoints not created yet",
+			commands: rolledOut().AndRunOut(templateCmd, `1 {"app":"graph"}`).
+				AndRunOut(servicesCmd, "graph-svc {\"app\":\"graph\"}\n").
+				AndRunOutErr(endpointsCmd, "", errors.New("not found")),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SERVICE_ENDPOINTS_PENDING,
+			expectedMessage: "waiting for service graph-svc endpoints: 0 of 1 endpoints ready",
+		},
+		{
+			description: "endpoints ready",
+			commands: rolledOut().AndRunOut(templateCmd, `2 {"app":"graph"}`).
+				AndRunOut(servicesCmd, "graph-svc {\"app\":\"graph\"}\n").
+				AndRunOut(endpointsCmd, "10.0.0.1 10.0.0.2 10.0.0.1"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0, false).WithServiceEndpoints()
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.StatusCode())
+			if test.expectedMessage != "" {
+				t.CheckDeepEqual(test.expectedMessage, r.Status().String())
+			}
+		})
+	}
+}
+
+func TestSelectingServices(t *testing.T) {
+	services := "web {\"app\":\"graph\"}\nall {\"tier\":\"web\",\"app\":\"graph\"}\nother {\"app\":\"other\"}\nexternal \n"
+
+	testutil.CheckDeepEqual(t, []string{"web", "all"}, selectingServices(services, map[string]string{"app": "graph", "tier": "web"}))
+	testutil.CheckDeepEqual(t, []string{"web"}, selectingServices(services, map[string]string{"app": "graph"}))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"strings"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// maxTransitions is the number of status transitions retained per resource.
+const maxTransitions = 10
+
+// Transition is a change of the status of a resource observed during the status check.
+type Transition struct {
+	Time    time.Time
+	Code    proto.StatusCode
+	Message string
+	// Err is the status of the resource after the transition.
+	Err *proto.ActionableErr
+	// Ready is true when the resource, or all of its pods, were ready.
+	Ready bool
+}
+
+// Outcome classifies how a resource that did not stabilize behaved during the status check.
+type Outcome string
+
+const (
+	// NeverReady is the outcome of resources that never became ready.
+	NeverReady Outcome = "never became ready"
+	// BecameUnhealthy is the outcome of resources that became ready and then went unhealthy.
+	BecameUnhealthy Outcome = "became ready, then went unhealthy"
+)
+
+// History returns the last status transitions of the resource, oldest first.
+func (r *Resource) History() []Transition {
+	return append([]Transition(nil), r.transitions...)
+}
+
+// Outcome classifies the resource from its status transitions.
+func (r *Resource) Outcome() Outcome {
+	if r.becameReady {
+		return BecameUnhealthy
+	}
+	return NeverReady
+}
+
+// rolloutStarted returns true if the status check observed the rollout of the resource before it was cancelled.
+func (r *Resource) rolloutStarted() bool {
+	for _, t := range r.transitions {
+		if t.Code != proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+			return true
+		}
+	}
+	return false
+}
+
+// recordTransition appends the current status of the resource to its transitions when it differs from the last one.
+func (r *Resource) recordTransition() {
+	t := Transition{
+		Time:    r.clock.Now(),
+		Code:    r.StatusCode(),
+		Message: strings.TrimSuffix(r.StatusMessage(), "\n"),
+		Err:     r.status.ActionableError(),
+		Ready:   r.isReady(),
+	}
+	if n := len(r.transitions); n > 0 {
+		last := r.transitions[n-1]
+		if last.Code == t.Code && last.Message == t.Message && last.Ready == t.Ready {
+			return
+		}
+	}
+	r.becameReady = r.becameReady || t.Ready
+	r.transitions = append(r.transitions, t)
+	if len(r.transitions) > maxTransitions {
+		r.transitions = r.transitions[len(r.transitions)-maxTransitions:]
+	}
+}
+
+// isReady returns whether the resource is stable, or all of its pods are ready.
+func (r *Resource) isReady() bool {
+	if r.statusCode == proto.StatusCode_STATUSCHECK_SUCCESS {
+		return true
+	}
+	if len(r.resources) == 0 {
+		return false
+	}
+	for _, p := range r.resources {
+		if p.ActionableError().GetErrCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return false
+		}
+	}
+	return true
+}
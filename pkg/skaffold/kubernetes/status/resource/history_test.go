@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestOutcome(t *testing.T) {
+	tests := []struct {
+		description string
+		podStatuses [][]proto.StatusCode
+		expected    Outcome
+	}{
+		{
+			description: "no pods",
+			podStatuses: [][]proto.StatusCode{nil, nil},
+			expected:    NeverReady,
+		},
+		{
+			description: "pods never ready",
+			podStatuses: [][]proto.StatusCode{{proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}, {proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}},
+			expected:    NeverReady,
+		},
+		{
+			description: "pods ready then crashing",
+			podStatuses: [][]proto.StatusCode{{proto.StatusCode_STATUSCHECK_SUCCESS}, {proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING}},
+			expected:    BecameUnhealthy,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			r := NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false)
+			for i, scs := range test.podStatuses {
+				r.WithPodStatuses(scs)
+				r.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: fmt.Sprintf("waiting %d", i)})
+			}
+
+			t.CheckDeepEqual(test.expected, r.Outcome())
+		})
+	}
+}
+
+func TestTransitionsAreBounded(t *testing.T) {
+	r := NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false)
+	r.WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_SUCCESS})
+	r.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: "ready"})
+	r.WithPodStatuses(nil)
+	for i := 0; i < 2*maxTransitions; i++ {
+		r.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: fmt.Sprintf("waiting %d", i)})
+	}
+
+	transitions := r.History()
+	testutil.CheckDeepEqual(t, maxTransitions, len(transitions))
+	testutil.CheckDeepEqual(t, fmt.Sprintf("waiting %d", 2*maxTransitions-1), transitions[len(transitions)-1].Message)
+	testutil.CheckDeepEqual(t, BecameUnhealthy, r.Outcome())
+}
+
+func TestHistory(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	r := NewResource("dep", ResourceTypes.Deployment, "test", time.Second, false).WithClock(clock)
+	testutil.CheckDeepEqual(t, 0, len(r.History()))
+
+	for i := 0; i < maxTransitions+5; i++ {
+		clock.advance(time.Second)
+		r.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: fmt.Sprintf("waiting %d", i)})
+		// unchanged statuses aren't recorded
+		r.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: fmt.Sprintf("waiting %d", i)})
+	}
+	clock.advance(time.Second)
+	r.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS})
+
+	history := r.History()
+	testutil.CheckDeepEqual(t, maxTransitions, len(history))
+	testutil.CheckDeepEqual(t, "waiting 6", history[0].Err.Message)
+	testutil.CheckDeepEqual(t, proto.StatusCode_STATUSCHECK_SUCCESS, history[maxTransitions-1].Err.ErrCode)
+	testutil.CheckDeepEqual(t, time.Unix(7, 0), history[0].Time)
+	for i := 1; i < len(history); i++ {
+		testutil.CheckDeepEqual(t, time.Second, history[i].Time.Sub(history[i-1].Time))
+	}
+}
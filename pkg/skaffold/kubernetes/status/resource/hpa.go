@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// hpaTargetsJSONPath lists the `<kind> <name> <minReplicas>` targets of the HorizontalPodAutoscalers in a namespace.
+const hpaTargetsJSONPath = `jsonpath={range .items[*]}{.spec.scaleTargetRef.kind} {.spec.scaleTargetRef.name} {.spec.minReplicas}{"\n"}{end}`
+
+// WithHPAMinReplicas makes a successful deployment rollout wait until the deployment has at least
+// the minimum number of replicas of the HorizontalPodAutoscaler targeting it.
+func (r *Resource) WithHPAMinReplicas() *Resource {
+	r.waitForHPA = r.rType == ResourceTypes.Deployment
+	return r
+}
+
+// checkHPAMinReplicas returns a pending status until the deployment has as many available replicas
+// as the minReplicas of the HorizontalPodAutoscaler targeting it, if any.
+func (r *Resource) checkHPAMinReplicas(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "hpa", "-o", hpaTargetsJSONPath, "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	minReplicas, found := hpaMinReplicas(string(b), r.name)
+	if !found {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+	}
+
+	b, err = r.runKubectlOut(ctx, cfg, "get", "deployment", r.name, "-o", "jsonpath={.status.availableReplicas}", "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	// availableReplicas is omitted when no replicas are available.
+	available, _ := strconv.Atoi(strings.TrimSpace(string(b)))
+	if available < minReplicas {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: fmt.Sprintf("waiting for autoscaler minimum replicas: %d of %d replicas available", available, minReplicas),
+		}
+	}
+	return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+}
+
+// hpaMinReplicas finds the minReplicas of the HorizontalPodAutoscaler targeting the named deployment.
+func hpaMinReplicas(targets string, name string) (int, bool) {
+	for _, line := range strings.Split(targets, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "Deployment" || fields[1] != name {
+			continue
+		}
+		// minReplicas defaults to 1.
+		if len(fields) < 3 {
+			return 1, true
+		}
+		minReplicas, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return 1, true
+		}
+		return minReplicas, true
+	}
+	return 0, false
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestDeploymentCheckStatusWithHPA(t *testing.T) {
+	rolloutCmd := "kubectl --context kubecontext rollout status deployment graph --namespace test --watch=false"
+	generationCmd := "kubectl --context kubecontext get deployment graph -o " + generationJSONPath + " --namespace test"
+	hpaCmd := "kubectl --context kubecontext get hpa -o " + hpaTargetsJSONPath + " --namespace test"
+	replicasCmd := "kubectl --context kubecontext get deployment graph -o jsonpath={.status.availableReplicas} --namespace test"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+	}{
+		{
+			description: "no autoscaler targeting the deployment",
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, `deployment "graph" successfully rolled out`).
+				AndRunOut(hpaCmd, "Deployment other 3\n"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "waiting for autoscaler minReplicas",
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, `deployment "graph" successfully rolled out`).
+				AndRunOut(hpaCmd, "Deployment graph 3\n").
+				AndRunOut(replicasCmd, "1"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedMessage: "waiting for autoscaler minimum replicas: 1 of 3 replicas available",
+		},
+		{
+			description: "autoscaler minReplicas available",
+			commands: testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, `deployment "graph" successfully rolled out`).
+				AndRunOut(hpaCmd, "Deployment graph 3\n").
+				AndRunOut(replicasCmd, "3"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description:     "rollout pending",
+			commands:        testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, "Waiting for replicas to be available"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedMessage: "waiting for replicas to be available",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0, false).WithHPAMinReplicas()
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.StatusCode())
+			if test.expectedMessage != "" {
+				t.CheckDeepEqual(test.expectedMessage, r.Status().String())
+			}
+		})
+	}
+}
+
+func TestHPAMinReplicas(t *testing.T) {
+	tests := []struct {
+		description string
+		targets     string
+		expected    int
+		found       bool
+	}{
+		{
+			description: "autoscaler found",
+			targets:     "StatefulSet graph 5\nDeployment graph 3\n",
+			expected:    3,
+			found:       true,
+		},
+		{
+			description: "minReplicas defaults to 1",
+			targets:     "Deployment graph \n",
+			expected:    1,
+			found:       true,
+		},
+		{
+			description: "no autoscaler",
+			targets:     "Deployment other 2\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			minReplicas, found := hpaMinReplicas(test.targets, "graph")
+			t.CheckDeepEqual(test.expected, minReplicas)
+			t.CheckDeepEqual(test.found, found)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+const (
+	// ingressClassAnnotation is the legacy way of setting the class of an ingress.
+	ingressClassAnnotation = "kubernetes.io/ingress.class"
+	// gceBackendsAnnotation is set by the GCE ingress controller to the health of each backend.
+	gceBackendsAnnotation = "ingress.kubernetes.io/backends"
+)
+
+// IngressReadiness returns whether an ingress is ready to serve traffic.
+type IngressReadiness func(ing *networkingv1.Ingress) bool
+
+var (
+	ingressReadinessMu sync.RWMutex
+	// ingressReadiness holds the readiness predicates of the ingress classes whose controllers
+	// don't consider an ingress ready as soon as it is assigned an address.
+	ingressReadiness = map[string]IngressReadiness{
+		"gce":          gceIngressReady,
+		"gce-internal": gceIngressReady,
+	}
+)
+
+// RegisterIngressReadiness sets the readiness predicate of the ingresses of a class.
+// Ingresses of the other classes are ready once they are assigned an address.
+func RegisterIngressReadiness(class string, ready IngressReadiness) {
+	ingressReadinessMu.Lock()
+	defer ingressReadinessMu.Unlock()
+	ingressReadiness[class] = ready
+}
+
+func ingressReadinessFor(class string) IngressReadiness {
+	ingressReadinessMu.RLock()
+	defer ingressReadinessMu.RUnlock()
+	if ready, found := ingressReadiness[class]; found {
+		return ready
+	}
+	return ingressAddressAssigned
+}
+
+// ingressClass returns the class of the ingress from its spec, or from the legacy annotation.
+func ingressClass(ing *networkingv1.Ingress) string {
+	if ing.Spec.IngressClassName != nil {
+		return *ing.Spec.IngressClassName
+	}
+	return ing.Annotations[ingressClassAnnotation]
+}
+
+// ingressAddressAssigned returns whether the ingress controller assigned an IP or hostname to the ingress.
+func ingressAddressAssigned(ing *networkingv1.Ingress) bool {
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		if lb.IP != "" || lb.Hostname != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// gceIngressReady returns whether the ingress is assigned an address and all its backends are healthy.
+// The GCE controller assigns the address before the load balancer is able to route traffic.
+func gceIngressReady(ing *networkingv1.Ingress) bool {
+	if !ingressAddressAssigned(ing) {
+		return false
+	}
+	var backends map[string]string
+	if err := json.Unmarshal([]byte(ing.Annotations[gceBackendsAnnotation]), &backends); err != nil || len(backends) == 0 {
+		return false
+	}
+	for _, health := range backends {
+		if health != "HEALTHY" {
+			return false
+		}
+	}
+	return true
+}
+
+// checkIngressStatus waits for an ingress to be ready according to the readiness predicate of its class.
+func (r *Resource) checkIngressStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "ingress", r.name, "-o", "json", "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	var ing networkingv1.Ingress
+	if err := json.Unmarshal(b, &ing); err != nil {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing ingress %s: %v", r.name, err),
+		}
+	}
+	if !ingressAddressAssigned(&ing) {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_INGRESS_PENDING,
+			Message: "waiting for ingress address",
+		}
+	}
+	if class := ingressClass(&ing); !ingressReadinessFor(class)(&ing) {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_INGRESS_PENDING,
+			Message: fmt.Sprintf("waiting for %s ingress to be ready", class),
+		}
+	}
+	return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+}
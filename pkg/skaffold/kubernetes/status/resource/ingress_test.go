@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestIngressCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get ingress web -o json --namespace test"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		readiness       map[string]IngressReadiness
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+		complete        bool
+	}{
+		{
+			description:     "address assigned",
+			commands:        testutil.CmdRunOut(getCmd, `{"status":{"loadBalancer":{"ingress":[{"ip":"34.1.2.3"}]}}}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description:     "address pending",
+			commands:        testutil.CmdRunOut(getCmd, `{"status":{"loadBalancer":{}}}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_INGRESS_PENDING,
+			expectedMessage: "waiting for ingress address",
+		},
+		{
+			description:     "gce backends unhealthy",
+			commands:        testutil.CmdRunOut(getCmd, `{"metadata":{"annotations":{"kubernetes.io/ingress.class":"gce","ingress.kubernetes.io/backends":"{\"k8s-be-1\":\"HEALTHY\",\"k8s-be-2\":\"Unknown\"}"}},"status":{"loadBalancer":{"ingress":[{"ip":"34.1.2.3"}]}}}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_INGRESS_PENDING,
+			expectedMessage: "waiting for gce ingress to be ready",
+		},
+		{
+			description:     "gce backends healthy",
+			commands:        testutil.CmdRunOut(getCmd, `{"metadata":{"annotations":{"ingress.kubernetes.io/backends":"{\"k8s-be-1\":\"HEALTHY\"}"}},"spec":{"ingressClassName":"gce"},"status":{"loadBalancer":{"ingress":[{"ip":"34.1.2.3"}]}}}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description: "registered class readiness",
+			commands:    testutil.CmdRunOut(getCmd, `{"spec":{"ingressClassName":"custom"},"status":{"loadBalancer":{"ingress":[{"hostname":"web.example.com"}]}}}`),
+			readiness: map[string]IngressReadiness{
+				"custom": func(ing *networkingv1.Ingress) bool { return ing.Annotations["ready"] == "true" },
+			},
+			expectedErrCode: proto.StatusCode_STATUSCHECK_INGRESS_PENDING,
+			expectedMessage: "waiting for custom ingress to be ready",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&ingressReadiness, map[string]IngressReadiness{"gce": gceIngressReady})
+			for class, ready := range test.readiness {
+				RegisterIngressReadiness(class, ready)
+			}
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("web", ResourceTypes.Ingress, "test", 0, false)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.status.ae.GetErrCode())
+			t.CheckDeepEqual(test.expectedMessage, r.status.ae.GetMessage())
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
@@ -25,8 +25,8 @@ import (
 )
 
 // withLogFile returns a multiwriter that writes both to a file and a buffer, with the buffer being written to the provided output buffer in case of error
-func withLogFile(container string, out io.Writer, l []string, muted bool) (io.Writer, func([]string), error) {
-	if !muted || len(l) <= maxLogLines {
+func withLogFile(container string, out io.Writer, l []string, maxLines int) (io.Writer, func([]string), error) {
+	if len(l) <= maxLines {
 		return out, func([]string) {}, nil
 	}
 	file, err := logfile.Create("statuscheck", container+".log")
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// rolloutProgress matches the progress in the rollout status messages, like
+// `2 out of 3 new replicas have been updated`, `2 of 3 updated replicas are available` or `1 of 2 replicas ready`.
+var rolloutProgress = regexp.MustCompile(`(\d+) (?:out )?of (\d+) (?:new |updated )?(?:replicas|pods|endpoints)\b`)
+
+// Progress is the progress of a rollout, parsed from its status message.
+type Progress struct {
+	// Current is the number of replicas that reached the current step of the rollout, like updated or available replicas.
+	Current int
+	// Total is the number of replicas of the rollout, or 0 if the progress is unknown.
+	Total int
+}
+
+// Percent returns the progress as a percentage, or 0 if it is unknown.
+func (p Progress) Percent() int {
+	if p.Total <= 0 {
+		return 0
+	}
+	return min(p.Current*100/p.Total, 100)
+}
+
+// parseRolloutProgress returns the progress of a rollout from its status message.
+// Messages without replica counts, like `1 old replicas are pending termination`, have an unknown progress.
+func parseRolloutProgress(msg string) Progress {
+	m := rolloutProgress.FindStringSubmatch(msg)
+	if m == nil {
+		return Progress{}
+	}
+	current, _ := strconv.Atoi(m[1])
+	total, _ := strconv.Atoi(m[2])
+	return Progress{Current: current, Total: total}
+}
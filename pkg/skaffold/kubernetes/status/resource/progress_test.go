@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestParseRolloutProgress(t *testing.T) {
+	tests := []struct {
+		description string
+		msg         string
+		expected    Progress
+		percent     int
+	}{
+		{
+			description: "deployment replicas updated",
+			msg:         "Waiting for deployment \"foo\" rollout to finish: 1 out of 4 new replicas have been updated...\n",
+			expected:    Progress{Current: 1, Total: 4},
+			percent:     25,
+		},
+		{
+			description: "deployment replicas available",
+			msg:         "waiting for rollout to finish: 2 of 3 updated replicas are available...\n",
+			expected:    Progress{Current: 2, Total: 3},
+			percent:     66,
+		},
+		{
+			description: "statefulset partitioned rollout",
+			msg:         "Waiting for partitioned roll out to finish: 3 out of 3 new pods have been updated...\n",
+			expected:    Progress{Current: 3, Total: 3},
+			percent:     100,
+		},
+		{
+			description: "replicaset replicas ready",
+			msg:         "waiting for replicas to be ready: 0 of 2 replicas ready",
+			expected:    Progress{Current: 0, Total: 2},
+		},
+		{
+			description: "old replicas pending termination",
+			msg:         "Waiting for deployment \"foo\" rollout to finish: 1 old replicas are pending termination...\n",
+		},
+		{
+			description: "no progress",
+			msg:         "deployment \"foo\" successfully rolled out",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			progress := parseRolloutProgress(test.msg)
+
+			t.CheckDeepEqual(test.expected, progress)
+			t.CheckDeepEqual(test.percent, progress.Percent())
+		})
+	}
+}
+
+func TestStatusProgress(t *testing.T) {
+	status := newStatus(&proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+		Message: "waiting for rollout to finish: 1 of 2 updated replicas are available...\n",
+	})
+
+	testutil.CheckDeepEqual(t, Progress{Current: 1, Total: 2}, status.Progress())
+}
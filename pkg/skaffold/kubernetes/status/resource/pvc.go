@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+const (
+	// waitForFirstConsumerReason is the reason of the event of a claim whose storage class binds volumes
+	// only once a pod uses the claim.
+	waitForFirstConsumerReason = "WaitForFirstConsumer"
+	// noStorageClassMessage is the message of the event of a claim without storage class, in a cluster without default storage class.
+	noStorageClassMessage = "no storage class is set"
+)
+
+// checkPVCStatus waits for a PersistentVolumeClaim to be bound to a volume.
+// It fails right away if the storage class of the claim doesn't exist, or if there's no default storage class.
+func (r *Resource) checkPVCStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "persistentvolumeclaim", r.name, "-o", "json", "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	// the claims of the volume claim templates of a StatefulSet are created along with the pods of their ordinals.
+	if err != nil && strings.Contains(err.Error(), notFoundErrMsg) {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_PVC_PENDING,
+			Message: "waiting for the claim to be created",
+		}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := json.Unmarshal(b, &pvc); err != nil {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing persistent volume claim %s: %v", r.name, err),
+		}
+	}
+	if pvc.Status.Phase == v1.ClaimBound {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+	}
+	if class := pvc.Spec.StorageClassName; class != nil && *class != "" {
+		if _, err := r.runKubectlOut(ctx, cfg, "get", "storageclass", *class, "-o", "name"); err != nil && strings.Contains(err.Error(), notFoundErrMsg) {
+			return &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND,
+				Message: fmt.Sprintf("storage class %q doesn't exist", *class),
+			}
+		}
+	}
+	event := r.lastPVCEvent(ctx, cfg)
+	switch {
+	case event == nil:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_PVC_PENDING,
+			Message: "waiting for the claim to be bound",
+		}
+	case event.Reason == waitForFirstConsumerReason:
+		// the claim is bound once the pods using it are scheduled, and these pods are checked with their workloads.
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+	case pvc.Spec.StorageClassName == nil && strings.Contains(event.Message, noStorageClassMessage):
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND,
+			Message: "the claim has no storage class and the cluster has no default StorageClass",
+		}
+	default:
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_PVC_PENDING,
+			Message: fmt.Sprintf("waiting for the claim to be bound: %s", event.Message),
+		}
+	}
+}
+
+// lastPVCEvent returns the last event of the claim, or nil if it can't be fetched.
+func (r *Resource) lastPVCEvent(ctx context.Context, cfg kubectl.Config) *v1.Event {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "events", "--field-selector", "involvedObject.kind=PersistentVolumeClaim,involvedObject.name="+r.name, "--sort-by", ".lastTimestamp", "-o", "json", "--namespace", r.namespace)
+	if err != nil {
+		return nil
+	}
+	var events v1.EventList
+	if err := json.Unmarshal(b, &events); err != nil || len(events.Items) == 0 {
+		return nil
+	}
+	return &events.Items[len(events.Items)-1]
+}
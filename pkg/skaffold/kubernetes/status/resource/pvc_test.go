@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestPVCCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get persistentvolumeclaim data -o json --namespace test"
+	storageClassCmd := "kubectl --context kubecontext get storageclass fast -o name"
+	eventsCmd := "kubectl --context kubecontext get events --field-selector involvedObject.kind=PersistentVolumeClaim,involvedObject.name=data --sort-by .lastTimestamp -o json --namespace test"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+		complete        bool
+	}{
+		{
+			description:     "bound",
+			commands:        testutil.CmdRunOut(getCmd, `{"status":{"phase":"Bound"}}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description: "pending without events",
+			commands: testutil.CmdRunOut(getCmd, `{"spec":{"storageClassName":"fast"},"status":{"phase":"Pending"}}`).
+				AndRunOut(storageClassCmd, "storageclass.storage.k8s.io/fast").
+				AndRunOut(eventsCmd, `{"items":[]}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_PVC_PENDING,
+			expectedMessage: "waiting for the claim to be bound",
+		},
+		{
+			description: "pending with provisioning event",
+			commands: testutil.CmdRunOut(getCmd, `{"status":{"phase":"Pending"}}`).
+				AndRunOut(eventsCmd, `{"items":[{"reason":"ExternalProvisioning","message":"waiting for a volume to be created"},{"reason":"Provisioning","message":"External provisioner is provisioning volume"}]}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_PVC_PENDING,
+			expectedMessage: "waiting for the claim to be bound: External provisioner is provisioning volume",
+		},
+		{
+			description: "storage class not found",
+			commands: testutil.CmdRunOut(getCmd, `{"spec":{"storageClassName":"fast"},"status":{"phase":"Pending"}}`).
+				AndRunOutErr(storageClassCmd, "", errors.New(`Error from server (NotFound): storageclasses.storage.k8s.io "fast" not found`)),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND,
+			expectedMessage: `storage class "fast" doesn't exist`,
+			complete:        true,
+		},
+		{
+			description: "no default storage class",
+			commands: testutil.CmdRunOut(getCmd, `{"status":{"phase":"Pending"}}`).
+				AndRunOut(eventsCmd, `{"items":[{"reason":"FailedBinding","message":"no persistent volumes available for this claim and no storage class is set"}]}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND,
+			expectedMessage: "the claim has no storage class and the cluster has no default StorageClass",
+			complete:        true,
+		},
+		{
+			description:     "claim not created yet",
+			commands:        testutil.CmdRunOutErr(getCmd, "", errors.New(`Error from server (NotFound): persistentvolumeclaims "data" not found`)),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_PVC_PENDING,
+			expectedMessage: "waiting for the claim to be created",
+		},
+		{
+			description: "waiting for first consumer",
+			commands: testutil.CmdRunOut(getCmd, `{"spec":{"storageClassName":"fast"},"status":{"phase":"Pending"}}`).
+				AndRunOut(storageClassCmd, "storageclass.storage.k8s.io/fast").
+				AndRunOut(eventsCmd, `{"items":[{"reason":"WaitForFirstConsumer","message":"waiting for first consumer to be created before binding"}]}`),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("data", ResourceTypes.PersistentVolumeClaim, "test", 0, false)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.status.ae.GetErrCode())
+			t.CheckDeepEqual(test.expectedMessage, r.status.ae.GetMessage())
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
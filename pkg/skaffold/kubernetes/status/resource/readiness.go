@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// readiness is a field path of a resource, and the value it has once the resource is ready.
+type readiness struct {
+	// kind is the kubectl name of the resource kind, like `persistentvolumeclaim` or `certificate.cert-manager.io`.
+	kind     string
+	jsonPath string
+	value    string
+}
+
+// WithReadiness makes the status check wait for the JSONPath expression evaluated on the resource to have the given value,
+// instead of waiting for its rollout.
+func (r *Resource) WithReadiness(kind, jsonPath, value string) *Resource {
+	r.readiness = &readiness{kind: kind, jsonPath: jsonPath, value: value}
+	return r
+}
+
+// checkReadinessStatus waits for the field path of the resource to evaluate to the expected value.
+func (r *Resource) checkReadinessStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", r.readiness.kind, r.name, "-o", "jsonpath="+r.readiness.jsonPath, "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	if actual := strings.TrimSpace(string(b)); actual != r.readiness.value {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_READINESS_PENDING,
+			Message: fmt.Sprintf("waiting for %s to be %q, got %q", r.readiness.jsonPath, r.readiness.value, actual),
+		}
+	}
+	return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestReadinessCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get job.batch migrate -o jsonpath={.status.succeeded} --namespace test"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+		complete        bool
+	}{
+		{
+			description:     "expected value",
+			commands:        testutil.CmdRunOut(getCmd, "1"),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:        true,
+		},
+		{
+			description:     "other value",
+			commands:        testutil.CmdRunOut(getCmd, ""),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_READINESS_PENDING,
+			expectedMessage: `waiting for {.status.succeeded} to be "1", got ""`,
+		},
+		{
+			description:     "kubectl connection error",
+			commands:        testutil.CmdRunOutErr(getCmd, "", errors.New("Unable to connect to the server")),
+			expectedErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+			expectedMessage: "kubectl connection error\n",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("migrate", Type("job"), "test", 0, false).WithReadiness("job.batch", "{.status.succeeded}", "1")
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.status.ae.GetErrCode())
+			t.CheckDeepEqual(test.expectedMessage, r.status.ae.GetMessage())
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
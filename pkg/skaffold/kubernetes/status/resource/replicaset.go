@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// replicasJSONPath prints the `<replicas> <readyReplicas>` of a ReplicaSet.
+const replicasJSONPath = "jsonpath={.spec.replicas} {.status.readyReplicas}"
+
+// checkReplicaSetStatus waits for all the replicas of a bare ReplicaSet to be ready.
+// There is no `kubectl rollout status` for ReplicaSets.
+func (r *Resource) checkReplicaSetStatus(ctx context.Context, cfg kubectl.Config) *proto.ActionableErr {
+	b, err := r.runKubectlOut(ctx, cfg, "get", "replicaset", r.name, "-o", replicasJSONPath, "--namespace", r.namespace)
+	if ctx.Err() != nil {
+		return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED}
+	}
+	if err != nil {
+		return parseKubectlRolloutError(string(b), r.deadline, r.tolerateFailures, r.retryableErrors, err)
+	}
+	desired, ready := replicaSetReplicas(string(b))
+	if ready < desired {
+		return &proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_REPLICASET_PENDING,
+			Message: fmt.Sprintf("waiting for replicas to be ready: %d of %d replicas ready", ready, desired),
+		}
+	}
+	return &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}
+}
+
+// replicaSetReplicas parses the desired and ready replicas of a ReplicaSet.
+func replicaSetReplicas(out string) (int, int) {
+	fields := strings.Fields(out)
+	// replicas defaults to 1.
+	desired := 1
+	if len(fields) > 0 {
+		desired, _ = strconv.Atoi(fields[0])
+	}
+	// readyReplicas is omitted when no replicas are ready.
+	var ready int
+	if len(fields) > 1 {
+		ready, _ = strconv.Atoi(fields[1])
+	}
+	return desired, ready
+}
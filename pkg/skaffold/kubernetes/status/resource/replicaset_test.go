@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestReplicaSetCheckStatus(t *testing.T) {
+	replicasCmd := "kubectl --context kubecontext get replicaset graph -o " + replicasJSONPath + " --namespace test"
+	tests := []struct {
+		description     string
+		output          string
+		expectedErrCode proto.StatusCode
+		expectedMessage string
+	}{
+		{
+			description:     "no replica ready",
+			output:          "3 ",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_REPLICASET_PENDING,
+			expectedMessage: "waiting for replicas to be ready: 0 of 3 replicas ready",
+		},
+		{
+			description:     "some replicas ready",
+			output:          "3 2",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_REPLICASET_PENDING,
+			expectedMessage: "waiting for replicas to be ready: 2 of 3 replicas ready",
+		},
+		{
+			description:     "all replicas ready",
+			output:          "3 3",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description:     "scaled to zero",
+			output:          "0 ",
+			expectedErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(replicasCmd, test.output))
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			r := NewResource("graph", ResourceTypes.ReplicaSet, "test", 0, false)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedErrCode, r.StatusCode())
+			if test.expectedMessage != "" {
+				t.CheckDeepEqual(test.expectedMessage, r.Status().String())
+			}
+		})
+	}
+}
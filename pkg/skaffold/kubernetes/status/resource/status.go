@@ -24,6 +24,7 @@ import (
 
 type Status struct {
 	ae       *proto.ActionableErr
+	progress Progress
 	changed  bool
 	reported bool
 }
@@ -39,6 +40,11 @@ func (rs Status) ActionableError() *proto.ActionableErr {
 	return rs.ae
 }
 
+// Progress returns the rollout progress parsed from the status message.
+func (rs Status) Progress() Progress {
+	return rs.progress
+}
+
 func (rs Status) String() string {
 	return rs.ae.Message
 }
@@ -49,7 +55,8 @@ func (rs Status) Equal(other Status) bool {
 
 func newStatus(ae *proto.ActionableErr) Status {
 	return Status{
-		ae:      ae,
-		changed: true,
+		ae:       ae,
+		progress: parseRolloutProgress(ae.GetMessage()),
+		changed:  true,
 	}
 }
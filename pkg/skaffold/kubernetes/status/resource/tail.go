@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag/validator"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/log/stream"
+	olog "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// podLogTailer follows the logs of the unready pods of a resource while it is being status checked.
+// A pod's logs are followed until it becomes ready, disappears, or the status check context is done.
+// If following the logs fails, for example because the pod containers haven't started yet, it is retried on the next sync.
+// A retried tail resumes after the last line printed for the pod, instead of replaying the whole pod log.
+type podLogTailer struct {
+	out   io.Writer
+	lock  sync.Mutex
+	tails map[string]*podTail
+	// last holds the timestamp of the last line printed for each pod.
+	last map[string]time.Time
+	run  func(ctx context.Context, out io.Writer, pod validator.Resource, since time.Time) error
+}
+
+type podTail struct {
+	cancel context.CancelFunc
+}
+
+func newPodLogTailer(cfg kubectl.Config, binary string, out io.Writer) *podLogTailer {
+	cli := kubectl.NewCLI(cfg, "")
+	cli.Binary = binary
+	return &podLogTailer{
+		out:   out,
+		tails: map[string]*podTail{},
+		last:  map[string]time.Time{},
+		run: func(ctx context.Context, out io.Writer, pod validator.Resource, since time.Time) error {
+			args := []string{pod.Name(), "--all-containers", "--prefix=false", "--timestamps", "--namespace", pod.Namespace()}
+			if !since.IsZero() {
+				args = append(args, "--since-time", since.Format(time.RFC3339))
+			}
+			return cli.Run(ctx, nil, out, "logs", append([]string{"-f"}, args...)...)
+		},
+	}
+}
+
+// sync starts following the logs of newly unready pods and stops following pods that are ready or gone.
+func (t *podLogTailer) sync(ctx context.Context, pods map[string]validator.Resource) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for name, tail := range t.tails {
+		if p, found := pods[name]; !found || isPodReady(p) {
+			tail.cancel()
+			delete(t.tails, name)
+		}
+	}
+	for name, p := range pods {
+		if _, found := t.tails[name]; found || isPodReady(p) || !strings.EqualFold(p.Kind(), "pod") {
+			continue
+		}
+		tailCtx, cancel := context.WithCancel(ctx)
+		tail := &podTail{cancel: cancel}
+		t.tails[name] = tail
+		since := t.last[name]
+		go func(name string, p validator.Resource) {
+			last := t.follow(tailCtx, p, since)
+			t.done(name, tail, last)
+		}(name, p)
+	}
+}
+
+// stop stops following all pod logs.
+func (t *podLogTailer) stop() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for name, tail := range t.tails {
+		tail.cancel()
+		delete(t.tails, name)
+	}
+}
+
+// done forgets a pod tail once it stopped following the logs, unless it was already replaced,
+// and records the timestamp of the last line it printed.
+func (t *podLogTailer) done(name string, tail *podTail, last time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	tail.cancel()
+	if t.tails[name] == tail {
+		delete(t.tails, name)
+	}
+	if last.After(t.last[name]) {
+		t.last[name] = last
+	}
+}
+
+// follow prints the pod log lines after since, and returns the timestamp of the last printed line.
+func (t *podLogTailer) follow(ctx context.Context, pod validator.Resource, since time.Time) time.Time {
+	olog.Entry(ctx).Debugf("following logs of unready pod %s", pod)
+	formatter := &podLogFormatter{prefix: fmt.Sprintf("%s %s %s >", tab, tab, pod), last: since}
+	tr, tw := io.Pipe()
+	go func() {
+		if err := t.run(ctx, tw, pod, since); err != nil && ctx.Err() == nil {
+			olog.Entry(ctx).Debugf("could not follow logs of pod %s: %v", pod, err)
+		}
+		tw.Close()
+	}()
+	if err := stream.StreamRequest(ctx, t.out, formatter, tr); err != nil {
+		olog.Entry(ctx).Debugf("streaming logs of pod %s: %v", pod, err)
+	}
+	tr.Close()
+	return formatter.last
+}
+
+func isPodReady(p validator.Resource) bool {
+	ae := p.ActionableError()
+	return ae == nil || ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS
+}
+
+// podLogFormatter prefixes the pod log lines interleaved in the status check output.
+// Each line is written at once, so that a LogWriter shared by all the tailers keeps lines from concurrent pods whole.
+// The timestamps added by `kubectl logs --timestamps` are stripped, and lines that aren't after the last printed
+// line are skipped, since `--since-time` only has a precision of a second.
+type podLogFormatter struct {
+	prefix string
+	last   time.Time
+}
+
+func (f *podLogFormatter) Name() string { return f.prefix }
+
+func (f *podLogFormatter) PrintLine(out io.Writer, line string) {
+	line = strings.TrimSuffix(line, "\n")
+	if ts, rest, found := strings.Cut(line, " "); found {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			if !t.After(f.last) {
+				return
+			}
+			f.last = t
+			line = rest
+		}
+	}
+	fmt.Fprintf(out, "%s %s\n", f.prefix, line)
+}
+
+// LogWriter serializes the writes of the pod log tailers of all the resources that share an output.
+type LogWriter struct {
+	lock sync.Mutex
+	out  io.Writer
+}
+
+// NewLogWriter returns a LogWriter for out. One LogWriter should be shared by all the resources writing to out.
+func NewLogWriter(out io.Writer) *LogWriter {
+	return &LogWriter{out: out}
+}
+
+func (w *LogWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.out.Write(p)
+}
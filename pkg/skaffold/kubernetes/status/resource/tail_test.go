@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag/validator"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+func TestPodLogTailer(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		out := &syncBuffer{}
+		started := make(chan string, 2)
+		stopped := make(chan string, 2)
+		tailer := &podLogTailer{
+			out:   out,
+			tails: map[string]*podTail{},
+			last:  map[string]time.Time{},
+			run: func(ctx context.Context, w io.Writer, pod validator.Resource, _ time.Time) error {
+				fmt.Fprintf(w, "log line from %s\n", pod.Name())
+				started <- pod.Name()
+				<-ctx.Done()
+				stopped <- pod.Name()
+				return nil
+			},
+		}
+		unready := validator.NewResource("test", "pod", "unready", "Pending", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}, nil)
+		ready := validator.NewResource("test", "pod", "ready", "Running", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}, nil)
+
+		tailer.sync(context.Background(), map[string]validator.Resource{unready.String(): unready, ready.String(): ready})
+		t.CheckDeepEqual("unready", <-started)
+		t.CheckDeepEqual(1, len(tailer.tails))
+
+		// the pod becomes ready: stop following its logs.
+		nowReady := validator.NewResource("test", "pod", "unready", "Running", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}, nil)
+		tailer.sync(context.Background(), map[string]validator.Resource{nowReady.String(): nowReady, ready.String(): ready})
+		t.CheckDeepEqual("unready", <-stopped)
+		t.CheckDeepEqual(0, len(tailer.tails))
+
+		tailer.stop()
+		t.CheckContains("test:pod/unready > log line from unready", out.String())
+	})
+}
+
+func TestPodLogTailerRetriesFailedTails(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		attempts := make(chan string, 2)
+		tailer := &podLogTailer{
+			out:   io.Discard,
+			tails: map[string]*podTail{},
+			last:  map[string]time.Time{},
+			run: func(ctx context.Context, w io.Writer, pod validator.Resource, _ time.Time) error {
+				attempts <- pod.Name()
+				return fmt.Errorf("container %q in pod %q is waiting to start", "app", pod.Name())
+			},
+		}
+		unready := validator.NewResource("test", "pod", "unready", "Pending", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_CREATING}, nil)
+		pods := map[string]validator.Resource{unready.String(): unready}
+
+		tailer.sync(context.Background(), pods)
+		t.CheckDeepEqual("unready", <-attempts)
+		waitForNoTails(t, tailer)
+
+		// the failed tail was forgotten: the next sync follows the pod logs again.
+		tailer.sync(context.Background(), pods)
+		t.CheckDeepEqual("unready", <-attempts)
+		tailer.stop()
+	})
+}
+
+func TestPodLogTailerResumesRetriedTails(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		out := &syncBuffer{}
+		sinces := make(chan time.Time, 2)
+		tailer := &podLogTailer{
+			out:   out,
+			tails: map[string]*podTail{},
+			last:  map[string]time.Time{},
+			run: func(ctx context.Context, w io.Writer, pod validator.Resource, since time.Time) error {
+				// kubectl replays the lines of the second of --since-time.
+				fmt.Fprintln(w, "2026-10-16T10:00:00.100000000Z first line")
+				fmt.Fprintln(w, "2026-10-16T10:00:00.200000000Z second line")
+				if !since.IsZero() {
+					fmt.Fprintln(w, "2026-10-16T10:00:00.300000000Z third line")
+				}
+				sinces <- since
+				return fmt.Errorf("container %q in pod %q terminated", "app", pod.Name())
+			},
+		}
+		unready := validator.NewResource("test", "pod", "unready", "Running", &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING}, nil)
+		pods := map[string]validator.Resource{unready.String(): unready}
+
+		tailer.sync(context.Background(), pods)
+		t.CheckTrue((<-sinces).IsZero())
+		waitForNoTails(t, tailer)
+
+		tailer.sync(context.Background(), pods)
+		t.CheckDeepEqual(time.Date(2026, 10, 16, 10, 0, 0, 200000000, time.UTC), <-sinces)
+		waitForNoTails(t, tailer)
+
+		t.CheckDeepEqual(1, strings.Count(out.String(), "first line"))
+		t.CheckDeepEqual(1, strings.Count(out.String(), "second line"))
+		t.CheckContains("test:pod/unready > third line", out.String())
+	})
+}
+
+func TestPodLogTailersShareLogWriter(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		out := &bytes.Buffer{}
+		logs := NewLogWriter(out)
+		var wg sync.WaitGroup
+		for _, name := range []string{"first", "second"} {
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				formatter := &podLogFormatter{prefix: name + " >"}
+				for i := 0; i < 100; i++ {
+					formatter.PrintLine(logs, "log line\n")
+				}
+			}(name)
+		}
+		wg.Wait()
+
+		for _, line := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+			t.CheckTrue(line == "first > log line" || line == "second > log line")
+		}
+	})
+}
+
+func waitForNoTails(t *testutil.T, tailer *podLogTailer) {
+	err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		tailer.lock.Lock()
+		defer tailer.lock.Unlock()
+		return len(tailer.tails) == 0, nil
+	})
+	t.CheckNoError(err)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+	timeutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/time"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// rollBack reverts the deployments and statefulsets whose rollout failed with a non-retriable error
+// to their previous revision, and waits for the rollbacks to stabilize.
+// It returns the number of resources that were rolled back.
+func (s *monitor) rollBack(ctx context.Context, out io.Writer, resources []*resource.Resource) (int, error) {
+	var rollbacks []*resource.Resource
+	var errs []error
+	for _, r := range resources {
+		if !r.CanRollBack() {
+			continue
+		}
+		if len(rollbacks) == 0 && len(errs) == 0 {
+			output.Yellow.Fprintln(out, "Rolling back failed deployments...")
+		}
+		rb, err := r.RollBack(ctx, s.cfg)
+		if err != nil {
+			fmt.Fprintf(out, "%s %s rollback failed. Error: %v.\n", tabHeader, r, err)
+			errs = append(errs, err)
+			continue
+		}
+		rollbacks = append(rollbacks, rb)
+	}
+	if len(rollbacks) == 0 {
+		return 0, errors.Join(errs...)
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, rb := range rollbacks {
+		wg.Add(1)
+		go func(r *resource.Resource) {
+			defer wg.Done()
+			resourceStatusCheckStarted(r)
+			pollResourceStatus(ctx, s.cfg, r)
+			resourceStatusCheckEnded(r)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
+				fmt.Fprintf(out, "%s %s rollback failed. Error: %s.\n", tabHeader, r, trimNewLine(r.StatusMessage()))
+				errs = append(errs, fmt.Errorf("%s did not stabilize after the rollback", r))
+				return
+			}
+			fmt.Fprintf(out, "%s %s rolled back.\n", tabHeader, r)
+		}(rb)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return len(rollbacks), errors.Join(errs...)
+	}
+	output.Default.Fprintln(out, "Rollback stabilized in", timeutil.Humanize(time.Since(start)))
+	return len(rollbacks), nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/v2/testutil/event"
+)
+
+func TestRollBack(t *testing.T) {
+	undoCmd := "kubectl --context kubecontext rollout undo deployment dep --namespace test"
+	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
+	generationCmd := "kubectl --context kubecontext get deployment dep -o jsonpath={.metadata.generation} {.status.observedGeneration} {.spec.replicas} {.status.updatedReplicas} {.status.replicas} {.status.availableReplicas} {.spec.paused} --namespace test"
+	tests := []struct {
+		description        string
+		previousStatusCode proto.StatusCode
+		statusCode         proto.StatusCode
+		command            util.Command
+		expectedRolledBack int
+		shouldErr          bool
+		expectedOutput     []string
+	}{
+		{
+			description:        "failed deployment is rolled back",
+			statusCode:         proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			command:            testutil.CmdRunOut(undoCmd, "deployment.apps/dep rolled back").AndRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, "successfully rolled out"),
+			expectedRolledBack: 1,
+			expectedOutput:     []string{"Rolling back failed deployments...", " - test:deployment/dep rolled back.", "Rollback stabilized in"},
+		},
+		{
+			description:    "deployment without a previous revision",
+			statusCode:     proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			command:        testutil.CmdRunOutErr(undoCmd, "", errors.New("no rollout history found")),
+			shouldErr:      true,
+			expectedOutput: []string{"Rolling back failed deployments...", " - test:deployment/dep rollback failed. Error: rolling back test:deployment/dep"},
+		},
+		{
+			description:        "rollback does not stabilize",
+			statusCode:         proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			command:            testutil.CmdRunOut(undoCmd, "deployment.apps/dep rolled back").AndRunOut(generationCmd, "1 1").AndRunOutErr(rolloutCmd, "", errors.New("error: deployment \"dep\" exceeded its progress deadline")),
+			expectedRolledBack: 1,
+			shouldErr:          true,
+			expectedOutput:     []string{"Rolling back failed deployments...", " - test:deployment/dep rollback failed. Error:"},
+		},
+		{
+			description: "cancelled deployment is not rolled back",
+			statusCode:  proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+			command:     testutil.CmdRunOut("unexpected", ""),
+		},
+		{
+			description:        "deployment cancelled during its rollout is rolled back",
+			previousStatusCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			statusCode:         proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+			command:            testutil.CmdRunOut(undoCmd, "deployment.apps/dep rolled back").AndRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, "successfully rolled out"),
+			expectedRolledBack: 1,
+			expectedOutput:     []string{"Rolling back failed deployments...", " - test:deployment/dep rolled back.", "Rollback stabilized in"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.command)
+			t.Override(&defaultPollPeriodInMilliseconds, 10)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+			r := resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second, false)
+			if test.previousStatusCode != proto.StatusCode_OK {
+				r.UpdateStatus(&proto.ActionableErr{ErrCode: test.previousStatusCode})
+			}
+			r.UpdateStatus(&proto.ActionableErr{ErrCode: test.statusCode})
+			s := &monitor{cfg: &statusConfig{}}
+
+			var out bytes.Buffer
+			rolledBack, err := s.rollBack(context.Background(), &out, []*resource.Resource{r})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedRolledBack, rolledBack)
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			if len(test.expectedOutput) == 0 {
+				t.CheckDeepEqual("", out.String())
+				return
+			}
+			t.CheckDeepEqual(len(test.expectedOutput), len(lines))
+			for i, expected := range test.expectedOutput {
+				t.CheckTrue(strings.HasPrefix(lines[i], expected))
+			}
+		})
+	}
+}
+
+func TestRollBackWithKubectlBinary(t *testing.T) {
+	kubectl := "/opt/kubectl-1.30 --context kubecontext"
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(kubectl+" rollout undo deployment dep --namespace test", "deployment.apps/dep rolled back").
+			AndRunOut(kubectl+" get deployment dep -o jsonpath={.metadata.generation} {.status.observedGeneration} {.spec.replicas} {.status.updatedReplicas} {.status.replicas} {.status.availableReplicas} {.spec.paused} --namespace test", "1 1").
+			AndRunOut(kubectl+" rollout status deployment dep --namespace test --watch=false", "successfully rolled out"))
+		t.Override(&defaultPollPeriodInMilliseconds, 10)
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		r := resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second, false).WithKubectlBinary("/opt/kubectl-1.30")
+		r.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED})
+		s := &monitor{cfg: &statusConfig{}}
+
+		rolledBack, err := s.rollBack(context.Background(), io.Discard, []*resource.Resource{r})
+
+		t.CheckErrorAndDeepEqual(false, err, 1, rolledBack)
+	})
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// slowestResources is the number of resources listed in the summary of the slowest resources to become ready.
+const slowestResources = 3
+
+// readyDuration is the time a resource took to become ready.
+type readyDuration struct {
+	resource string
+	elapsed  time.Duration
+}
+
+// readyDurations returns the time each ready resource took to become ready since the status check started,
+// from the time of its last status transition.
+func readyDurations(resources []*resource.Resource, start time.Time) []readyDuration {
+	var durations []readyDuration
+	for _, r := range resources {
+		transitions := r.History()
+		if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS || len(transitions) == 0 {
+			continue
+		}
+		durations = append(durations, readyDuration{resource: r.String(), elapsed: transitions[len(transitions)-1].Time.Sub(start)})
+	}
+	return durations
+}
+
+// printSlowestResources lists the resources that took the longest to become ready, slowest first,
+// when there's more than one to compare.
+func printSlowestResources(out io.Writer, durations []readyDuration) {
+	if len(durations) < 2 {
+		return
+	}
+	sort.SliceStable(durations, func(i, j int) bool {
+		return durations[i].elapsed > durations[j].elapsed
+	})
+	if len(durations) > slowestResources {
+		durations = durations[:slowestResources]
+	}
+	fmt.Fprintln(out, "Slowest resources to become ready:")
+	for _, d := range durations {
+		fmt.Fprintf(out, "%s %s: %v\n", tabHeader, d.resource, d.elapsed.Round(100*time.Millisecond))
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestReadyDurations(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		start := time.Now()
+		ready := withStatus(resource.NewResource("ready", resource.ResourceTypes.Deployment, "test", time.Second, false),
+			&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS})
+		failed := withStatus(resource.NewResource("failed", resource.ResourceTypes.Deployment, "test", time.Second, false),
+			&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR})
+
+		durations := readyDurations([]*resource.Resource{ready, failed}, start)
+
+		t.CheckDeepEqual(1, len(durations))
+		t.CheckDeepEqual("test:deployment/ready", durations[0].resource)
+		t.CheckTrue(durations[0].elapsed >= 0)
+	})
+}
+
+func TestPrintSlowestResources(t *testing.T) {
+	tests := []struct {
+		description string
+		durations   []readyDuration
+		expected    string
+	}{
+		{
+			description: "no resources",
+		},
+		{
+			description: "single resource",
+			durations:   []readyDuration{{resource: "deployment/web", elapsed: 5 * time.Second}},
+		},
+		{
+			description: "slowest first",
+			durations: []readyDuration{
+				{resource: "deployment/web", elapsed: 5 * time.Second},
+				{resource: "statefulset/db", elapsed: 42*time.Second + 340*time.Millisecond},
+				{resource: "service/lb", elapsed: 0},
+				{resource: "deployment/api", elapsed: 12 * time.Second},
+			},
+			expected: `Slowest resources to become ready:
+ - statefulset/db: 42.3s
+ - deployment/api: 12s
+ - deployment/web: 5s
+`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var out bytes.Buffer
+			printSlowestResources(&out, test.durations)
+
+			t.CheckDeepEqual(test.expected, out.String())
+		})
+	}
+}
@@ -18,17 +18,27 @@ package status
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os/exec"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/singleflight"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag/validator"
@@ -41,10 +51,12 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/status"
 	timeutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/time"
 	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
@@ -57,13 +69,29 @@ var (
 	// Poll period for checking set to 1 second
 	defaultPollPeriodInMilliseconds = 1000
 
+	// with adaptive polling, the poll period doubles every adaptivePollBackoffTicks consecutive polls
+	// without a resource status change, up to maxAdaptivePollFactor times the configured poll period.
+	adaptivePollBackoffTicks = 3
+	maxAdaptivePollFactor    = 8
+
 	// report resource status for pending resources 5 seconds.
 	reportStatusTime = 5 * time.Second
+
+	// for testing
+	lookPath = exec.LookPath
 )
 
 const (
 	tabHeader             = " -"
 	kubernetesMaxDeadline = 600
+
+	// LoadBalancerAnnotation opts a service of type LoadBalancer into the status check, which then
+	// waits for the service load balancer ingress to be provisioned.
+	LoadBalancerAnnotation = "skaffold.dev/status-check-load-balancer"
+
+	// IngressAnnotation opts an ingress into the status check, which then waits for the ingress
+	// to be ready according to its ingress class, by default until it is assigned an address.
+	IngressAnnotation = "skaffold.dev/status-check-ingress"
 )
 
 type counter struct {
@@ -77,51 +105,147 @@ type Config interface {
 	kubectl.Config
 
 	StatusCheckDeadlineSeconds() int
+	StatusCheckInitialDelaySeconds() int
+	StatusCheckStabilizationSeconds() int
 	FastFailStatusCheck() bool
 	StatusCheckTolerateFailures() bool
 	Muted() config.Muted
 	StatusCheck() *bool
 	StatusCheckCRDsFile() string
+	StatusCheckTail() bool
+	StatusCheckQuiet() bool
+	StatusCheckAllNamespaces() bool
+	StatusCheckPollInterval() time.Duration
+	StatusCheckAdaptivePoll() bool
+	StatusCheckWaitForHPA() bool
+	StatusCheckWaitForEndpoints() bool
+	StatusCheckLogLines() int
+	StatusCheckMaxLogLines() int
+	StatusCheckKubectl() string
+	WatchResources() bool
+	StatusCheckJUnitOutput() string
+	RollbackOnFailure() bool
+	StatusCheckExclude() []latest.StatusCheckExclude
+	StatusCheckSuggestions() []latest.StatusCheckSuggestion
+	StatusCheckRetryableErrors() []string
+	StatusCheckFailOn() []string
+	StatusCheckSkipPaused() bool
+	StatusCheckReadyPercent() int
+	StatusCheckWebhook() string
+	StatusCheckLogPrefix() string
+	StatusCheckReadiness() []latest.StatusCheckReadiness
 }
 
 // Monitor runs status checks for selected resources
 type Monitor interface {
 	status.Monitor
 	RegisterDeployManifests(manifest.ManifestList)
+	// WaitForAllResources waits until all tracked resources complete their status check or the context is done.
+	WaitForAllResources(context.Context) error
 }
 
 type monitor struct {
 	cfg              Config
 	labeller         *label.DefaultLabeller
 	deadlineSeconds  int
+	initialDelay     time.Duration
+	stabilization    time.Duration
 	muteLogs         bool
+	tailLogs         bool
+	quiet            bool
+	waitForHPA       bool
+	waitForEndpoints bool
+	logLines         int
+	maxLogLines      int
+	kubectlBinary    string
+	// kubectlChecked records that the kubectl binary was validated and its version reported.
+	kubectlChecked   bool
+	watchResources   bool
+	junitOutput      string
+	rollback         bool
 	failFast         bool
 	tolerateFailures bool
 	seenResources    resource.Group
 	singleRun        singleflight.Group
 	namespaces       *[]string
+	allNamespaces    bool
 	kubeContext      string
 	manifests        manifest.ManifestList
 	crSelectors      []manifest.GroupKindSelector
+	exclude          []latest.StatusCheckExclude
+	retryableErrors  []string
+	failOn           map[proto.StatusCode]bool
+	skipPaused       bool
+	readyPercent     int
+	readiness        []latest.StatusCheckReadiness
+	// logPrefix is the template of the prefix of the log lines reported for failing pods, if configured.
+	logPrefix string
+	// webhook is notified of the resources completing or failing their status check, if configured.
+	webhook *webhook
+	// fromManifests selects the resources defined in the manifests rather than the resources labelled with the run id.
+	fromManifests bool
+	// changedImages restricts the next check to the resources using these images, if not nil.
+	changedImages map[string]bool
+	// builtImages maps the images built by Skaffold to the digest their containers are expected to run.
+	builtImages map[string]string
+	// reporter reports the status check updates of the resources.
+	reporter Reporter
 }
 
 // NewStatusMonitor returns a status monitor which runs checks on selected resource rollouts.
 // Currently implemented for deployments and statefulsets.
-func NewStatusMonitor(cfg Config, labeller *label.DefaultLabeller, namespaces *[]string, selectors []manifest.GroupKindSelector) Monitor {
-	return &monitor{
+func NewStatusMonitor(cfg Config, labeller *label.DefaultLabeller, namespaces *[]string, selectors []manifest.GroupKindSelector, opts ...MonitorOption) Monitor {
+	m := &monitor{
 		muteLogs:         cfg.Muted().MuteStatusCheck(),
+		tailLogs:         cfg.StatusCheckTail(),
+		quiet:            cfg.StatusCheckQuiet(),
+		waitForHPA:       cfg.StatusCheckWaitForHPA(),
+		waitForEndpoints: cfg.StatusCheckWaitForEndpoints(),
+		logLines:         cfg.StatusCheckLogLines(),
+		maxLogLines:      cfg.StatusCheckMaxLogLines(),
+		kubectlBinary:    cfg.StatusCheckKubectl(),
+		watchResources:   cfg.WatchResources(),
+		junitOutput:      cfg.StatusCheckJUnitOutput(),
+		rollback:         cfg.RollbackOnFailure(),
 		cfg:              cfg,
 		labeller:         labeller,
 		deadlineSeconds:  cfg.StatusCheckDeadlineSeconds(),
+		initialDelay:     time.Duration(cfg.StatusCheckInitialDelaySeconds()) * time.Second,
+		stabilization:    time.Duration(cfg.StatusCheckStabilizationSeconds()) * time.Second,
 		seenResources:    make(resource.Group),
 		singleRun:        singleflight.Group{},
 		namespaces:       namespaces,
+		allNamespaces:    cfg.StatusCheckAllNamespaces(),
 		kubeContext:      cfg.GetKubeContext(),
 		manifests:        make(manifest.ManifestList, 0),
 		failFast:         cfg.FastFailStatusCheck(),
 		tolerateFailures: cfg.StatusCheckTolerateFailures(),
 		crSelectors:      selectors,
+		exclude:          cfg.StatusCheckExclude(),
+		retryableErrors:  cfg.StatusCheckRetryableErrors(),
+		failOn:           statusCodes(cfg.StatusCheckFailOn()),
+		skipPaused:       cfg.StatusCheckSkipPaused(),
+		readyPercent:     cfg.StatusCheckReadyPercent(),
+		readiness:        cfg.StatusCheckReadiness(),
+		logPrefix:        cfg.StatusCheckLogPrefix(),
+		reporter:         terminalReporter{},
+	}
+	if url := cfg.StatusCheckWebhook(); url != "" {
+		m.webhook = newWebhook(url)
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
+}
+
+// NewManifestStatusMonitor returns a status monitor which checks the resources defined in the given manifests,
+// whether or not they were deployed by Skaffold.
+func NewManifestStatusMonitor(cfg Config, labeller *label.DefaultLabeller, namespaces *[]string, selectors []manifest.GroupKindSelector, manifests manifest.ManifestList, opts ...MonitorOption) Monitor {
+	m := NewStatusMonitor(cfg, labeller, namespaces, selectors, opts...).(*monitor)
+	m.RegisterDeployManifests(manifests)
+	m.fromManifests = true
+	return m
 }
 
 func (s *monitor) RegisterDeployManifests(manifests manifest.ManifestList) {
@@ -154,7 +278,9 @@ func (s *monitor) check(ctx context.Context, out io.Writer) error {
 
 	errCode, err := s.statusCheck(ctx, out)
 	event.StatusCheckEventEnded(errCode, err)
+	traceStatusCheckEnded(ctx, errCode, err)
 	if err != nil {
+		err = withStatusCode(ctx, errCode, err)
 		eventV2.TaskFailed(constants.StatusCheck, err)
 		return err
 	}
@@ -166,122 +292,511 @@ func (s *monitor) check(ctx context.Context, out io.Writer) error {
 
 func (s *monitor) Reset() {
 	s.seenResources.Reset()
+	s.changedImages = nil
+	s.builtImages = nil
+}
+
+// SetChangedImages restricts the next check to the resources using one of the images.
+func (s *monitor) SetChangedImages(images []string) {
+	s.changedImages = make(map[string]bool, len(images))
+	for _, image := range images {
+		s.changedImages[image] = true
+	}
+}
+
+// SetBuiltImages sets the images built by Skaffold, which the pods deployed by the current run are checked to run once ready.
+func (s *monitor) SetBuiltImages(images []string) {
+	s.builtImages = make(map[string]string, len(images))
+	for _, image := range images {
+		if digest := builtDigest(image); digest != "" {
+			s.builtImages[image] = digest
+		}
+	}
+}
+
+// usesChangedImage returns whether the resource uses one of the changed images, or true if no image changes were set.
+func (s *monitor) usesChangedImage(r *resource.Resource) bool {
+	if s.changedImages == nil {
+		return true
+	}
+	for _, image := range r.Images() {
+		if s.changedImages[image] {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceOptions returns the status check settings applied to every resource.
+func (s *monitor) resourceOptions() resource.Options {
+	return resource.Options{
+		KubectlBinary:   s.kubectlBinary,
+		InitialDelay:    s.initialDelay,
+		Stabilization:   s.stabilization,
+		LogLines:        s.logLines,
+		MaxLogLines:     s.maxLogLines,
+		RetryableErrors: s.retryableErrors,
+		FailOn:          s.failOn,
+		SkipPaused:      s.skipPaused,
+		ReadyPercent:    s.readyPercent,
+	}
 }
 
 func (s *monitor) statusCheck(ctx context.Context, out io.Writer) (proto.StatusCode, error) {
+	start := time.Now()
+	if err := s.checkKubectlBinary(ctx, out); err != nil {
+		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, err
+	}
+	resources, errCode, err := s.collectResources(ctx, out)
+	if err != nil {
+		return errCode, err
+	}
+
+	// the tree is drawn below the other status check output, and replaces the terminal rendering of the updates.
+	var tree *statusTree
+	if s.watchResources && !s.quiet && s.reportsToTerminal() {
+		if t, isTerm := newStatusTree(out); isTerm {
+			tree = t
+			out = tree
+		}
+	}
+
+	opts := s.resourceOptions()
+	for _, r := range resources {
+		r.WithOptions(opts)
+	}
+	if s.tailLogs {
+		logs := resource.NewLogWriter(out)
+		for _, r := range resources {
+			r.WithLogTailing(s.cfg, logs)
+		}
+	}
+
+	var wg sync.WaitGroup
+	c := newCounter(len(resources))
+	deps := newDependencies(ctx, resources)
+
+	checkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var exitStatusOnce sync.Once
+	var exitStatus proto.StatusCode
+
+	for _, d := range resources {
+		// the span of a resource starts once it's enumerated, and ends once its status check completed or was cancelled.
+		traceCtx, endTrace := instrumentation.StartTrace(ctx, "statusCheck_WaitForResourceToStabilize", map[string]string{
+			"ResourceKind": string(d.Type()),
+			"ResourceName": instrumentation.PII(d.Name()),
+			"Namespace":    instrumentation.PII(d.Namespace()),
+		})
+		wg.Add(1)
+		go func(r *resource.Resource) {
+			defer wg.Done()
+			defer deps.complete(r)
+			deps.wait(checkCtx, r)
+			// keep updating the resource status until it fails/succeeds/times out/cancelled.
+			pollResourceStatus(checkCtx, s.cfg, r)
+			resourceStatusCheckEnded(r)
+			traceStatusCheckEnded(traceCtx, r.StatusCode(), r.Status().Error())
+			endTrace()
+			if s.webhook != nil {
+				s.webhook.notify(ctx, r)
+			}
+			rcCopy, failed := c.markProcessed(checkCtx, r.StatusCode())
+			s.printStatusCheckSummary(out, r, rcCopy)
+			// if a resource fails and fast fail enabled, cancel status checks
+			// for all resources to fail fast and capture the first failed exit code.
+			// otherwise wait for all resources to report status once before failing
+			if failed && s.failFast {
+				exitStatusOnce.Do(func() {
+					exitStatus = r.StatusCode()
+				})
+				cancel()
+			}
+		}(d)
+	}
+
+	// Retrieve pending resource statuses
+	go func() {
+		if s.quiet {
+			// the latest status of a failing resource is reported with its failure.
+			return
+		}
+		if tree != nil {
+			s.printResourceTree(checkCtx, tree, resources)
+			return
+		}
+		s.printResourceStatus(checkCtx, out, resources)
+	}()
+
+	// Wait for all deployment statuses to be fetched
+	wg.Wait()
+	if s.webhook != nil {
+		s.webhook.wait()
+	}
+	if tree != nil {
+		tree.draw(resourceTree(resources))
+		tree.stop()
+	}
+	if !s.quiet {
+		printSlowestResources(out, readyDurations(resources, start))
+	}
+	if timedOut(resources) {
+		printTimeoutReport(out, resources)
+	}
+	if s.junitOutput != "" {
+		if err := writeJUnitReport(s.cfg, s.junitOutput, resources, time.Since(start)); err != nil {
+			log.Entry(ctx).Warnf("could not write status check junit report: %v", err)
+		}
+	}
+	// without fail fast, report the failure shared by most resources.
+	if exitStatus == 0 {
+		exitStatus = dominantStatusCode(resources)
+	}
+	errCode, err = getSkaffoldDeployStatus(ctx, c, exitStatus)
+	if err == nil {
+		s.warnStaleImages(ctx, out)
+	}
+	if err == nil && s.quiet {
+		output.Default.Fprintf(out, "%s %d resource(s) ready.\n", tabHeader, len(resources))
+	}
+	if err != nil && s.rollback && ctx.Err() == nil {
+		rolledBack, rbErr := s.rollBack(ctx, out, resources)
+		if rbErr != nil {
+			return errCode, fmt.Errorf("%w; rollback failed: %v", err, rbErr)
+		}
+		if rolledBack > 0 {
+			return errCode, fmt.Errorf("%w; rolled back %d resource(s)", err, rolledBack)
+		}
+	}
+	return errCode, err
+}
+
+// warnStaleImages warns about the containers that don't run the images built by Skaffold.
+func (s *monitor) warnStaleImages(ctx context.Context, out io.Writer) {
+	if len(s.builtImages) == 0 {
+		return
+	}
+	client, err := kubernetesclient.Client(s.kubeContext)
+	if err == nil {
+		err = s.checkRunningImages(ctx, out, client)
+	}
+	if err != nil {
+		log.Entry(ctx).Warnf("could not check the images run by the deployed pods: %v", err)
+	}
+}
+
+// checkKubectlBinary validates the kubectl binary set for the status check, and reports its version the first time.
+func (s *monitor) checkKubectlBinary(ctx context.Context, out io.Writer) error {
+	if s.kubectlBinary == "" || s.kubectlChecked {
+		return nil
+	}
+	path, err := lookPath(s.kubectlBinary)
+	if err != nil {
+		return fmt.Errorf("kubectl binary %q of the status check not found: %w", s.kubectlBinary, err)
+	}
+	cli := kubectl.NewCLI(s.cfg, "")
+	cli.Binary = path
+	output.Default.Fprintf(out, "Using kubectl %s from %s for the status check\n", cli.Version(ctx), path)
+	s.kubectlChecked = true
+	return nil
+}
+
+// collectResources returns the resources deployed by the current run that the status check waits for,
+// skipping the resources already seen in the current iteration. Excluded resources are reported to out.
+func (s *monitor) collectResources(ctx context.Context, out io.Writer) ([]*resource.Resource, proto.StatusCode, error) {
+	listed, skipped, errCode, err := s.listResources(ctx)
+	if err != nil {
+		return nil, errCode, err
+	}
+	if len(skipped) > 0 {
+		output.Default.Fprintln(out, "Skipping status check of excluded resources:", strings.Join(skipped, ", "))
+	}
+	resources := make([]*resource.Resource, 0, len(listed))
+	for _, r := range listed {
+		if s.seenResources.Contains(r) {
+			continue
+		}
+		resources = append(resources, r)
+		s.seenResources.Add(r)
+		resourceStatusCheckStarted(r)
+	}
+	return resources, proto.StatusCode_STATUSCHECK_SUCCESS, nil
+}
+
+// listResources lists the resources deployed by the current run that the status check waits for:
+// deployments, statefulsets, annotated load balancer services, standalone pods, config connector and selected custom resources.
+// Resources matching the status check exclusions are returned separately by name.
+func (s *monitor) listResources(ctx context.Context) ([]*resource.Resource, []string, proto.StatusCode, error) {
+	exclusions, err := newExclusions(s.exclude)
+	if err != nil {
+		return nil, nil, proto.StatusCode_STATUSCHECK_INTERNAL_ERROR, err
+	}
 	client, err := kubernetesclient.Client(s.kubeContext)
 	if err != nil {
-		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
+		return nil, nil, proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 	dynClient, err := kubernetesclient.DynamicClient(s.kubeContext)
 	if err != nil {
-		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
+		return nil, nil, proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	l := s.labeller
+	var defined definedResources
+	if s.fromManifests {
+		// resources deployed out-of-band don't have the run id label.
+		l = nil
+		if defined, err = newDefinedResources(s.manifests); err != nil {
+			return nil, nil, proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, err
+		}
 	}
 	resources := make([]*resource.Resource, 0)
-	for _, n := range *s.namespaces {
-		newDeployments, err := getDeployments(ctx, client, n, s.labeller, getDeadline(s.deadlineSeconds), s.tolerateFailures)
+	var skipped []string
+	add := func(r *resource.Resource) {
+		if !s.usesChangedImage(r) {
+			log.Entry(ctx).Debugf("Skipping status check of %s: none of its images changed", r)
+			return
+		}
+		if excluded(exclusions, r) {
+			skipped = append(skipped, r.String())
+			return
+		}
+		resources = append(resources, r)
+	}
+	for _, n := range s.listedNamespaces() {
+		newDeployments, err := getDeployments(ctx, client, n, l, getDeadline(s.deadlineSeconds), s.tolerateFailures, s.logPrefix)
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
+			return nil, nil, proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
 		}
 		for _, d := range newDeployments {
-			if s.seenResources.Contains(d) {
+			if !defined.contains(d) {
 				continue
 			}
-			resources = append(resources, d)
-			s.seenResources.Add(d)
+			if s.waitForHPA {
+				d.WithHPAMinReplicas()
+			}
+			if s.waitForEndpoints {
+				d.WithServiceEndpoints()
+			}
+			add(d)
 		}
 
-		newStatefulSets, err := getStatefulSets(ctx, client, n, s.labeller, getDeadline(s.deadlineSeconds), s.tolerateFailures)
+		// the StatefulSets are listed once, for their own status and for the claims of their volume claim templates.
+		statefulSets, err := listStatefulSets(ctx, client, n, l)
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_STATEFULSET_FETCH_ERR, fmt.Errorf("could not fetch statefulsets: %w", err)
+			return nil, nil, proto.StatusCode_STATUSCHECK_STATEFULSET_FETCH_ERR, fmt.Errorf("could not fetch statefulsets: %w", err)
 		}
-		for _, d := range newStatefulSets {
-			if s.seenResources.Contains(d) {
-				continue
+		for _, d := range getStatefulSets(client, statefulSets, l, getDeadline(s.deadlineSeconds), s.tolerateFailures, s.logPrefix) {
+			if defined.contains(d) {
+				add(d)
+			}
+		}
+
+		if s.deploys(replicaSetSelector, nil) {
+			newReplicaSets, err := getReplicaSets(ctx, client, n, l, getDeadline(s.deadlineSeconds), s.tolerateFailures, s.logPrefix)
+			if err != nil {
+				return nil, nil, proto.StatusCode_STATUSCHECK_REPLICASET_FETCH_ERR, fmt.Errorf("could not fetch replicasets: %w", err)
+			}
+			for _, d := range newReplicaSets {
+				if defined.contains(d) {
+					add(d)
+				}
 			}
-			resources = append(resources, d)
-			s.seenResources.Add(d)
 		}
 
-		newStandalonePods, err := getStandalonePods(ctx, client, n, s.labeller, getDeadline((s.deadlineSeconds)), s.tolerateFailures)
+		if s.deploys(serviceSelector, waitsForLoadBalancer) {
+			newServices, err := getLoadBalancerServices(ctx, client, n, l, getDeadline(s.deadlineSeconds), s.tolerateFailures)
+			if err != nil {
+				return nil, nil, proto.StatusCode_STATUSCHECK_SERVICE_FETCH_ERR, fmt.Errorf("could not fetch services: %w", err)
+			}
+			for _, d := range newServices {
+				if defined.contains(d) {
+					add(d)
+				}
+			}
+		}
+
+		if s.deploys(ingressSelector, waitsForIngress) {
+			newIngresses, err := getIngresses(ctx, client, n, l, getDeadline(s.deadlineSeconds), s.tolerateFailures)
+			if err != nil {
+				return nil, nil, proto.StatusCode_STATUSCHECK_INGRESS_FETCH_ERR, fmt.Errorf("could not fetch ingresses: %w", err)
+			}
+			for _, d := range newIngresses {
+				if defined.contains(d) {
+					add(d)
+				}
+			}
+		}
+
+		newClaims, err := getPersistentVolumeClaims(ctx, client, n, statefulSets, s.deploys(pvcSelector, nil), l, getDeadline(s.deadlineSeconds), s.tolerateFailures)
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_STANDALONE_PODS_FETCH_ERR, fmt.Errorf("could not fetch standalone pods: %w", err)
+			return nil, nil, proto.StatusCode_STATUSCHECK_PVC_FETCH_ERR, fmt.Errorf("could not fetch persistent volume claims: %w", err)
 		}
-		for _, pods := range newStandalonePods {
-			if s.seenResources.Contains(pods) {
-				continue
+		for _, d := range newClaims {
+			if defined.contains(d) {
+				add(d)
+			}
+		}
+
+		// standalone pods are only selected by the run id label.
+		if !s.fromManifests {
+			newStandalonePods, err := getStandalonePods(ctx, client, n, s.labeller, getDeadline((s.deadlineSeconds)), s.tolerateFailures, s.logPrefix)
+			if err != nil {
+				return nil, nil, proto.StatusCode_STATUSCHECK_STANDALONE_PODS_FETCH_ERR, fmt.Errorf("could not fetch standalone pods: %w", err)
+			}
+			for _, pods := range newStandalonePods {
+				add(pods)
 			}
-			resources = append(resources, pods)
-			s.seenResources.Add(pods)
 		}
+	}
 
-		newConfigConnectorResources, err := getConfigConnectorResources(client, dynClient, s.manifests, n, s.labeller, getDeadline(s.deadlineSeconds), s.tolerateFailures)
+	// the resources defined in the manifests are checked in the namespaces they are deployed to.
+	defaultNs := s.defaultNamespace()
+	for _, n := range *s.namespaces {
+		newConfigConnectorResources, err := getConfigConnectorResources(client, dynClient, s.manifests, n, l, getDeadline(s.deadlineSeconds), s.tolerateFailures)
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR, fmt.Errorf("could not fetch config connector resources: %w", err)
+			return nil, nil, proto.StatusCode_STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR, fmt.Errorf("could not fetch config connector resources: %w", err)
 		}
 		for _, d := range newConfigConnectorResources {
-			if s.seenResources.Contains(d) {
-				continue
-			}
-			resources = append(resources, d)
-			s.seenResources.Add(d)
+			add(d)
 		}
 
 		for _, selector := range s.crSelectors {
 			customResources, err := getCustomResources(client, dynClient, s.manifests, n, getDeadline(s.deadlineSeconds), s.tolerateFailures, selector)
 			if err != nil {
-				return proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR, fmt.Errorf("could not fetch custom resources: %w", err)
+				return nil, nil, proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR, fmt.Errorf("could not fetch custom resources: %w", err)
 			}
-
 			for _, d := range customResources {
-				if s.seenResources.Contains(d) {
-					continue
-				}
-				resources = append(resources, d)
-				s.seenResources.Add(d)
+				add(d)
 			}
 		}
+
+		readinessResources, err := getReadinessResources(s.manifests, n, defaultNs, getDeadline(s.deadlineSeconds), s.tolerateFailures, s.readiness)
+		if err != nil {
+			return nil, nil, proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR, fmt.Errorf("could not fetch resources with readiness rules: %w", err)
+		}
+		for _, d := range readinessResources {
+			add(d)
+		}
+
+		rollouts, err := getArgoRollouts(s.manifests, n, defaultNs, getDeadline(s.deadlineSeconds), s.tolerateFailures)
+		if err != nil {
+			return nil, nil, proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR, fmt.Errorf("could not fetch argo rollouts: %w", err)
+		}
+		for _, d := range rollouts {
+			add(d)
+		}
 	}
+	return resources, skipped, proto.StatusCode_STATUSCHECK_SUCCESS, nil
+}
 
-	var wg sync.WaitGroup
-	c := newCounter(len(resources))
+var (
+	replicaSetSelector = &manifest.WildcardGroupKind{Group: regexp.MustCompile(`^apps$`), Kind: regexp.MustCompile(`^ReplicaSet$`)}
+	serviceSelector    = &manifest.WildcardGroupKind{Group: regexp.MustCompile(`^$`), Kind: regexp.MustCompile(`^Service$`)}
+	ingressSelector    = &manifest.WildcardGroupKind{Group: regexp.MustCompile(`^networking\.k8s\.io$`), Kind: regexp.MustCompile(`^Ingress$`)}
+	pvcSelector        = &manifest.WildcardGroupKind{Group: regexp.MustCompile(`^$`), Kind: regexp.MustCompile(`^PersistentVolumeClaim$`)}
+)
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	var exitStatusOnce sync.Once
-	var exitStatus proto.StatusCode
+// deploys returns whether the deployed manifests define resources of the selected kind that the status check waits for,
+// so that the kinds the status check only waits for on demand aren't listed for nothing. When the deployer didn't
+// register its manifests, the resources can't be told apart and are listed.
+func (s *monitor) deploys(selector manifest.GroupKindSelector, waitsFor func(unstructured.Unstructured) bool) bool {
+	if len(s.manifests) == 0 {
+		return true
+	}
+	uRes, err := s.manifests.SelectResources(selector)
+	if err != nil {
+		return true
+	}
+	for _, r := range uRes {
+		if waitsFor == nil || waitsFor(r) {
+			return true
+		}
+	}
+	return false
+}
 
-	for _, d := range resources {
-		wg.Add(1)
-		go func(r *resource.Resource) {
-			defer wg.Done()
-			// keep updating the resource status until it fails/succeeds/times out/cancelled.
-			pollResourceStatus(ctx, s.cfg, r)
-			rcCopy, failed := c.markProcessed(ctx, r.StatusCode())
-			s.printStatusCheckSummary(out, r, rcCopy)
-			// if a resource fails and fast fail enabled, cancel status checks
-			// for all resources to fail fast and capture the first failed exit code.
-			// otherwise wait for all resources to report status once before failing
-			if failed && s.failFast {
-				exitStatusOnce.Do(func() {
-					exitStatus = r.StatusCode()
-				})
-				cancel()
-			}
-		}(d)
+// waitsForLoadBalancer returns whether a service of type LoadBalancer opted into the status check with LoadBalancerAnnotation.
+func waitsForLoadBalancer(r unstructured.Unstructured) bool {
+	if svcType, _, _ := unstructured.NestedString(r.Object, "spec", "type"); svcType != string(v1.ServiceTypeLoadBalancer) {
+		return false
 	}
+	wait, _ := strconv.ParseBool(r.GetAnnotations()[LoadBalancerAnnotation])
+	return wait
+}
 
-	// Retrieve pending resource statuses
-	go func() {
-		s.printResourceStatus(ctx, out, resources)
-	}()
+// waitsForIngress returns whether an ingress opted into the status check with IngressAnnotation.
+func waitsForIngress(r unstructured.Unstructured) bool {
+	wait, _ := strconv.ParseBool(r.GetAnnotations()[IngressAnnotation])
+	return wait
+}
 
-	// Wait for all deployment statuses to be fetched
-	wg.Wait()
-	return getSkaffoldDeployStatus(ctx, c, exitStatus)
+// skipForbidden returns whether listing the resources of a kind was forbidden, in which case the status check
+// doesn't wait for that kind, as with namespace-scoped RBAC that doesn't grant access to it.
+func skipForbidden(ctx context.Context, kind, ns string, err error) bool {
+	if !apierrs.IsForbidden(err) {
+		return false
+	}
+	log.Entry(ctx).Debugf("Skipping status check of %s in namespace %q: %v", kind, ns, err)
+	return true
 }
 
-func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration, tolerateFailures bool) ([]*resource.Resource, error) {
+// defaultNamespace returns the namespace that the manifests without a namespace are deployed to:
+// the namespace set on the command line, or else the namespace of the kube context.
+func (s *monitor) defaultNamespace() string {
+	if ns := s.cfg.GetKubeNamespace(); ns != "" {
+		return ns
+	}
+	if cfg, err := kubectx.CurrentConfig(); err == nil {
+		if c, ok := cfg.Contexts[s.kubeContext]; ok && c.Namespace != "" {
+			return c.Namespace
+		}
+	}
+	return metav1.NamespaceDefault
+}
+
+// deployedTo returns whether a resource of the manifests is deployed to the namespace,
+// the resources without a namespace being deployed to the default namespace.
+func deployedTo(r unstructured.Unstructured, ns, defaultNs string) bool {
+	if r.GetNamespace() == "" {
+		return ns == defaultNs
+	}
+	return r.GetNamespace() == ns
+}
+
+// listedNamespaces returns the namespaces to list the labelled resources from: the namespaces of the deployed manifests,
+// or all namespaces, since a label selector query of the empty namespace lists every namespace.
+func (s *monitor) listedNamespaces() []string {
+	if s.allNamespaces {
+		return []string{metav1.NamespaceAll}
+	}
+	return *s.namespaces
+}
+
+// resourceStatusCheckStarted emits the event marking the start of the status check of a resource.
+func resourceStatusCheckStarted(r *resource.Resource) {
+	event.ResourceStatusCheckEventStarted(r.String(), string(r.Type()), r.Name(), r.Namespace(), r.Deadline())
+	eventV2.ResourceStatusCheckEventStarted(r.String(), string(r.Type()), r.Name(), r.Namespace(), r.Deadline())
+}
+
+// resourceStatusCheckEnded emits the event marking the end of the status check of a resource, once it completed or was cancelled.
+func resourceStatusCheckEnded(r *resource.Resource) {
+	event.ResourceStatusCheckEventEnded(r.String(), string(r.Type()), r.Name(), r.Namespace(), r.Deadline(), r.StatusCode())
+	eventV2.ResourceStatusCheckEventEnded(r.String(), string(r.Type()), r.Name(), r.Namespace(), r.Deadline(), r.StatusCode())
+}
+
+// traceStatusCheckEnded records the status code of a status check on its span, and its error as a span event.
+func traceStatusCheckEnded(ctx context.Context, code proto.StatusCode, err error) {
+	instrumentation.AddAttributesToCurrentSpanFromContext(ctx, map[string]string{
+		"StatusCode": code.String(),
+	})
+	if err != nil {
+		instrumentation.AddEventToCurrentSpanFromContext(ctx, "error", map[string]string{
+			"StatusCode": code.String(),
+			"Message":    err.Error(),
+		})
+	}
+}
+
+func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration, tolerateFailures bool, logPrefix string) ([]*resource.Resource, error) {
 	var result []*resource.Resource
 	selector := validator.NewStandalonePodsSelector(client)
 	pods, err := selector.Select(ctx, ns, metav1.ListOptions{
@@ -295,8 +810,12 @@ func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns stri
 	}
 	pd := diag.New([]string{ns}).
 		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-		WithValidators([]validator.Validator{validator.NewPodValidator(client, selector)})
-	result = append(result, resource.NewResource(string(resource.ResourceTypes.StandalonePods), resource.ResourceTypes.StandalonePods, ns, deadlineDuration, tolerateFailures).WithValidator(pd))
+		WithValidators([]validator.Validator{validator.NewPodValidator(client, selector).WithLogPrefix(logPrefix)})
+	var images []string
+	for _, pod := range pods {
+		images = append(images, podImages(pod.Spec)...)
+	}
+	result = append(result, resource.NewResource(string(resource.ResourceTypes.StandalonePods), resource.ResourceTypes.StandalonePods, ns, deadlineDuration, tolerateFailures).WithImages(images).WithValidator(pd))
 
 	return result, nil
 }
@@ -312,10 +831,9 @@ func getConfigConnectorResources(client kubernetes.Interface, dynClient dynamic.
 		if r.GetName() != "" {
 			resName = fmt.Sprintf("%s, Name=%s", resName, r.GetName())
 		}
-		pd := diag.New([]string{ns}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		pd := withRunIDLabel(diag.New([]string{ns}), l).
 			WithValidators([]validator.Validator{validator.NewConfigConnectorValidator(client, dynClient, r.GroupVersionKind())})
-		result = append(result, resource.NewResource(resName, resource.ResourceTypes.ConfigConnector, ns, deadlineDuration, tolerateFailures).WithValidator(pd))
+		result = append(result, resource.NewResource(resName, resource.ResourceTypes.ConfigConnector, ns, deadlineDuration, tolerateFailures).WithLabels(r.GetLabels()).WithValidator(pd))
 	}
 
 	return result, nil
@@ -334,15 +852,64 @@ func getCustomResources(client kubernetes.Interface, dynClient dynamic.Interface
 		}
 		pd := diag.New([]string{ns}).
 			WithValidators([]validator.Validator{validator.NewCustomValidator(client, dynClient, r.GroupVersionKind())})
-		result = append(result, resource.NewResource(resName, resource.ResourceTypes.CustomResource, ns, deadlineDuration, tolerateFailures).WithValidator(pd))
+		result = append(result, resource.NewResource(resName, resource.ResourceTypes.CustomResource, ns, deadlineDuration, tolerateFailures).WithLabels(r.GetLabels()).WithValidator(pd))
 	}
 
 	return result, nil
 }
 
-func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration, tolerateFailures bool) ([]*resource.Resource, error) {
+// getReadinessResources returns the resources defined in the manifests whose kind has a readiness rule.
+func getReadinessResources(m manifest.ManifestList, ns, defaultNs string, deadlineDuration time.Duration, tolerateFailures bool, rules []latest.StatusCheckReadiness) ([]*resource.Resource, error) {
+	var result []*resource.Resource
+	for _, rule := range rules {
+		selector := &manifest.WildcardGroupKind{Kind: regexp.MustCompile("(?i)^" + regexp.QuoteMeta(rule.Kind) + "$")}
+		kind := strings.ToLower(rule.Kind)
+		if rule.Group != "" {
+			selector.Group = regexp.MustCompile("^" + regexp.QuoteMeta(rule.Group) + "$")
+			kind = kind + "." + rule.Group
+		}
+		uRes, err := m.SelectResources(selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range uRes {
+			if !deployedTo(r, ns, defaultNs) {
+				continue
+			}
+			result = append(result, resource.NewResource(r.GetName(), resource.Type(strings.ToLower(r.GetKind())), ns, deadlineDuration, tolerateFailures).WithLabels(r.GetLabels()).WithReadiness(kind, rule.JSONPath, rule.Value))
+		}
+	}
+	return result, nil
+}
+
+// argoRolloutSelector selects the Argo Rollouts, which replace the deployments of the teams using progressive delivery.
+var argoRolloutSelector = &manifest.WildcardGroupKind{Group: regexp.MustCompile(`^argoproj\.io$`), Kind: regexp.MustCompile(`^Rollout$`)}
+
+// getArgoRollouts returns the Argo Rollouts defined in the manifests.
+func getArgoRollouts(m manifest.ManifestList, ns, defaultNs string, deadlineDuration time.Duration, tolerateFailures bool) ([]*resource.Resource, error) {
+	uRes, err := m.SelectResources(argoRolloutSelector)
+	if err != nil {
+		return nil, err
+	}
+	var result []*resource.Resource
+	for _, r := range uRes {
+		if !deployedTo(r, ns, defaultNs) {
+			continue
+		}
+		var template v1.PodTemplateSpec
+		if t, found, _ := unstructured.NestedMap(r.Object, "spec", "template"); found {
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t, &template); err != nil {
+				return nil, fmt.Errorf("could not read the pod template of rollout %q: %w", r.GetName(), err)
+			}
+		}
+		result = append(result, resource.NewResource(r.GetName(), resource.ResourceTypes.ArgoRollout, ns, deadlineDuration, tolerateFailures).WithLabels(r.GetLabels()).WithAnnotations(r.GetAnnotations(), template.Annotations).WithImages(podImages(template.Spec)))
+	}
+	return result, nil
+}
+
+func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration, tolerateFailures bool, logPrefix string) ([]*resource.Resource, error) {
 	deps, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{
-		LabelSelector: l.RunIDSelector(),
+		LabelSelector: runIDSelector(l),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch deployments: %w", err)
@@ -357,44 +924,246 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
 
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client, validator.NewDeploymentPodsSelector(client, d))})
+		pd := withRunIDLabel(diag.New([]string{d.Namespace}), l).
+			WithValidators([]validator.Validator{validator.NewPodValidator(client, validator.NewDeploymentPodsSelector(client, d)).WithLogPrefix(logPrefix)})
 
 		for k, v := range d.Spec.Template.Labels {
 			pd = pd.WithLabel(k, v)
 		}
 
-		resources[i] = resource.NewResource(d.Name, resource.ResourceTypes.Deployment, d.Namespace, deadline, tolerateFailures).WithValidator(pd)
+		resources[i] = resource.NewResource(d.Name, resource.ResourceTypes.Deployment, d.Namespace, deadline, tolerateFailures).WithLabels(d.Labels).WithAnnotations(d.Annotations, d.Spec.Template.Annotations).WithImages(podImages(d.Spec.Template.Spec)).WithValidator(pd)
 	}
 	return resources, nil
 }
 
-func getStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration, tolerateFailures bool) ([]*resource.Resource, error) {
+func listStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller) ([]appsv1.StatefulSet, error) {
 	sets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
-		LabelSelector: l.RunIDSelector(),
+		LabelSelector: runIDSelector(l),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch stateful sets: %w", err)
 	}
+	return sets.Items, nil
+}
 
-	resources := make([]*resource.Resource, len(sets.Items))
-	for i, ss := range sets.Items {
-		pd := diag.New([]string{ss.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client, validator.NewStatefulSetPodsSelector(client, ss))})
+func getStatefulSets(client kubernetes.Interface, sets []appsv1.StatefulSet, l *label.DefaultLabeller, deadline time.Duration, tolerateFailures bool, logPrefix string) []*resource.Resource {
+	resources := make([]*resource.Resource, len(sets))
+	for i, ss := range sets {
+		pd := withRunIDLabel(diag.New([]string{ss.Namespace}), l).
+			WithValidators([]validator.Validator{validator.NewPodValidator(client, validator.NewStatefulSetPodsSelector(client, ss)).WithLogPrefix(logPrefix)})
 
 		for k, v := range ss.Spec.Template.Labels {
 			pd = pd.WithLabel(k, v)
 		}
 
-		resources[i] = resource.NewResource(ss.Name, resource.ResourceTypes.StatefulSet, ss.Namespace, deadline, tolerateFailures).WithValidator(pd)
+		resources[i] = resource.NewResource(ss.Name, resource.ResourceTypes.StatefulSet, ss.Namespace, deadline, tolerateFailures).WithLabels(ss.Labels).WithAnnotations(ss.Annotations, ss.Spec.Template.Annotations).WithImages(podImages(ss.Spec.Template.Spec)).WithValidator(pd)
+	}
+	return resources
+}
+
+// getReplicaSets returns the bare ReplicaSets, the ones not controlled by a deployment.
+func getReplicaSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration, tolerateFailures bool, logPrefix string) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().ReplicaSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: runIDSelector(l),
+	})
+	if skipForbidden(ctx, "replica sets", ns, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch replica sets: %w", err)
+	}
+
+	resources := make([]*resource.Resource, 0)
+	for _, rs := range sets.Items {
+		if metav1.GetControllerOf(&rs) != nil {
+			continue
+		}
+		pd := withRunIDLabel(diag.New([]string{rs.Namespace}), l).
+			WithValidators([]validator.Validator{validator.NewPodValidator(client, validator.NewReplicaSetPodsSelector(client, rs)).WithLogPrefix(logPrefix)})
+
+		for k, v := range rs.Spec.Template.Labels {
+			pd = pd.WithLabel(k, v)
+		}
+
+		resources = append(resources, resource.NewResource(rs.Name, resource.ResourceTypes.ReplicaSet, rs.Namespace, deadline, tolerateFailures).WithLabels(rs.Labels).WithAnnotations(rs.Annotations, rs.Spec.Template.Annotations).WithImages(podImages(rs.Spec.Template.Spec)).WithValidator(pd))
 	}
 	return resources, nil
 }
 
+// getLoadBalancerServices returns the services of type LoadBalancer annotated with LoadBalancerAnnotation.
+func getLoadBalancerServices(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration, tolerateFailures bool) ([]*resource.Resource, error) {
+	svcs, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: runIDSelector(l),
+	})
+	if skipForbidden(ctx, "services", ns, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch services: %w", err)
+	}
+
+	resources := make([]*resource.Resource, 0)
+	for _, svc := range svcs.Items {
+		if svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+			continue
+		}
+		if wait, _ := strconv.ParseBool(svc.Annotations[LoadBalancerAnnotation]); !wait {
+			continue
+		}
+		resources = append(resources, resource.NewResource(svc.Name, resource.ResourceTypes.Service, svc.Namespace, deadline, tolerateFailures).WithLabels(svc.Labels))
+	}
+	return resources, nil
+}
+
+// getIngresses returns the ingresses annotated with IngressAnnotation.
+func getIngresses(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration, tolerateFailures bool) ([]*resource.Resource, error) {
+	ingresses, err := client.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: runIDSelector(l),
+	})
+	if skipForbidden(ctx, "ingresses", ns, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch ingresses: %w", err)
+	}
+
+	resources := make([]*resource.Resource, 0)
+	for _, ing := range ingresses.Items {
+		if wait, _ := strconv.ParseBool(ing.Annotations[IngressAnnotation]); !wait {
+			continue
+		}
+		resources = append(resources, resource.NewResource(ing.Name, resource.ResourceTypes.Ingress, ing.Namespace, deadline, tolerateFailures).WithLabels(ing.Labels))
+	}
+	return resources, nil
+}
+
+// getPersistentVolumeClaims returns the persistent volume claims, which have to be bound before their pods can be scheduled.
+// The labelled claims are only listed if listLabelled is true. The claims created from the volume claim templates of the
+// StatefulSets aren't labelled, so they're named after the templates. They're checked even before the StatefulSet
+// controller creates them along with the pods of their ordinals.
+func getPersistentVolumeClaims(ctx context.Context, client kubernetes.Interface, ns string, sets []appsv1.StatefulSet, listLabelled bool, l *label.DefaultLabeller, deadline time.Duration, tolerateFailures bool) ([]*resource.Resource, error) {
+	resources := make([]*resource.Resource, 0)
+	found := map[string]bool{}
+	if listLabelled {
+		claims, err := client.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: runIDSelector(l),
+		})
+		if skipForbidden(ctx, "persistent volume claims", ns, err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch persistent volume claims: %w", err)
+		}
+		for _, pvc := range claims.Items {
+			found[pvc.Name] = true
+			resources = append(resources, resource.NewResource(pvc.Name, resource.ResourceTypes.PersistentVolumeClaim, pvc.Namespace, deadline, tolerateFailures).WithLabels(pvc.Labels))
+		}
+	}
+	for _, ss := range sets {
+		for _, name := range templateClaimNames(ss) {
+			if found[name] {
+				continue
+			}
+			found[name] = true
+			resources = append(resources, resource.NewResource(name, resource.ResourceTypes.PersistentVolumeClaim, ss.Namespace, deadline, tolerateFailures))
+		}
+	}
+	return resources, nil
+}
+
+// templateClaimNames returns the names of the claims created from the volume claim templates of a StatefulSet,
+// which are named <template>-<statefulset>-<ordinal> for each ordinal of the replicas.
+func templateClaimNames(ss appsv1.StatefulSet) []string {
+	replicas := int32(1)
+	if ss.Spec.Replicas != nil {
+		replicas = *ss.Spec.Replicas
+	}
+	var start int32
+	if ss.Spec.Ordinals != nil {
+		start = ss.Spec.Ordinals.Start
+	}
+	var names []string
+	for _, t := range ss.Spec.VolumeClaimTemplates {
+		for i := start; i < start+replicas; i++ {
+			names = append(names, fmt.Sprintf("%s-%s-%d", t.Name, ss.Name, i))
+		}
+	}
+	return names
+}
+
+// statusCodes returns the set of status codes with the given names. Unknown names are ignored.
+func statusCodes(names []string) map[proto.StatusCode]bool {
+	if len(names) == 0 {
+		return nil
+	}
+	codes := make(map[proto.StatusCode]bool)
+	for _, name := range names {
+		if code, found := proto.StatusCode_value[name]; found {
+			codes[proto.StatusCode(code)] = true
+		}
+	}
+	return codes
+}
+
+// podImages returns the images of the init and regular containers of a pod.
+func podImages(spec v1.PodSpec) []string {
+	var images []string
+	for _, c := range append(spec.InitContainers, spec.Containers...) {
+		images = append(images, c.Image)
+	}
+	return images
+}
+
+// runIDSelector returns the label selector of the resources deployed by the current run, or no selector without labeller.
+func runIDSelector(l *label.DefaultLabeller) string {
+	if l == nil {
+		return ""
+	}
+	return l.RunIDSelector()
+}
+
+// withRunIDLabel restricts the diagnosed pods to those deployed by the current run, if there's a labeller.
+func withRunIDLabel(d diag.Diagnose, l *label.DefaultLabeller) diag.Diagnose {
+	if l == nil {
+		return d
+	}
+	return d.WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel])
+}
+
+// definedResources are the lowercased kinds, namespaces and names of the resources defined in manifests.
+// A nil set contains every resource.
+type definedResources map[string]bool
+
+func newDefinedResources(manifests manifest.ManifestList) (definedResources, error) {
+	defined := definedResources{}
+	for _, m := range manifests {
+		var obj metav1.PartialObjectMetadata
+		if err := yaml.Unmarshal(m, &obj); err != nil {
+			return nil, fmt.Errorf("parsing manifest: %w", err)
+		}
+		defined[definedResourceKey(strings.ToLower(obj.Kind), obj.Namespace, obj.Name)] = true
+	}
+	return defined, nil
+}
+
+// contains returns whether the resource is defined, in its namespace or without namespace.
+func (d definedResources) contains(r *resource.Resource) bool {
+	if d == nil {
+		return true
+	}
+	return d[definedResourceKey(string(r.Type()), r.Namespace(), r.Name())] || d[definedResourceKey(string(r.Type()), "", r.Name())]
+}
+
+func definedResourceKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
 func pollResourceStatus(ctx context.Context, cfg Config, r *resource.Resource) {
-	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
+	pollDuration := cfg.StatusCheckPollInterval()
+	if pollDuration <= 0 {
+		pollDuration = time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
+	}
+	unchangedTicks := 0
 	ticker := time.NewTicker(pollDuration)
 	defer ticker.Stop()
 	// Add poll duration to account for one last attempt after progressDeadlineSeconds.
@@ -437,10 +1206,27 @@ func pollResourceStatus(ctx context.Context, cfg Config, r *resource.Resource) {
 				r.MarkComplete()
 				return
 			}
+			if cfg.StatusCheckAdaptivePoll() {
+				if r.StatusChanged() {
+					unchangedTicks = 0
+				} else {
+					unchangedTicks++
+				}
+				ticker.Reset(adaptivePollPeriod(pollDuration, unchangedTicks))
+			}
 		}
 	}
 }
 
+// adaptivePollPeriod backs off the poll period while a resource status stays unchanged.
+func adaptivePollPeriod(pollDuration time.Duration, unchangedTicks int) time.Duration {
+	factor := 1 << (unchangedTicks / adaptivePollBackoffTicks)
+	if factor > maxAdaptivePollFactor || factor <= 0 {
+		factor = maxAdaptivePollFactor
+	}
+	return pollDuration * time.Duration(factor)
+}
+
 func getSkaffoldDeployStatus(ctx context.Context, c *counter, sc proto.StatusCode) (proto.StatusCode, error) {
 	if c.total == int(c.cancelled) && c.total > 0 {
 		err := fmt.Errorf("%d/%d deployment(s) status check cancelled", c.cancelled, c.total)
@@ -460,6 +1246,33 @@ func getSkaffoldDeployStatus(ctx context.Context, c *counter, sc proto.StatusCod
 	return sc, err
 }
 
+// dominantStatusCode returns the most frequent status code of the failed resources,
+// the first one to reach the highest count in case of a tie, or 0 if no resource failed.
+func dominantStatusCode(resources []*resource.Resource) proto.StatusCode {
+	counts := map[proto.StatusCode]int{}
+	var dominant proto.StatusCode
+	for _, r := range resources {
+		sc := r.StatusCode()
+		if sc == proto.StatusCode_STATUSCHECK_SUCCESS || sc == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+			continue
+		}
+		counts[sc]++
+		if counts[sc] > counts[dominant] {
+			dominant = sc
+		}
+	}
+	return dominant
+}
+
+// withStatusCode attaches the status code of a failed status check to its error, so that it
+// determines the exit code of skaffold. Cancellations are returned unchanged.
+func withStatusCode(ctx context.Context, errCode proto.StatusCode, err error) error {
+	if errCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED || ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return err
+	}
+	return sErrors.WithStatusCode(err, errCode)
+}
+
 func getDeadline(d int) time.Duration {
 	if d > 0 {
 		return time.Duration(d) * time.Second
@@ -468,7 +1281,7 @@ func getDeadline(d int) time.Duration {
 }
 
 func (s *monitor) printStatusCheckSummary(out io.Writer, r *resource.Resource, c counter) {
-	ae := r.Status().ActionableError()
+	ae := sErrors.WithCustomSuggestions(s.cfg, r.Status().ActionableError(), r.StatusCode())
 	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 		// Don't print the status summary if the user ctrl-C or
 		// another deployment failed
@@ -480,17 +1293,23 @@ func (s *monitor) printStatusCheckSummary(out io.Writer, r *resource.Resource, c
 	status := fmt.Sprintf("%s %s", tabHeader, r)
 	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
 		if str := r.ReportSinceLastUpdated(s.muteLogs); str != "" {
-			fmt.Fprintln(out, trimNewLine(str))
+			s.report(out, newUpdate(r, str, false))
 		}
 		status = fmt.Sprintf("%s failed. Error: %s.",
 			status,
 			trimNewLine(r.StatusMessage()),
 		)
+		for _, suggestion := range sErrors.CustomSuggestions(s.cfg, ae.ErrCode, r.StatusCode()) {
+			status = fmt.Sprintf("%s %s.", status, strings.TrimSuffix(suggestion.Action, "."))
+		}
 	} else {
+		if s.quiet {
+			return
+		}
 		status = fmt.Sprintf("%s is ready.%s", status, getPendingMessage(c.pending, c.total))
 	}
 
-	fmt.Fprintln(out, status)
+	s.report(out, newUpdate(r, status, true))
 }
 
 // printResourceStatus prints resource statuses until all status check are completed or context is cancelled.
@@ -520,10 +1339,11 @@ func (s *monitor) printStatus(resources []*resource.Resource, out io.Writer) boo
 		allDone = false
 		if str := r.ReportSinceLastUpdated(s.muteLogs); str != "" {
 			ae := r.Status().ActionableError()
-			event.ResourceStatusCheckEventUpdated(r.String(), ae)
-			eventV2.ResourceStatusCheckEventUpdated(r.String(), sErrors.V2fromV1(ae))
+			p := r.Status().Progress()
+			event.ResourceStatusCheckEventProgressed(r.String(), ae, p.Current, p.Total)
+			eventV2.ResourceStatusCheckEventProgressed(r.String(), sErrors.V2fromV1(ae), p.Current, p.Total)
 			out, _ := output.WithEventContext(context.Background(), out, constants.Deploy, r.String())
-			fmt.Fprintln(out, trimNewLine(str))
+			s.report(out, newUpdate(r, str, false))
 		}
 	}
 	return allDone
@@ -575,3 +1395,5 @@ type NoopMonitor struct {
 }
 
 func (n *NoopMonitor) RegisterDeployManifests(manifest.ManifestList) {}
+
+func (n *NoopMonitor) WaitForAllResources(context.Context) error { return nil }
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -27,14 +28,28 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"google.golang.org/protobuf/testing/protocmp"
 	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	fakedynclient "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd/api"
 	utilpointer "k8s.io/utils/pointer"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/diag/validator"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
@@ -49,10 +64,11 @@ var TestKubeContext = "kubecontext"
 func TestGetDeployments(t *testing.T) {
 	labeller := label.NewLabeller(true, nil, "run-id")
 	tests := []struct {
-		description string
-		deps        []*appsv1.Deployment
-		expected    []*resource.Resource
-		shouldErr   bool
+		description   string
+		deps          []*appsv1.Deployment
+		allNamespaces bool
+		expected      []*resource.Resource
+		shouldErr     bool
 	}{
 		{
 			description: "multiple deployments in same namespace",
@@ -80,8 +96,8 @@ func TestGetDeployments(t *testing.T) {
 				},
 			},
 			expected: []*resource.Resource{
-				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 10*time.Second, false),
-				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 20*time.Second, false),
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 10*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID(), "random": "foo"}),
+				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 20*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
 			},
 		},
 		{
@@ -100,7 +116,7 @@ func TestGetDeployments(t *testing.T) {
 				},
 			},
 			expected: []*resource.Resource{
-				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 300*time.Second, false),
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 300*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID(), "random": "foo"}),
 			},
 		},
 		{
@@ -127,8 +143,8 @@ func TestGetDeployments(t *testing.T) {
 				},
 			},
 			expected: []*resource.Resource{
-				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second, false),
-				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 200*time.Second, false),
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
+				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 200*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
 			},
 		},
 		{
@@ -146,7 +162,7 @@ func TestGetDeployments(t *testing.T) {
 				},
 			},
 			expected: []*resource.Resource{
-				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 200*time.Second, false),
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 200*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
 			},
 		},
 		{
@@ -178,7 +194,47 @@ func TestGetDeployments(t *testing.T) {
 				},
 			},
 			expected: []*resource.Resource{
-				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second, false),
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
+			},
+		},
+		{
+			description: "deployments across all namespaces",
+			deps: []*appsv1.Deployment{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dep1",
+						Namespace: "test",
+						Labels: map[string]string{
+							label.RunIDLabel: labeller.GetRunID(),
+						},
+					},
+					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dep2",
+						Namespace: "tenant-a1b2",
+						Labels: map[string]string{
+							label.RunIDLabel: labeller.GetRunID(),
+						},
+					},
+					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dep3",
+						Namespace: "tenant-c3d4",
+						Labels: map[string]string{
+							label.RunIDLabel: "9876-6789",
+						},
+					},
+					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
+				},
+			},
+			allNamespaces: true,
+			expected: []*resource.Resource{
+				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "tenant-a1b2", 100*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
 			},
 		},
 		{
@@ -222,7 +278,11 @@ func TestGetDeployments(t *testing.T) {
 				objs[i] = dep
 			}
 			client := fakekubeclientset.NewSimpleClientset(objs...)
-			actual, err := getDeployments(context.Background(), client, "test", labeller, 200*time.Second, false)
+			ns := "test"
+			if test.allNamespaces {
+				ns = metav1.NamespaceAll
+			}
+			actual, err := getDeployments(context.Background(), client, ns, labeller, 200*time.Second, false, "")
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, &test.expected, &actual,
 				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
 				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
@@ -230,6 +290,617 @@ func TestGetDeployments(t *testing.T) {
 	}
 }
 
+func TestCollectResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	objs := []runtime.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test", Labels: runLabels}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "seen", Namespace: "test", Labels: runLabels}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "other-run", Namespace: "test", Labels: map[string]string{label.RunIDLabel: "other"}}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "test", Labels: runLabels}},
+	}
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&kubernetesclient.Client, func(string) (kubernetes.Interface, error) {
+			return fakekubeclientset.NewSimpleClientset(objs...), nil
+		})
+		t.Override(&kubernetesclient.DynamicClient, func(string) (dynamic.Interface, error) {
+			return fakedynclient.NewSimpleDynamicClient(scheme.Scheme), nil
+		})
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		m := &monitor{
+			cfg:           &statusConfig{},
+			labeller:      labeller,
+			namespaces:    &[]string{"test"},
+			seenResources: make(resource.Group),
+		}
+		m.seenResources.Add(resource.NewResource("seen", resource.ResourceTypes.Deployment, "test", 0, false))
+
+		resources, _, err := m.collectResources(context.Background(), io.Discard)
+		t.CheckNoError(err)
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, r.ID())
+		}
+		t.CheckDeepEqual([]string{"dep:test:deployment", "sts:test:statefulset"}, ids)
+	})
+}
+
+func TestCollectResourcesExcluded(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	migrationLabels := map[string]string{label.RunIDLabel: labeller.GetRunID(), "app": "migration"}
+	objs := []runtime.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test", Labels: runLabels}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "db-migration-v2", Namespace: "test", Labels: runLabels}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "test", Labels: migrationLabels}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runLabels}},
+	}
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&kubernetesclient.Client, func(string) (kubernetes.Interface, error) {
+			return fakekubeclientset.NewSimpleClientset(objs...), nil
+		})
+		t.Override(&kubernetesclient.DynamicClient, func(string) (dynamic.Interface, error) {
+			return fakedynclient.NewSimpleDynamicClient(scheme.Scheme), nil
+		})
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		m := &monitor{
+			cfg:           &statusConfig{},
+			labeller:      labeller,
+			namespaces:    &[]string{"test"},
+			seenResources: make(resource.Group),
+			exclude: []latest.StatusCheckExclude{
+				{Name: "db-migration-*"},
+				{Labels: "app=migration"},
+				{Name: "db", Labels: "app=migration"},
+			},
+		}
+
+		var out bytes.Buffer
+		resources, _, err := m.collectResources(context.Background(), &out)
+		t.CheckNoError(err)
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, r.ID())
+		}
+		t.CheckDeepEqual([]string{"dep:test:deployment", "db:test:statefulset"}, ids)
+		t.CheckDeepEqual(2, len(m.seenResources))
+		t.CheckDeepEqual("Skipping status check of excluded resources: test:deployment/db-migration-v2, test:statefulset/sts\n", out.String())
+	})
+}
+
+func TestCollectResourcesChangedImages(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	template := func(image string) v1.PodTemplateSpec {
+		return v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: image}}}}
+	}
+	objs := []runtime.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test", Labels: runLabels}, Spec: appsv1.DeploymentSpec{Template: template("web:v2")}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "test", Labels: runLabels}, Spec: appsv1.DeploymentSpec{Template: template("api:v1")}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runLabels}, Spec: appsv1.StatefulSetSpec{Template: template("postgres:16")}},
+	}
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&kubernetesclient.Client, func(string) (kubernetes.Interface, error) {
+			return fakekubeclientset.NewSimpleClientset(objs...), nil
+		})
+		t.Override(&kubernetesclient.DynamicClient, func(string) (dynamic.Interface, error) {
+			return fakedynclient.NewSimpleDynamicClient(scheme.Scheme), nil
+		})
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		m := &monitor{
+			cfg:           &statusConfig{},
+			labeller:      labeller,
+			namespaces:    &[]string{"test"},
+			seenResources: make(resource.Group),
+		}
+		m.SetChangedImages([]string{"web:v2"})
+
+		resources, _, err := m.collectResources(context.Background(), io.Discard)
+		t.CheckNoError(err)
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, r.ID())
+		}
+		t.CheckDeepEqual([]string{"web:test:deployment"}, ids)
+
+		m.Reset()
+		resources, _, err = m.collectResources(context.Background(), io.Discard)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(3, len(resources))
+	})
+}
+
+func TestCollectResourcesFromManifests(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	objs := []runtime.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "undefined", Namespace: "test"}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "test", Labels: map[string]string{label.RunIDLabel: "other"}}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test"}},
+	}
+	manifests := manifest.ManifestList{
+		[]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: dep\n"),
+		[]byte("apiVersion: apps/v1\nkind: StatefulSet\nmetadata:\n  name: sts\n  namespace: test\n"),
+		[]byte("apiVersion: v1\nkind: Service\nmetadata:\n  name: svc\n"),
+	}
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&kubernetesclient.Client, func(string) (kubernetes.Interface, error) {
+			return fakekubeclientset.NewSimpleClientset(objs...), nil
+		})
+		t.Override(&kubernetesclient.DynamicClient, func(string) (dynamic.Interface, error) {
+			return fakedynclient.NewSimpleDynamicClient(scheme.Scheme), nil
+		})
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		m := NewManifestStatusMonitor(&statusConfig{}, labeller, &[]string{"test"}, nil, manifests).(*monitor)
+
+		resources, _, err := m.collectResources(context.Background(), io.Discard)
+		t.CheckNoError(err)
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, r.ID())
+		}
+		t.CheckDeepEqual([]string{"dep:test:deployment", "sts:test:statefulset"}, ids)
+	})
+}
+
+func TestCollectResourcesListedKinds(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	tests := []struct {
+		description string
+		manifests   manifest.ManifestList
+		listErr     error
+		expected    []string
+	}{
+		{
+			description: "forbidden kinds are skipped",
+			listErr:     apierrs.NewForbidden(schema.GroupResource{Resource: "ingresses"}, "", errors.New("namespace-scoped role")),
+			expected:    []string{"dep:test:deployment"},
+		},
+		{
+			description: "other list errors fail",
+			listErr:     errors.New("connection refused"),
+		},
+		{
+			description: "services that aren't waited for aren't listed",
+			manifests:   manifest.ManifestList{[]byte("apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  annotations:\n    " + LoadBalancerAnnotation + ": \"true\"\nspec:\n  type: ClusterIP\n")},
+			listErr:     errors.New("connection refused"),
+			expected:    []string{"dep:test:deployment"},
+		},
+		{
+			description: "kinds that aren't deployed aren't listed",
+			manifests:   manifest.ManifestList{[]byte("apiVersion: networking.k8s.io/v1\nkind: Ingress\nmetadata:\n  name: web\n")},
+			listErr:     errors.New("connection refused"),
+			expected:    []string{"dep:test:deployment"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test", Labels: runLabels}})
+			for _, kind := range []string{"replicasets", "services", "ingresses", "persistentvolumeclaims"} {
+				client.PrependReactor("list", kind, func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, test.listErr
+				})
+			}
+			t.Override(&kubernetesclient.Client, func(string) (kubernetes.Interface, error) { return client, nil })
+			t.Override(&kubernetesclient.DynamicClient, func(string) (dynamic.Interface, error) {
+				return fakedynclient.NewSimpleDynamicClient(scheme.Scheme), nil
+			})
+			testEvent.InitializeState([]latest.Pipeline{{}})
+			m := &monitor{
+				cfg:           &statusConfig{},
+				labeller:      labeller,
+				namespaces:    &[]string{"test"},
+				seenResources: make(resource.Group),
+				manifests:     test.manifests,
+			}
+
+			resources, _, err := m.collectResources(context.Background(), io.Discard)
+			var ids []string
+			for _, r := range resources {
+				ids = append(ids, r.ID())
+			}
+			t.CheckErrorAndDeepEqual(test.expected == nil, err, test.expected, ids)
+		})
+	}
+}
+
+func TestGetLoadBalancerServices(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	service := func(name string, svcType v1.ServiceType, annotations map[string]string) runtime.Object {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "test",
+				Labels:      map[string]string{label.RunIDLabel: labeller.GetRunID()},
+				Annotations: annotations,
+			},
+			Spec: v1.ServiceSpec{Type: svcType},
+		}
+	}
+	tests := []struct {
+		description string
+		services    []runtime.Object
+		expected    []*resource.Resource
+	}{
+		{
+			description: "annotated load balancer",
+			services: []runtime.Object{
+				service("lb", v1.ServiceTypeLoadBalancer, map[string]string{LoadBalancerAnnotation: "true"}),
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("lb", resource.ResourceTypes.Service, "test", 200*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
+			},
+		},
+		{
+			description: "load balancer not opted in",
+			services: []runtime.Object{
+				service("lb", v1.ServiceTypeLoadBalancer, nil),
+				service("lb-disabled", v1.ServiceTypeLoadBalancer, map[string]string{LoadBalancerAnnotation: "false"}),
+			},
+			expected: []*resource.Resource{},
+		},
+		{
+			description: "annotated service of other type",
+			services: []runtime.Object{
+				service("cluster-ip", v1.ServiceTypeClusterIP, map[string]string{LoadBalancerAnnotation: "true"}),
+			},
+			expected: []*resource.Resource{},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.services...)
+			actual, err := getLoadBalancerServices(context.Background(), client, "test", labeller, 200*time.Second, false)
+			t.CheckErrorAndDeepEqual(false, err, &test.expected, &actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetIngresses(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	ingress := func(name string, annotations map[string]string) runtime.Object {
+		return &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "test",
+				Labels:      map[string]string{label.RunIDLabel: labeller.GetRunID()},
+				Annotations: annotations,
+			},
+		}
+	}
+	tests := []struct {
+		description string
+		ingresses   []runtime.Object
+		expected    []*resource.Resource
+	}{
+		{
+			description: "annotated ingress",
+			ingresses: []runtime.Object{
+				ingress("web", map[string]string{IngressAnnotation: "true"}),
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("web", resource.ResourceTypes.Ingress, "test", 200*time.Second, false).WithLabels(map[string]string{label.RunIDLabel: labeller.GetRunID()}),
+			},
+		},
+		{
+			description: "ingress not opted in",
+			ingresses: []runtime.Object{
+				ingress("web", nil),
+				ingress("web-disabled", map[string]string{IngressAnnotation: "false"}),
+			},
+			expected: []*resource.Resource{},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.ingresses...)
+			actual, err := getIngresses(context.Background(), client, "test", labeller, 200*time.Second, false)
+			t.CheckErrorAndDeepEqual(false, err, &test.expected, &actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetPersistentVolumeClaims(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runIDLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	claim := func(name string, labels map[string]string) runtime.Object {
+		return &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test", Labels: labels}}
+	}
+	tests := []struct {
+		description string
+		objects     []runtime.Object
+		expected    []*resource.Resource
+	}{
+		{
+			description: "labelled claims",
+			objects: []runtime.Object{
+				claim("data", runIDLabels),
+				claim("other", nil),
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("data", resource.ResourceTypes.PersistentVolumeClaim, "test", 200*time.Second, false).WithLabels(runIDLabels),
+			},
+		},
+		{
+			description: "claims of statefulset templates",
+			objects: []runtime.Object{
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runIDLabels},
+					Spec: appsv1.StatefulSetSpec{
+						VolumeClaimTemplates: []v1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+					},
+				},
+				claim("data-db-0", nil),
+				claim("data-cache-0", nil),
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("data-db-0", resource.ResourceTypes.PersistentVolumeClaim, "test", 200*time.Second, false),
+			},
+		},
+		{
+			description: "claims of statefulset templates not created yet",
+			objects: []runtime.Object{
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runIDLabels},
+					Spec: appsv1.StatefulSetSpec{
+						Replicas:             util.Ptr[int32](2),
+						VolumeClaimTemplates: []v1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+					},
+				},
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("data-db-0", resource.ResourceTypes.PersistentVolumeClaim, "test", 200*time.Second, false),
+				resource.NewResource("data-db-1", resource.ResourceTypes.PersistentVolumeClaim, "test", 200*time.Second, false),
+			},
+		},
+		{
+			description: "claims of statefulset templates match the ordinals of the replicas",
+			objects: []runtime.Object{
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runIDLabels},
+					Spec: appsv1.StatefulSetSpec{
+						Replicas:             util.Ptr[int32](2),
+						Ordinals:             &appsv1.StatefulSetOrdinals{Start: 1},
+						VolumeClaimTemplates: []v1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+					},
+				},
+				claim("data-db-0", nil),
+				claim("data-db-1", nil),
+				claim("data-db-2", nil),
+				claim("data-db-3", nil),
+				claim("data-db-1-backup", nil),
+				claim("data-db-replica-0", nil),
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("data-db-1", resource.ResourceTypes.PersistentVolumeClaim, "test", 200*time.Second, false),
+				resource.NewResource("data-db-2", resource.ResourceTypes.PersistentVolumeClaim, "test", 200*time.Second, false),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.objects...)
+			sets, err := listStatefulSets(context.Background(), client, "test", labeller)
+			t.CheckNoError(err)
+			actual, err := getPersistentVolumeClaims(context.Background(), client, "test", sets, true, labeller, 200*time.Second, false)
+			t.CheckErrorAndDeepEqual(false, err, &test.expected, &actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetReadinessResources(t *testing.T) {
+	manifests := manifest.ManifestList{
+		[]byte("apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: migrate"),
+		[]byte("apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: seed\n  namespace: test"),
+		[]byte("apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: other\n  namespace: other"),
+		[]byte("apiVersion: cert-manager.io/v1\nkind: Certificate\nmetadata:\n  name: tls"),
+		[]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web"),
+	}
+	tests := []struct {
+		description string
+		namespace   string
+		rules       []latest.StatusCheckReadiness
+		expected    []string
+	}{
+		{
+			description: "no rules",
+			namespace:   "test",
+		},
+		{
+			description: "kind in the default namespace",
+			namespace:   "test",
+			rules:       []latest.StatusCheckReadiness{{Kind: "Job", JSONPath: "{.status.succeeded}", Value: "1"}},
+			expected:    []string{"test:job/migrate", "test:job/seed"},
+		},
+		{
+			description: "kind in another namespace",
+			namespace:   "other",
+			rules:       []latest.StatusCheckReadiness{{Kind: "Job", JSONPath: "{.status.succeeded}", Value: "1"}},
+			expected:    []string{"other:job/other"},
+		},
+		{
+			description: "kind and group",
+			namespace:   "test",
+			rules:       []latest.StatusCheckReadiness{{Kind: "certificate", Group: "cert-manager.io", JSONPath: `{.status.conditions[?(@.type=="Ready")].status}`, Value: "True"}},
+			expected:    []string{"test:certificate/tls"},
+		},
+		{
+			description: "other group",
+			namespace:   "test",
+			rules:       []latest.StatusCheckReadiness{{Kind: "Certificate", Group: "example.com", JSONPath: "{.status.ready}", Value: "true"}},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			resources, err := getReadinessResources(manifests, test.namespace, "test", 200*time.Second, false, test.rules)
+			var actual []string
+			for _, r := range resources {
+				actual = append(actual, r.String())
+			}
+			t.CheckErrorAndDeepEqual(false, err, test.expected, actual)
+		})
+	}
+}
+
+func TestGetArgoRollouts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		manifests := manifest.ManifestList{
+			[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Rollout\nmetadata:\n  name: web\nspec:\n  template:\n    spec:\n      containers:\n      - name: web\n        image: gcr.io/project/web:tag"),
+			[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Rollout\nmetadata:\n  name: other\n  namespace: other"),
+			[]byte("apiVersion: argoproj.io/v1alpha1\nkind: AnalysisTemplate\nmetadata:\n  name: success-rate"),
+			[]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: api"),
+		}
+
+		resources, err := getArgoRollouts(manifests, "test", "test", 200*time.Second, false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(resources))
+		t.CheckDeepEqual("test:rollout/web", resources[0].String())
+		t.CheckDeepEqual([]string{"gcr.io/project/web:tag"}, resources[0].Images())
+
+		// the rollouts without a namespace are only deployed to the default namespace.
+		resources, err = getArgoRollouts(manifests, "other", "test", 200*time.Second, false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(resources))
+		t.CheckDeepEqual("other:rollout/other", resources[0].String())
+	})
+}
+
+func TestDefaultNamespace(t *testing.T) {
+	tests := []struct {
+		description string
+		namespace   string
+		contextNs   string
+		expected    string
+	}{
+		{
+			description: "command line namespace",
+			namespace:   "cli",
+			contextNs:   "kubecontext-ns",
+			expected:    "cli",
+		},
+		{
+			description: "kube context namespace",
+			contextNs:   "kubecontext-ns",
+			expected:    "kubecontext-ns",
+		},
+		{
+			description: "default",
+			expected:    "default",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&kubectx.CurrentConfig, func() (api.Config, error) {
+				return api.Config{CurrentContext: TestKubeContext, Contexts: map[string]*api.Context{TestKubeContext: {Namespace: test.contextNs}}}, nil
+			})
+			cfg := &statusConfig{RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: test.namespace}}}
+			m := NewStatusMonitor(cfg, nil, &[]string{}, nil).(*monitor)
+
+			t.CheckDeepEqual(test.expected, m.defaultNamespace())
+		})
+	}
+}
+
+func TestGetReplicaSets(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	labels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	replicaSet := func(name string, owners ...metav1.OwnerReference) runtime.Object {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "test",
+				Labels:          labels,
+				OwnerReferences: owners,
+			},
+		}
+	}
+	tests := []struct {
+		description string
+		replicaSets []runtime.Object
+		expected    []*resource.Resource
+	}{
+		{
+			description: "bare replicaset",
+			replicaSets: []runtime.Object{replicaSet("bare")},
+			expected: []*resource.Resource{
+				resource.NewResource("bare", resource.ResourceTypes.ReplicaSet, "test", 200*time.Second, false).WithLabels(labels),
+			},
+		},
+		{
+			description: "replicaset of a deployment",
+			replicaSets: []runtime.Object{
+				replicaSet("dep-5d8f", metav1.OwnerReference{Kind: "Deployment", Name: "dep", Controller: utilpointer.Bool(true)}),
+			},
+			expected: []*resource.Resource{},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.replicaSets...)
+			actual, err := getReplicaSets(context.Background(), client, "test", labeller, 200*time.Second, false, "")
+			t.CheckErrorAndDeepEqual(false, err, &test.expected, &actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestDominantStatusCode(t *testing.T) {
+	withStatus := func(name string, sc proto.StatusCode) *resource.Resource {
+		r := resource.NewResource(name, resource.ResourceTypes.Deployment, "test", time.Second, false)
+		r.UpdateStatus(&proto.ActionableErr{ErrCode: sc})
+		return r
+	}
+	tests := []struct {
+		description string
+		resources   []*resource.Resource
+		expected    proto.StatusCode
+	}{
+		{
+			description: "no failure",
+			resources: []*resource.Resource{
+				withStatus("a", proto.StatusCode_STATUSCHECK_SUCCESS),
+				withStatus("b", proto.StatusCode_STATUSCHECK_USER_CANCELLED),
+			},
+		},
+		{
+			description: "most frequent failure",
+			resources: []*resource.Resource{
+				withStatus("a", proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR),
+				withStatus("b", proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED),
+				withStatus("c", proto.StatusCode_STATUSCHECK_SUCCESS),
+				withStatus("d", proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED),
+			},
+			expected: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED,
+		},
+		{
+			description: "tie",
+			resources: []*resource.Resource{
+				withStatus("a", proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING),
+				withStatus("b", proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR),
+			},
+			expected: proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, dominantStatusCode(test.resources))
+		})
+	}
+}
+
 func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description  string
@@ -319,6 +990,8 @@ func TestPrintSummaryStatus(t *testing.T) {
 		deployment  string
 		pending     int32
 		ae          *proto.ActionableErr
+		suggestions []latest.StatusCheckSuggestion
+		quiet       bool
 		expected    string
 	}{
 		{
@@ -361,6 +1034,34 @@ func TestPrintSummaryStatus(t *testing.T) {
 			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, Message: "context deadline expired"},
 			expected:    " - test:deployment/dep failed. Error: context deadline expired.\n",
 		},
+		{
+			description: "custom suggestions",
+			namespace:   "test",
+			deployment:  "dep",
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, Message: "context deadline expired"},
+			suggestions: []latest.StatusCheckSuggestion{
+				{Code: "STATUSCHECK_DEADLINE_EXCEEDED", Action: "Check the cluster capacity.", URL: "https://wiki.example.com/capacity"},
+				{Code: "STATUSCHECK_IMAGE_PULL_ERR", Action: "Check the team registry"},
+			},
+			expected: " - test:deployment/dep failed. Error: context deadline expired. Check the cluster capacity (see https://wiki.example.com/capacity).\n",
+		},
+		{
+			description: "quiet mode skips success",
+			namespace:   "test",
+			deployment:  "dep",
+			pending:     4,
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+			quiet:       true,
+			expected:    "",
+		},
+		{
+			description: "quiet mode reports failures",
+			namespace:   "test",
+			deployment:  "dep",
+			ae:          &proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, Message: "context deadline expired"},
+			quiet:       true,
+			expected:    " - test:deployment/dep failed. Error: context deadline expired.\n",
+		},
 		{
 			description: "skip printing if status check is cancelled",
 			namespace:   "test",
@@ -373,7 +1074,10 @@ func TestPrintSummaryStatus(t *testing.T) {
 
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			monitor := monitor{labeller: labeller}
+			cfg := &statusConfig{RunContext: runcontext.RunContext{
+				Pipelines: runcontext.NewPipelines(map[string]latest.Pipeline{"": {Deploy: latest.DeployConfig{StatusCheckSuggestions: test.suggestions}}}, []string{""}),
+			}}
+			monitor := monitor{cfg: cfg, labeller: labeller, quiet: test.quiet}
 			out := new(bytes.Buffer)
 			rc := newCounter(10)
 			rc.pending = test.pending
@@ -557,6 +1261,7 @@ func TestResourceMarkProcessed(t *testing.T) {
 
 func TestPollDeployment(t *testing.T) {
 	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
+	generationCmd := "kubectl --context kubecontext get deployment dep -o jsonpath={.metadata.generation} {.status.observedGeneration} {.spec.replicas} {.status.updatedReplicas} {.status.replicas} {.status.availableReplicas} {.spec.paused} --namespace test"
 	tests := []struct {
 		description string
 		dep         *resource.Resource
@@ -567,7 +1272,7 @@ func TestPollDeployment(t *testing.T) {
 		{
 			description: "pollDeploymentStatus errors out immediately when container error can't recover",
 			dep:         resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second, false),
-			command:     testutil.CmdRunOut(rolloutCmd, "Waiting for replicas to be available"),
+			command:     testutil.CmdRunOut(generationCmd, "1 1").AndRunOut(rolloutCmd, "Waiting for replicas to be available"),
 			runs: [][]validator.Resource{
 				{validator.NewResource(
 					"test",
@@ -582,10 +1287,11 @@ func TestPollDeployment(t *testing.T) {
 		{
 			description: "pollDeploymentStatus waits when a container can recover and eventually succeeds",
 			dep:         resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second, false),
-			command: testutil.CmdRunOutErr(
+			command: testutil.CmdRunOut(generationCmd, "1 1").
 				// pending due to recoverable error
-				rolloutCmd, "", errors.New("Unable to connect to the server")).
+				AndRunOutErr(rolloutCmd, "", errors.New("Unable to connect to the server")).
 				// successfully rolled out run
+				AndRunOut(generationCmd, "1 1").
 				AndRunOut(rolloutCmd, "successfully rolled out"),
 			runs: [][]validator.Resource{
 				// pod pending due to some k8 infra related recoverable error.
@@ -615,6 +1321,44 @@ func TestPollDeployment(t *testing.T) {
 	}
 }
 
+func TestAdaptivePollPeriod(t *testing.T) {
+	tests := []struct {
+		description    string
+		unchangedTicks int
+		expected       time.Duration
+	}{
+		{
+			description: "status just changed",
+			expected:    time.Second,
+		},
+		{
+			description:    "a few unchanged polls",
+			unchangedTicks: 2,
+			expected:       time.Second,
+		},
+		{
+			description:    "backs off after unchanged polls",
+			unchangedTicks: 3,
+			expected:       2 * time.Second,
+		},
+		{
+			description:    "keeps backing off",
+			unchangedTicks: 7,
+			expected:       4 * time.Second,
+		},
+		{
+			description:    "capped",
+			unchangedTicks: 100,
+			expected:       8 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, adaptivePollPeriod(time.Second, test.unchangedTicks))
+		})
+	}
+}
+
 type mockValidator struct {
 	runs      [][]validator.Resource
 	iteration int
@@ -636,6 +1380,57 @@ func (m mockValidator) WithValidators([]validator.Validator) diag.Diagnose {
 	return m
 }
 
+func TestCheckKubectlBinary(t *testing.T) {
+	tests := []struct {
+		description string
+		binary      string
+		found       bool
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "kubectl from the PATH",
+		},
+		{
+			description: "kubectl binary",
+			binary:      "/opt/bin/kubectl",
+			found:       true,
+			expected:    "Using kubectl 1.29 from /opt/bin/kubectl for the status check\n",
+		},
+		{
+			description: "kubectl binary not found",
+			binary:      "/opt/bin/kubectl",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&lookPath, func(file string) (string, error) {
+				if !test.found {
+					return "", errors.New("not found")
+				}
+				return file, nil
+			})
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(
+				"/opt/bin/kubectl version --client -ojson",
+				`{"clientVersion":{"major":"1","minor":"29"}}`,
+			))
+			m := &monitor{cfg: &statusConfig{}, kubectlBinary: test.binary}
+
+			var out bytes.Buffer
+			err := m.checkKubectlBinary(context.Background(), &out)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, out.String())
+
+			// the version is only reported once.
+			out.Reset()
+			if !test.shouldErr {
+				t.CheckNoError(m.checkKubectlBinary(context.Background(), &out))
+				t.CheckEmpty(out.String())
+			}
+		})
+	}
+}
+
 type statusConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 }
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// timedOut returns whether the status check of any resource exceeded its deadline.
+func timedOut(resources []*resource.Resource) bool {
+	for _, r := range resources {
+		if r.Status().ActionableError().ErrCode == proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED {
+			return true
+		}
+	}
+	return false
+}
+
+// printTimeoutReport classifies each resource that did not stabilize as either never ready, or ready
+// then unhealthy, and lists its status transitions relative to the first one.
+func printTimeoutReport(out io.Writer, resources []*resource.Resource) {
+	fmt.Fprintln(out, "Status check timed out. Resources that did not stabilize:")
+	for _, r := range resources {
+		switch r.StatusCode() {
+		case proto.StatusCode_STATUSCHECK_SUCCESS, proto.StatusCode_STATUSCHECK_USER_CANCELLED:
+			continue
+		}
+		fmt.Fprintf(out, "%s %s %s.\n", tabHeader, r, r.Outcome())
+		transitions := r.History()
+		for _, t := range transitions {
+			fmt.Fprintf(out, "     +%s %s", t.Time.Sub(transitions[0].Time).Round(time.Second), t.Code)
+			if t.Message != "" {
+				fmt.Fprintf(out, ": %s", t.Message)
+			}
+			fmt.Fprintln(out)
+		}
+	}
+}
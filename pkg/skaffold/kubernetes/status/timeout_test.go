@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestPrintTimeoutReport(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		ready := withStatus(resource.NewResource("ready", resource.ResourceTypes.Deployment, "test", time.Second, false),
+			&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS})
+		pending := resource.NewResource("pending", resource.ResourceTypes.Deployment, "test", time.Second, false)
+		pending.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: "waiting for rollout\n"})
+		pending.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, Message: "could not stabilize within 1s\n"})
+		crashing := resource.NewResource("crashing", resource.ResourceTypes.Deployment, "test", time.Second, false)
+		crashing.WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_SUCCESS})
+		crashing.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: "waiting for rollout\n"})
+		crashing.WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING})
+		crashing.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, Message: "could not stabilize within 1s\n"})
+		resources := []*resource.Resource{ready, pending, crashing}
+
+		t.CheckTrue(timedOut(resources))
+
+		var out bytes.Buffer
+		printTimeoutReport(&out, resources)
+
+		t.CheckDeepEqual(`Status check timed out. Resources that did not stabilize:
+ - test:deployment/pending never became ready.
+     +0s STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING: waiting for rollout
+     +0s STATUSCHECK_DEADLINE_EXCEEDED: could not stabilize within 1s
+ - test:deployment/crashing became ready, then went unhealthy.
+     +0s STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING: waiting for rollout
+     +0s STATUSCHECK_CONTAINER_RESTARTING: pod failed
+`, out.String())
+	})
+}
+
+func TestTimedOut(t *testing.T) {
+	resources := []*resource.Resource{
+		withStatus(resource.NewResource("failed", resource.ResourceTypes.Deployment, "test", time.Second, false),
+			&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}),
+	}
+
+	testutil.CheckDeepEqual(t, false, timedOut(resources))
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+	sterm "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/term"
+)
+
+// treeRefreshTime is the period at which the tree of resources is redrawn.
+const treeRefreshTime = time.Second
+
+// statusTree draws a frame of lines at the bottom of a terminal, and redraws it in place.
+// Lines written to the tree are printed above the frame.
+type statusTree struct {
+	mu sync.Mutex
+	// out receives the lines written to the tree, term receives the frames.
+	out     io.Writer
+	term    io.Writer
+	width   int
+	frame   []string
+	stopped bool
+}
+
+// newStatusTree returns a tree drawn on the terminal underlying out, or false if out isn't a terminal.
+func newStatusTree(out io.Writer) (*statusTree, bool) {
+	w := output.GetUnderlyingWriter(out)
+	fd, isTerm := sterm.IsTerminal(w)
+	if !isTerm {
+		return nil, false
+	}
+	width, _, err := term.GetSize(int(fd))
+	if err != nil {
+		width = 0
+	}
+	return &statusTree{out: out, term: w, width: width}, true
+}
+
+func (t *statusTree) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped {
+		return t.out.Write(p)
+	}
+	t.erase()
+	n, err := t.out.Write(p)
+	t.print()
+	return n, err
+}
+
+// draw replaces the frame with the lines.
+func (t *statusTree) draw(lines []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped {
+		return
+	}
+	t.erase()
+	t.frame = lines
+	t.print()
+}
+
+// stop leaves the last frame on the terminal. The lines written to the tree are then printed below it.
+func (t *statusTree) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stopped = true
+}
+
+// erase moves the cursor back to the first line of the frame and clears the screen below.
+func (t *statusTree) erase() {
+	if len(t.frame) > 0 {
+		fmt.Fprintf(t.term, "\x1b[%dA\r\x1b[J", len(t.frame))
+	}
+}
+
+func (t *statusTree) print() {
+	for _, line := range t.frame {
+		// lines wrapping around would offset the next erase.
+		if t.width > 0 && len(line) >= t.width {
+			line = line[:t.width-1]
+		}
+		fmt.Fprintln(t.term, line)
+	}
+}
+
+// resourceTree returns the lines of the tree of the resources, their pods and their current status.
+func resourceTree(resources []*resource.Resource) []string {
+	var lines []string
+	for _, r := range resources {
+		lines = append(lines, treeLine(tabHeader, r.String(), r.StatusCode().String(), r.Status().String()))
+		for _, p := range r.Pods() {
+			ae := p.ActionableError()
+			lines = append(lines, treeLine("  "+tabHeader, p.String(), ae.GetErrCode().String(), ae.GetMessage()))
+		}
+	}
+	return lines
+}
+
+func treeLine(prefix, name, code, message string) string {
+	line := fmt.Sprintf("%s %s: %s", prefix, name, code)
+	if message = strings.TrimSpace(strings.ReplaceAll(message, "\n", " ")); message != "" {
+		line += " " + message
+	}
+	return line
+}
+
+// printResourceTree redraws the tree of the resources until all of them are done. The status updates
+// are still sent as events.
+func (s *monitor) printResourceTree(ctx context.Context, tree *statusTree, resources []*resource.Resource) {
+	ticker := time.NewTicker(treeRefreshTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			allDone := s.printStatus(resources, io.Discard)
+			tree.draw(resourceTree(resources))
+			if allDone {
+				return
+			}
+		}
+	}
+}
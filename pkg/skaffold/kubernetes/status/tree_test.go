@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestStatusTree(t *testing.T) {
+	var out bytes.Buffer
+	tree := &statusTree{out: &out, term: &out, width: 20}
+
+	tree.draw([]string{"a", "b"})
+	tree.Write([]byte("summary\n"))
+	tree.draw([]string{"a very long line that wraps"})
+	tree.stop()
+	tree.draw([]string{"ignored"})
+	tree.Write([]byte("report\n"))
+
+	testutil.CheckDeepEqual(t, "a\nb\n"+
+		"\x1b[2A\r\x1b[Jsummary\na\nb\n"+
+		"\x1b[2A\r\x1b[Ja very long line th\n"+
+		"report\n", out.String())
+}
+
+func TestNewStatusTreeNotTerminal(t *testing.T) {
+	_, isTerm := newStatusTree(&bytes.Buffer{})
+
+	testutil.CheckDeepEqual(t, false, isTerm)
+}
+
+func TestResourceTree(t *testing.T) {
+	pending := resource.NewResource("app", resource.ResourceTypes.Deployment, "test", time.Second, false)
+	pending.UpdateStatus(&proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+		Message: "waiting for rollout to finish: 0 of 1 updated replicas are available...\n",
+	})
+	done := resource.NewResource("db", resource.ResourceTypes.StatefulSet, "default", time.Second, false)
+	done.UpdateStatus(&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS})
+
+	testutil.CheckDeepEqual(t, []string{
+		" - test:deployment/app: STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING waiting for rollout to finish: 0 of 1 updated replicas are available...",
+		" - statefulset/db: STATUSCHECK_SUCCESS",
+	}, resourceTree([]*resource.Resource{pending, done}))
+}
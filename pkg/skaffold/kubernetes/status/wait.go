@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// ResourcesError aggregates the status check failures of the tracked resources.
+// It preserves the actionable error reported by each resource, keyed by resource name.
+type ResourcesError struct {
+	Total     int
+	Resources map[string]*proto.ActionableErr
+}
+
+func (e *ResourcesError) Error() string {
+	names := e.names()
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = fmt.Sprintf("%s: %s", name, trimNewLine(e.Resources[name].Message))
+	}
+	return fmt.Sprintf("%d/%d resource(s) failed: %s", len(names), e.Total, strings.Join(msgs, "; "))
+}
+
+// StatusCode returns the status code of the first failed resource, ordered by name.
+func (e *ResourcesError) StatusCode() proto.StatusCode {
+	if names := e.names(); len(names) > 0 {
+		return e.Resources[names[0]].ErrCode
+	}
+	return proto.StatusCode_STATUSCHECK_INTERNAL_ERROR
+}
+
+// Suggestions returns the suggestions of all the failed resources.
+func (e *ResourcesError) Suggestions() []*proto.Suggestion {
+	var suggestions []*proto.Suggestion
+	for _, name := range e.names() {
+		suggestions = append(suggestions, e.Resources[name].Suggestions...)
+	}
+	return suggestions
+}
+
+func (e *ResourcesError) names() []string {
+	names := make([]string, 0, len(e.Resources))
+	for name := range e.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WaitForAllResources lists the resources deployed by the current run and blocks until every one of them
+// completes its status check, or the context is done. It doesn't depend on a status check being in progress,
+// and tracks its own copy of the resources. Resources declaring dependencies are only waited for once the
+// resources they depend on completed. It returns the context error if the context is done before all the
+// resources completed, and a *ResourcesError if any resource did not stabilize.
+func (s *monitor) WaitForAllResources(ctx context.Context) error {
+	resources, _, _, err := s.listResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := s.resourceOptions()
+	deps := newDependencies(ctx, resources)
+	var wg sync.WaitGroup
+	for _, r := range resources {
+		r.WithOptions(opts)
+		wg.Add(1)
+		go func(r *resource.Resource) {
+			defer wg.Done()
+			defer deps.complete(r)
+			deps.wait(ctx, r)
+			pollResourceStatus(ctx, s.cfg, r)
+		}(r)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return aggregateErrors(s.cfg, resources)
+}
+
+func aggregateErrors(cfg Config, resources []*resource.Resource) error {
+	failed := map[string]*proto.ActionableErr{}
+	for _, r := range resources {
+		if r.StatusCode() == proto.StatusCode_STATUSCHECK_SUCCESS {
+			continue
+		}
+		failed[r.String()] = sErrors.WithCustomSuggestions(cfg, r.Status().ActionableError(), r.StatusCode())
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &ResourcesError{Total: len(resources), Resources: failed}
+}
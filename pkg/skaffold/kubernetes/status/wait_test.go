@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/testing/protocmp"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	fakedynclient "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestAggregateErrors(t *testing.T) {
+	imagePullErr := &proto.ActionableErr{
+		ErrCode:     proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR,
+		Message:     "image not found\n",
+		Suggestions: []*proto.Suggestion{{SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_IMAGE, Action: "Check the image"}},
+	}
+	tests := []struct {
+		description string
+		resources   []*resource.Resource
+		expected    map[string]*proto.ActionableErr
+		expectedErr proto.StatusCode
+	}{
+		{
+			description: "all resources succeeded",
+			resources: []*resource.Resource{
+				withStatus(resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", time.Second, false),
+					&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}),
+			},
+		},
+		{
+			description: "failed resources are aggregated",
+			resources: []*resource.Resource{
+				withStatus(resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", time.Second, false),
+					&proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}),
+				withStatus(resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", time.Second, false), imagePullErr),
+			},
+			expected:    map[string]*proto.ActionableErr{"test:deployment/r2": imagePullErr},
+			expectedErr: proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := aggregateErrors(nil, test.resources)
+			if test.expected == nil {
+				t.CheckNoError(err)
+				return
+			}
+			rErr, ok := err.(*ResourcesError)
+			t.CheckTrue(ok)
+			t.CheckDeepEqual(len(test.resources), rErr.Total)
+			t.CheckDeepEqual(test.expected, rErr.Resources, protocmp.Transform())
+			t.CheckDeepEqual(test.expectedErr, rErr.StatusCode())
+		})
+	}
+}
+
+func TestWaitForAllResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	kubectl := "/opt/kubectl-1.30 --context kubecontext"
+	tests := []struct {
+		description   string
+		objs          []runtime.Object
+		kubectlBinary string
+		command       util.Command
+		cancelled     bool
+		expected      map[string]*proto.ActionableErr
+		expectedErr   error
+	}{
+		{
+			description: "no resources deployed by the run",
+			objs: []runtime.Object{
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "other-run", Namespace: "test", Labels: map[string]string{label.RunIDLabel: "other"}}},
+			},
+		},
+		{
+			description: "pending resources return the context error",
+			objs: []runtime.Object{
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "r1", Namespace: "test", Labels: runLabels}},
+			},
+			cancelled:   true,
+			expectedErr: context.Canceled,
+		},
+		{
+			description: "resources are checked with the configured kubectl binary",
+			objs: []runtime.Object{
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "r1", Namespace: "test", Labels: runLabels}},
+			},
+			kubectlBinary: "/opt/kubectl-1.30",
+			command: testutil.CmdRunOut(kubectl+" get deployment r1 -o jsonpath={.metadata.generation} {.status.observedGeneration} {.spec.replicas} {.status.updatedReplicas} {.status.replicas} {.status.availableReplicas} {.spec.paused} --namespace test", "1 1").
+				AndRunOut(kubectl+" rollout status deployment r1 --namespace test --watch=false", "successfully rolled out"),
+		},
+		{
+			description: "resources are checked after their dependencies",
+			objs: []runtime.Object{
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "r2", Namespace: "test", Labels: runLabels, Annotations: map[string]string{resource.DependsOnAnnotation: "deployment/r1"}}},
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "r1", Namespace: "test", Labels: runLabels}},
+			},
+			command: testutil.CmdRunOut("kubectl --context kubecontext get deployment r1 -o jsonpath={.metadata.generation} {.status.observedGeneration} {.spec.replicas} {.status.updatedReplicas} {.status.replicas} {.status.availableReplicas} {.spec.paused} --namespace test", "1 1").
+				AndRunOut("kubectl --context kubecontext rollout status deployment r1 --namespace test --watch=false", "successfully rolled out").
+				AndRunOut("kubectl --context kubecontext get deployment r2 -o jsonpath={.metadata.generation} {.status.observedGeneration} {.spec.replicas} {.status.updatedReplicas} {.status.replicas} {.status.availableReplicas} {.spec.paused} --namespace test", "1 1").
+				AndRunOut("kubectl --context kubecontext rollout status deployment r2 --namespace test --watch=false", "successfully rolled out"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&kubernetesclient.Client, func(string) (kubernetes.Interface, error) {
+				return fakekubeclientset.NewSimpleClientset(test.objs...), nil
+			})
+			t.Override(&kubernetesclient.DynamicClient, func(string) (dynamic.Interface, error) {
+				return fakedynclient.NewSimpleDynamicClient(scheme.Scheme), nil
+			})
+			m := &monitor{
+				cfg:           &statusConfig{},
+				labeller:      labeller,
+				namespaces:    &[]string{"test"},
+				seenResources: make(resource.Group),
+				kubectlBinary: test.kubectlBinary,
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if test.command != nil {
+				t.Override(&util.DefaultExecCommand, test.command)
+			}
+			if test.cancelled {
+				cancel()
+			}
+			defer cancel()
+
+			err := m.WaitForAllResources(ctx)
+			if test.expectedErr != nil {
+				t.CheckTrue(errors.Is(err, test.expectedErr))
+				return
+			}
+			if test.expected == nil {
+				t.CheckNoError(err)
+				return
+			}
+			rErr, ok := err.(*ResourcesError)
+			t.CheckTrue(ok)
+			t.CheckDeepEqual(test.expected, rErr.Resources, protocmp.Transform())
+			t.CheckDeepEqual(0, len(m.seenResources))
+		})
+	}
+}
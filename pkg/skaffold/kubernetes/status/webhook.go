@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/version"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+var (
+	// webhookInterval is the minimum interval between two webhook requests.
+	webhookInterval = 100 * time.Millisecond
+	// webhookRetryDelay is the delay before the first retry of a failed webhook request, doubled on each retry.
+	webhookRetryDelay = time.Second
+	webhookTimeout    = 10 * time.Second
+)
+
+// webhookAttempts is the number of times a webhook request is sent before giving up.
+const webhookAttempts = 3
+
+// webhookPayload is the JSON payload posted to the status check webhook when a resource completes or fails.
+type webhookPayload struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Code      string `json:"code"`
+	Message   string `json:"message,omitempty"`
+}
+
+// webhook notifies an external system of the resources that complete or fail their status check.
+// The requests are sent in the background, rate limited and retried on errors.
+type webhook struct {
+	url    string
+	client *http.Client
+
+	mu   sync.Mutex
+	next time.Time
+	wg   sync.WaitGroup
+}
+
+func newWebhook(url string) *webhook {
+	return &webhook{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// notify posts the status of the resource to the webhook in the background, unless its status check was cancelled.
+func (w *webhook) notify(ctx context.Context, r *resource.Resource) {
+	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+		return
+	}
+	payload := webhookPayload{
+		Kind:      string(r.Type()),
+		Name:      r.Name(),
+		Namespace: r.Namespace(),
+		Code:      r.StatusCode().String(),
+	}
+	if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
+		payload.Message = trimNewLine(r.StatusMessage())
+	}
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		if err := w.post(ctx, payload); err != nil {
+			log.Entry(ctx).Warnf("could not notify status check webhook of %s: %v", r, err)
+		}
+	}()
+}
+
+// wait waits for the pending webhook requests.
+func (w *webhook) wait() {
+	w.wg.Wait()
+}
+
+// post sends the payload to the webhook, retrying with an exponential backoff on connection errors and server errors.
+func (w *webhook) post(ctx context.Context, payload webhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshalling webhook payload: %w", err)
+	}
+	delay := webhookRetryDelay
+	for attempt := 1; ; attempt++ {
+		if err = w.throttle(ctx); err != nil {
+			return err
+		}
+		var retry bool
+		if retry, err = w.send(ctx, body); err == nil || !retry || attempt == webhookAttempts {
+			return err
+		}
+		log.Entry(ctx).Debugf("retrying status check webhook request in %v: %v", delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// send sends a single webhook request and returns whether it can be retried if it failed.
+func (w *webhook) send(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("creating webhook request: %w", withoutURL(err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", version.UserAgentWithClient())
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("sending webhook request: %w", withoutURL(err))
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		retry := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		return retry, fmt.Errorf("webhook responded with http status %q", resp.Status)
+	}
+	return false, nil
+}
+
+// withoutURL strips the URL from url errors, as the webhook URL may contain a token that shouldn't be logged.
+func withoutURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+// throttle waits until the next webhook request is allowed by the rate limit.
+func (w *webhook) throttle(ctx context.Context) error {
+	w.mu.Lock()
+	now := time.Now()
+	at := w.next
+	if at.Before(now) {
+		at = now
+	}
+	w.next = at.Add(webhookInterval)
+	w.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(at)):
+		return nil
+	}
+}
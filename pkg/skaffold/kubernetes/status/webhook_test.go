@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status/resource"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestWebhookNotify(t *testing.T) {
+	tests := []struct {
+		description string
+		responses   []int
+		code        proto.StatusCode
+		message     string
+		expected    []webhookPayload
+		requests    int
+	}{
+		{
+			description: "resource ready",
+			responses:   []int{http.StatusOK},
+			code:        proto.StatusCode_STATUSCHECK_SUCCESS,
+			expected:    []webhookPayload{{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_SUCCESS"}},
+			requests:    1,
+		},
+		{
+			description: "resource failed",
+			responses:   []int{http.StatusOK},
+			code:        proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR,
+			message:     "image can't be pulled\n",
+			expected:    []webhookPayload{{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_IMAGE_PULL_ERR", Message: "image can't be pulled"}},
+			requests:    1,
+		},
+		{
+			description: "retry on server error",
+			responses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			code:        proto.StatusCode_STATUSCHECK_SUCCESS,
+			expected: []webhookPayload{
+				{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_SUCCESS"},
+				{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_SUCCESS"},
+			},
+			requests: 2,
+		},
+		{
+			description: "give up after the last attempt",
+			responses:   []int{http.StatusInternalServerError},
+			code:        proto.StatusCode_STATUSCHECK_SUCCESS,
+			expected: []webhookPayload{
+				{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_SUCCESS"},
+				{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_SUCCESS"},
+				{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_SUCCESS"},
+			},
+			requests: webhookAttempts,
+		},
+		{
+			description: "no retry on client error",
+			responses:   []int{http.StatusBadRequest},
+			code:        proto.StatusCode_STATUSCHECK_SUCCESS,
+			expected:    []webhookPayload{{Kind: "deployment", Name: "dep", Namespace: "test", Code: "STATUSCHECK_SUCCESS"}},
+			requests:    1,
+		},
+		{
+			description: "cancelled check isn't notified",
+			code:        proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&webhookInterval, time.Millisecond)
+			t.Override(&webhookRetryDelay, time.Millisecond)
+
+			var mu sync.Mutex
+			var payloads []webhookPayload
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				var p webhookPayload
+				t.CheckNoError(json.NewDecoder(r.Body).Decode(&p))
+				t.CheckDeepEqual("application/json", r.Header.Get("Content-Type"))
+				payloads = append(payloads, p)
+				status := test.responses[len(test.responses)-1]
+				if len(payloads) <= len(test.responses) {
+					status = test.responses[len(payloads)-1]
+				}
+				w.WriteHeader(status)
+			}))
+			defer ts.Close()
+
+			r := withStatus(resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second, false),
+				&proto.ActionableErr{ErrCode: test.code, Message: test.message})
+			w := newWebhook(ts.URL)
+			w.notify(context.Background(), r)
+			w.wait()
+
+			t.CheckDeepEqual(test.requests, len(payloads))
+			t.CheckDeepEqual(test.expected, payloads)
+		})
+	}
+}
+
+func TestWebhookErrorsDontLeakURL(t *testing.T) {
+	tests := []struct {
+		description string
+		url         string
+		handler     http.HandlerFunc
+	}{
+		{
+			description: "connection error",
+			url:         "http://127.0.0.1:1/hooks/secret-token",
+		},
+		{
+			description: "invalid url",
+			url:         "http://[::1/hooks/secret-token",
+		},
+		{
+			description: "error response",
+			handler:     func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusForbidden) },
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			hookURL := test.url
+			if test.handler != nil {
+				ts := httptest.NewServer(test.handler)
+				defer ts.Close()
+				hookURL = ts.URL + "/hooks/secret-token"
+			}
+
+			_, err := newWebhook(hookURL).send(context.Background(), []byte("{}"))
+
+			t.CheckError(true, err)
+			t.CheckFalse(strings.Contains(err.Error(), "secret-token"))
+		})
+	}
+}
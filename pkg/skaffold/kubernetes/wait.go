@@ -99,6 +99,44 @@ func isPodSucceeded(podName string) func(event *watch.Event) (bool, error) {
 	}
 }
 
+// WaitForPodRunning waits until the Pod status is Running.
+func WaitForPodRunning(ctx context.Context, pods corev1.PodInterface, podName string, timeout time.Duration) error {
+	log.Entry(ctx).Infof("Waiting for %s to be running", podName)
+
+	w, err := newPodsWatcher(ctx, pods)
+	if err != nil {
+		return fmt.Errorf("initializing pod watcher: %s", err)
+	}
+	defer w.Stop()
+
+	return watchUntilTimeout(ctx, timeout, w, isPodRunning(podName))
+}
+
+func isPodRunning(podName string) func(event *watch.Event) (bool, error) {
+	return func(event *watch.Event) (bool, error) {
+		if event.Object == nil {
+			return false, nil
+		}
+		pod, isPod := event.Object.(*v1.Pod)
+		if !isPod {
+			return false, nil
+		}
+		if pod.Name != podName {
+			return false, nil
+		}
+
+		switch pod.Status.Phase {
+		case v1.PodRunning:
+			return true, nil
+		case v1.PodSucceeded, v1.PodFailed:
+			return false, fmt.Errorf("pod has terminated with phase %s", pod.Status.Phase)
+		case v1.PodUnknown, v1.PodPending:
+			return false, nil
+		}
+		return false, fmt.Errorf("unknown phase: %s", pod.Status.Phase)
+	}
+}
+
 // WaitForPodInitialized waits until init containers have started running
 func WaitForPodInitialized(ctx context.Context, pods corev1.PodInterface, podName string) error {
 	log.Entry(ctx).Infof("Waiting for %s to be initialized", podName)
@@ -160,3 +160,51 @@ func TestIsPodSucceeded(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPodRunning(t *testing.T) {
+	tests := []struct {
+		description string
+		podName     string
+		phase       v1.PodPhase
+		shouldErr   bool
+		expected    bool
+	}{
+		{
+			description: "pod name doesn't match",
+			podName:     "another-pod",
+		}, {
+			description: "pod phase is PodRunning",
+			phase:       v1.PodRunning,
+			expected:    true,
+		}, {
+			description: "pod phase is PodPending",
+			phase:       v1.PodPending,
+		}, {
+			description: "pod phase is PodSucceeded",
+			phase:       v1.PodSucceeded,
+			shouldErr:   true,
+		}, {
+			description: "pod phase is PodFailed",
+			phase:       v1.PodFailed,
+			shouldErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod := &v1.Pod{
+				Status: v1.PodStatus{
+					Phase: test.phase,
+				},
+			}
+			dummyEvent := &watch.Event{
+				Type:   "dummyEvent",
+				Object: pod,
+			}
+
+			actual, err := isPodRunning(test.podName)(dummyEvent)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, actual, test.expected)
+		})
+	}
+}
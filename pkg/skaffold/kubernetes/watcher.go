@@ -30,6 +30,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 )
 
+// UDPRelayLabel is set on the relay pods of UDP port forwards.
+const UDPRelayLabel = "skaffold.dev/udp-relay"
+
 type PodWatcher interface {
 	Register(receiver chan<- PodEvent)
 	Deregister(receiver chan<- PodEvent)
@@ -134,6 +137,10 @@ func (w *podWatcher) Start(ctx context.Context, kubeContext string, namespaces [
 						continue
 					}
 
+					// The relay pods of UDP port forwards are neither logged nor port forwarded.
+					if _, found := pod.Labels[UDPRelayLabel]; found {
+						continue
+					}
 					if !w.podSelector.Select(pod) {
 						continue
 					}
@@ -37,6 +37,10 @@ func pod(name string) *v1.Pod {
 	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
 }
 
+func relayPod(name string) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{UDPRelayLabel: "1234"}}}
+}
+
 func service(name string) *v1.Service {
 	return &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: name}}
 }
@@ -99,7 +103,7 @@ func TestPodWatcher(t *testing.T) {
 		t.Override(&client.Client, func(string) (kubernetes.Interface, error) { return clientset, nil })
 
 		podSelector := &hasName{
-			validNames: []string{"pod1", "pod2", "pod3"},
+			validNames: []string{"pod1", "pod2", "pod3", "relay"},
 		}
 		events := make(chan PodEvent)
 		watcher := NewPodWatcher(podSelector)
@@ -113,6 +117,7 @@ func TestPodWatcher(t *testing.T) {
 		clientset.CoreV1().Pods("ignored").Create(context.Background(), pod("ignored"), metav1.CreateOptions{})     // Different namespace
 		clientset.CoreV1().Services("ns1").Create(context.Background(), service("ignored"), metav1.CreateOptions{}) // Not a pod
 		clientset.CoreV1().Pods("ns2").Create(context.Background(), pod("ignored"), metav1.CreateOptions{})         // Rejected by podSelector
+		clientset.CoreV1().Pods("ns2").Create(context.Background(), relayPod("relay"), metav1.CreateOptions{})      // UDP relay pod
 		clientset.CoreV1().Pods("ns2").Create(context.Background(), pod("pod2"), metav1.CreateOptions{})
 		clientset.CoreV1().Pods("ns2").Create(context.Background(), pod("pod3"), metav1.CreateOptions{})
 
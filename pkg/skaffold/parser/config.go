@@ -117,7 +117,7 @@ func GetConfigSet(ctx context.Context, opts config.SkaffoldOptions) (SkaffoldCon
 func getConfigs(ctx context.Context, cfgOpts configOpts, opts config.SkaffoldOptions, r *record) (SkaffoldConfigSet, map[string]configlocations.YAMLOverrideInfo, error) {
 	fieldsOverrodeByProfile := map[string]configlocations.YAMLOverrideInfo{}
 
-	parsed, err := schema.ParseConfigAndUpgrade(cfgOpts.file)
+	parsed, err := schema.ParseConfigAndUpgradeWithEnv(cfgOpts.file, schema.EnvExpansion{Enabled: opts.ExpandEnv, Strict: opts.ExpandEnvStrict})
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil, nil, sErrors.MainConfigFileNotFoundErr(cfgOpts.file, err)
@@ -34,6 +34,7 @@ type Config interface {
 	Mode() config.RunMode
 	EnablePlatformNodeAffinityInRenderedManifests() bool
 	EnableGKEARMNodeTolerationInRenderedManifests() bool
+	AllowBaseDrift() bool
 }
 
 type MockConfig struct {
@@ -53,3 +54,4 @@ func (mc MockConfig) EnablePlatformNodeAffinityInRenderedManifests() bool { retu
 func (mc MockConfig) EnableGKEARMNodeTolerationInRenderedManifests() bool { return true }
 func (mc MockConfig) GetKubeNamespace() string                            { return "" }
 func (mc MockConfig) GetNamespace() string                                { return mc.Namespace }
+func (mc MockConfig) AllowBaseDrift() bool                                { return false }
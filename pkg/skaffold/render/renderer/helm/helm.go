@@ -157,7 +157,17 @@ func (h Helm) generateHelmManifests(ctx context.Context, builds []graph.Artifact
 	}
 
 	for _, release := range h.config.Releases {
-		m, err := h.generateHelmManifest(ctx, builds, release, helmEnv, postRendererArgs)
+		env, args := helmEnv, postRendererArgs
+		if release.PostRenderer != "" {
+			var cleanup func()
+			var err error
+			env, args, cleanup, err = h.releasePostRenderer(ctx, builds, release.PostRenderer)
+			if err != nil {
+				return nil, err
+			}
+			defer cleanup()
+		}
+		m, err := h.generateHelmManifest(ctx, builds, release, env, args)
 		if err != nil {
 			return nil, err
 		}
@@ -172,6 +182,31 @@ func (h Helm) generateHelmManifests(ctx context.Context, builds []graph.Artifact
 	return manifests, nil
 }
 
+// releasePostRenderer returns the environment and the arguments of `helm template` to run the post-renderer
+// of a release inside `skaffold filter`, since Helm only supports one post-renderer.
+func (h Helm) releasePostRenderer(ctx context.Context, builds []graph.Artifact, postRenderer string) ([]string, []string, func(), error) {
+	skaffoldBinary, filterEnv, cleanupFilter, err := helm.PrepareSkaffoldFilter(h, builds, []string{"--post-renderer", postRenderer})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not prepare `skaffold filter`: %w", err)
+	}
+	cleanUpPostRenderer, args, err := helm.PreparePostRenderer(ctx, h, skaffoldBinary, h.helmVersion)
+	if err != nil {
+		if cleanupFilter != nil {
+			cleanupFilter()
+		}
+		return nil, nil, nil, err
+	}
+	cleanup := func() {
+		if cleanUpPostRenderer != nil {
+			cleanUpPostRenderer()
+		}
+		if cleanupFilter != nil {
+			cleanupFilter()
+		}
+	}
+	return append(sUtil.OSEnviron(), filterEnv...), args, cleanup, nil
+}
+
 func (h Helm) generateHelmManifest(ctx context.Context, builds []graph.Artifact, release latest.HelmRelease, env, additionalArgs []string) ([]byte, error) {
 	releaseName, err := sUtil.ExpandEnvTemplateOrFail(release.Name, nil)
 	if err != nil {
@@ -183,6 +218,11 @@ func (h Helm) generateHelmManifest(ctx context.Context, builds []graph.Artifact,
 		return nil, helm.UserErr(fmt.Sprintf("cannot expand chart path %q", release.ChartPath), err)
 	}
 
+	release.RemoteChart, release.Version, err = helm.ResolveOCIChart(ctx, h, release.RemoteChart, release.Version)
+	if err != nil {
+		return nil, helm.UserErr("resolving OCI chart", err)
+	}
+
 	namespace, err := helm.ReleaseNamespace(h.namespace, release)
 	if err != nil {
 		return nil, err
@@ -35,6 +35,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/applysetters"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/generate"
@@ -63,6 +64,9 @@ type Kustomize struct {
 	validator          validate.Validator
 	transformAllowlist map[apimachinery.GroupKind]latest.ResourceFilter
 	transformDenylist  map[apimachinery.GroupKind]latest.ResourceFilter
+
+	// pinnedBases are the commits that the remote targets are built from, when a remote bases lock file is set.
+	pinnedBases map[string]string
 }
 
 func (k Kustomize) Render(ctx context.Context, out io.Writer, builds []graph.Artifact, offline bool) (manifest.ManifestListByConfig, error) {
@@ -71,6 +75,8 @@ func (k Kustomize) Render(ctx context.Context, out io.Writer, builds []graph.Art
 	useKubectlKustomize := !generate.KustomizeBinaryCheck() && generate.KubectlVersionCheck(kCLI)
 
 	var kustomizePaths []string
+	// sources tracks the kustomization path of each rendered manifest.
+	var sources []string
 	for _, kustomizePath := range k.rCfg.Kustomize.Paths {
 		kPath, err := sUtil.ExpandEnvTemplate(kustomizePath, nil)
 		if err != nil {
@@ -79,8 +85,19 @@ func (k Kustomize) Render(ctx context.Context, out io.Writer, builds []graph.Art
 		kustomizePaths = append(kustomizePaths, kPath)
 	}
 
-	for _, kustomizePath := range kustomizePaths {
-		if !sUtil.IsURL(kustomizePath) && !filepath.IsAbs(kustomizePath) {
+	if k.rCfg.Kustomize.RemoteBasesLockFile != "" {
+		pinned, err := k.verifyRemoteBases(ctx, out, kustomizePaths, offline)
+		if err != nil {
+			return manifest.ManifestListByConfig{}, err
+		}
+		k.pinnedBases = pinned
+	}
+
+	for _, kPath := range kustomizePaths {
+		kustomizePath := kPath
+		if commit, found := k.pinnedBases[kPath]; found {
+			kustomizePath = pinRef(kPath, commit)
+		} else if !sUtil.IsURL(kustomizePath) && !filepath.IsAbs(kustomizePath) {
 			kustomizePath = filepath.Join(k.cfg.GetWorkingDir(), kustomizePath)
 		}
 		out, err := k.render(ctx, kustomizePath, useKubectlKustomize, kCLI)
@@ -90,7 +107,11 @@ func (k Kustomize) Render(ctx context.Context, out io.Writer, builds []graph.Art
 		if len(out) == 0 {
 			continue
 		}
+		count := len(manifests)
 		manifests.Append(out)
+		for i := count; i < len(manifests); i++ {
+			sources = append(sources, kPath)
+		}
 	}
 
 	opts := util.GenerateHydratedManifestsOptions{
@@ -111,6 +132,9 @@ func (k Kustomize) Render(ctx context.Context, out io.Writer, builds []graph.Art
 	if err != nil {
 		return manifest.ManifestListByConfig{}, err
 	}
+	if k.rCfg.Kustomize.SourceComments {
+		manifests = withSourceComments(ctx, manifests, sources)
+	}
 
 	manifestListByConfig := manifest.NewManifestListByConfig()
 	manifestListByConfig.Add(k.configName, manifests)
@@ -121,7 +145,9 @@ func (k Kustomize) Render(ctx context.Context, out io.Writer, builds []graph.Art
 func (k Kustomize) render(ctx context.Context, kustomizePath string, useKubectlKustomize bool, kCLI *kubectl.CLI) ([]byte, error) {
 	var out []byte
 
-	if (len(k.applySetters.Setters) > 0 || !k.transformer.IsEmpty()) && !sUtil.IsURL(kustomizePath) {
+	// The remote bases are pinned in a mirror of the kustomizations.
+	_, remote := parseRemoteBase(kustomizePath)
+	if (len(k.applySetters.Setters) > 0 || !k.transformer.IsEmpty() || len(k.pinnedBases) > 0) && !sUtil.IsURL(kustomizePath) && !remote {
 		temp, err := os.MkdirTemp("", "*")
 		if err != nil {
 			return out, err
@@ -167,7 +193,11 @@ func (k Kustomize) mirror(kusDir string, fs TmpFS) error {
 		return err
 	}
 
-	if err := fs.WriteTo(kFile, bytes); err != nil {
+	pinned, err := k.pinRemoteBases(bytes)
+	if err != nil {
+		return fmt.Errorf("pinning remote bases of %s: %w", kFile, err)
+	}
+	if err := fs.WriteTo(kFile, pinned); err != nil {
 		return err
 	}
 
@@ -279,6 +309,9 @@ func (k Kustomize) mirrorPatchesStrategicMerge(kusDir string, fs TmpFS, merges [
 
 func (k Kustomize) mirrorResources(kusDir string, fs TmpFS, resources []string) error {
 	for _, r := range resources {
+		if isRemoteEntry(kusDir, r) {
+			continue
+		}
 		// note that r is relative to kustomization file not working dir here
 		rPath := filepath.Join(kusDir, r)
 		stat, err := os.Stat(rPath)
@@ -300,6 +333,9 @@ func (k Kustomize) mirrorResources(kusDir string, fs TmpFS, resources []string)
 
 func (k Kustomize) mirrorComponents(kusDir string, fs TmpFS, components []string) error {
 	for _, c := range components {
+		if isRemoteEntry(kusDir, c) {
+			continue
+		}
 		// note that c is relative to kustomization file not working dir here
 		cPath := filepath.Join(kusDir, c)
 
@@ -354,6 +390,9 @@ func (k Kustomize) mirrorFiles(kusDir string, fs TmpFS, paths []string) error {
 
 func (k Kustomize) mirrorBases(kusDir string, fs TmpFS, bases []string) error {
 	for _, b := range bases {
+		if isRemoteEntry(kusDir, b) {
+			continue
+		}
 		if err := k.mirror(filepath.Join(kusDir, b), fs); err != nil {
 			return err
 		}
@@ -584,3 +623,17 @@ func kustomizeBuildArgs(buildArgs []string, kustomizePath string) []string {
 
 	return args
 }
+
+// withSourceComments prefixes each manifest with a comment naming the kustomization path it was built from.
+// The comments are added after the manifests are transformed since transformations don't preserve comments.
+func withSourceComments(ctx context.Context, manifests manifest.ManifestList, sources []string) manifest.ManifestList {
+	if len(manifests) != len(sources) {
+		log.Entry(ctx).Debugf("not adding source comments: %d manifests were rendered from %d sources", len(manifests), len(sources))
+		return manifests
+	}
+	commented := make(manifest.ManifestList, len(manifests))
+	for i, m := range manifests {
+		commented[i] = append([]byte(fmt.Sprintf("# Source: %s\n", sources[i])), m...)
+	}
+	return commented
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package kustomize
 
 import (
+	"context"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
 
@@ -86,3 +88,34 @@ func TestBuildCommandArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestWithSourceComments(t *testing.T) {
+	tests := []struct {
+		description string
+		manifests   manifest.ManifestList
+		sources     []string
+		expected    manifest.ManifestList
+	}{
+		{
+			description: "one comment per manifest",
+			manifests:   manifest.ManifestList{[]byte("kind: Deployment"), []byte("kind: Service"), []byte("kind: ConfigMap")},
+			sources:     []string{"overlays/dev", "overlays/dev", "base"},
+			expected: manifest.ManifestList{
+				[]byte("# Source: overlays/dev\nkind: Deployment"),
+				[]byte("# Source: overlays/dev\nkind: Service"),
+				[]byte("# Source: base\nkind: ConfigMap"),
+			},
+		},
+		{
+			description: "manifests can't be correlated to sources",
+			manifests:   manifest.ManifestList{[]byte("kind: Deployment"), []byte("kind: Service")},
+			sources:     []string{"base"},
+			expected:    manifest.ManifestList{[]byte("kind: Deployment"), []byte("kind: Service")},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, withSourceComments(context.Background(), test.manifests, test.sources))
+		})
+	}
+}
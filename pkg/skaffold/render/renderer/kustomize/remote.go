@@ -0,0 +1,367 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"sigs.k8s.io/kustomize/api/types"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+	sUtil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/stringset"
+	"github.com/GoogleContainerTools/skaffold/v2/proto/v1"
+)
+
+// commitSHA matches the full and abbreviated commit SHAs, which are used as refs without being resolved.
+var commitSHA = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+// knownGitHosts have repositories named `host/org/repo`, so the path in the repository doesn't need the `//` separator.
+var knownGitHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// remoteBase is a git repository referenced by a kustomization.
+type remoteBase struct {
+	repo string
+	ref  string
+}
+
+// remoteBasesLock is the content of a `remoteBasesLockFile`.
+type remoteBasesLock struct {
+	RemoteBases map[string]string `yaml:"remoteBases"`
+}
+
+// verifyRemoteBases returns the commit that each remote target of the kustomizations must be built from, as recorded
+// in the `remoteBasesLockFile`. The refs of the remote bases that aren't in the file yet are resolved and recorded.
+// The recorded refs are only resolved again with `--allow-base-drift`, to record the commits they moved to.
+// The lock file is only written when its content changes.
+func (k Kustomize) verifyRemoteBases(ctx context.Context, out io.Writer, kustomizePaths []string, offline bool) (map[string]string, error) {
+	lockFile := k.rCfg.Kustomize.RemoteBasesLockFile
+	targets, err := remoteTargets(k.cfg.GetWorkingDir(), kustomizePaths)
+	if err != nil {
+		return nil, err
+	}
+	lock, err := readRemoteBasesLock(lockFile)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := false
+	commits := map[remoteBase]string{}
+	for _, target := range targets {
+		recorded, found := lock.RemoteBases[target]
+		if found && (offline || !k.cfg.AllowBaseDrift()) {
+			continue
+		}
+		base, _ := parseRemoteBase(target)
+		if offline && !commitSHA.MatchString(base.ref) {
+			err := fmt.Errorf("remote base %s isn't recorded in %s and its ref can't be resolved offline", target, lockFile)
+			return nil, sErrors.NewError(err,
+				&proto.ActionableErr{
+					Message: err.Error(),
+					ErrCode: proto.StatusCode_DEPLOY_KUSTOMIZE_USER_ERR,
+				})
+		}
+		commit, resolved := commits[base]
+		if !resolved {
+			if commit, err = resolveCommit(ctx, base); err != nil {
+				return nil, err
+			}
+			commits[base] = commit
+		}
+		if recorded == commit {
+			continue
+		}
+		if found {
+			output.Yellow.Fprintf(out, "Remote base %s drifted from %s to %s\n", target, recorded, commit)
+		}
+		lock.RemoteBases[target] = commit
+		updated = true
+	}
+
+	if updated {
+		if err := writeRemoteBasesLock(lockFile, lock); err != nil {
+			return nil, err
+		}
+	}
+
+	pinned := map[string]string{}
+	for _, target := range targets {
+		pinned[target] = lock.RemoteBases[target]
+	}
+	return pinned, nil
+}
+
+func readRemoteBasesLock(path string) (remoteBasesLock, error) {
+	lock := remoteBasesLock{}
+	buf, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		lock.RemoteBases = map[string]string{}
+		return lock, nil
+	}
+	if err != nil {
+		return lock, fmt.Errorf("reading remote bases lock file: %w", err)
+	}
+	if err := yaml.Unmarshal(buf, &lock); err != nil {
+		return lock, fmt.Errorf("parsing remote bases lock file %s: %w", path, err)
+	}
+	if lock.RemoteBases == nil {
+		lock.RemoteBases = map[string]string{}
+	}
+	return lock, nil
+}
+
+func writeRemoteBasesLock(path string, lock remoteBasesLock) error {
+	buf, err := yaml.Marshal(lock)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, buf, 0644); err != nil {
+		return fmt.Errorf("writing remote bases lock file: %w", err)
+	}
+	return nil
+}
+
+// remoteTargets returns the remote git targets of the kustomization paths, and of the local kustomizations they reference.
+func remoteTargets(workdir string, kustomizePaths []string) ([]string, error) {
+	targets := stringset.New()
+	for _, kPath := range kustomizePaths {
+		if _, ok := parseRemoteBase(kPath); ok {
+			targets.Insert(kPath)
+			continue
+		}
+		if sUtil.IsURL(kPath) {
+			continue
+		}
+		if !filepath.IsAbs(kPath) {
+			kPath = filepath.Join(workdir, kPath)
+		}
+		if err := collectRemoteTargets(kPath, targets); err != nil {
+			return nil, err
+		}
+	}
+	return targets.ToList(), nil
+}
+
+func collectRemoteTargets(dir string, targets stringset.StringSet) error {
+	path, err := FindKustomizationConfig(dir)
+	if err != nil {
+		return nil
+	}
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	content := types.Kustomization{}
+	if err := yaml.Unmarshal(buf, &content); err != nil {
+		return fmt.Errorf("kustomization parse error in %v: %w", path, err)
+	}
+
+	candidates := append(content.Bases, content.Resources...)
+	candidates = append(candidates, content.Components...)
+	for _, candidate := range candidates {
+		local, mode := pathExistsLocally(candidate, dir)
+		if !local {
+			if _, ok := parseRemoteBase(candidate); ok {
+				targets.Insert(candidate)
+			}
+			continue
+		}
+		if mode.IsDir() {
+			if err := collectRemoteTargets(filepath.Join(dir, candidate), targets); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseRemoteBase returns the repository and ref of a kustomize remote target, such as
+// `https://github.com/org/repo//path?ref=v1`, and false if the target isn't a git repository.
+func parseRemoteBase(target string) (remoteBase, bool) {
+	forced := strings.HasPrefix(target, "git::")
+	target = strings.TrimPrefix(target, "git::")
+
+	var ref string
+	if i := strings.Index(target, "?"); i != -1 {
+		query, _ := url.ParseQuery(target[i+1:])
+		ref = query.Get("ref")
+		if ref == "" {
+			ref = query.Get("version")
+		}
+		target = target[:i]
+	}
+
+	// kustomize also accepts http urls to single files.
+	switch filepath.Ext(target) {
+	case ".yaml", ".yml", ".json":
+		if !forced && !strings.Contains(schemeless(target), "//") {
+			return remoteBase{}, false
+		}
+	}
+
+	var scheme string
+	switch {
+	case strings.Contains(target, "://"):
+		i := strings.Index(target, "://")
+		scheme, target = target[:i+3], target[i+3:]
+	case strings.HasPrefix(target, "git@"):
+	case forced || isHostname(strings.SplitN(target, "/", 2)[0]):
+		scheme = "https://"
+	default:
+		return remoteBase{}, false
+	}
+
+	repo := target
+	if i := strings.Index(target, "//"); i != -1 {
+		repo = target[:i]
+	} else if i := strings.Index(target, ".git/"); i != -1 {
+		repo = target[:i+len(".git")]
+	} else {
+		segments := strings.Split(target, "/")
+		for _, host := range knownGitHosts {
+			if segments[0] == host && len(segments) > 3 {
+				repo = strings.Join(segments[:3], "/")
+			}
+		}
+	}
+	return remoteBase{repo: scheme + repo, ref: ref}, true
+}
+
+func schemeless(target string) string {
+	if i := strings.Index(target, "://"); i != -1 {
+		return target[i+3:]
+	}
+	return target
+}
+
+func isHostname(s string) bool {
+	return strings.Contains(s, ".") && !strings.HasPrefix(s, ".")
+}
+
+// resolveCommit returns the commit that the ref of a remote base points to.
+func resolveCommit(ctx context.Context, base remoteBase) (string, error) {
+	if commitSHA.MatchString(base.ref) {
+		return base.ref, nil
+	}
+	ref := base.ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	candidates := refCandidates(ref)
+	args := []string{"ls-remote", base.repo}
+	for _, candidate := range candidates {
+		args = append(args, candidate, candidate+"^{}")
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	out, err := sUtil.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return "", fmt.Errorf("resolving ref %q of %s: %w", ref, base.repo, err)
+	}
+
+	// `git ls-remote` matches the patterns against the end of the ref names, so the refs are looked up by their exact name.
+	refs := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			refs[fields[1]] = fields[0]
+		}
+	}
+	for _, candidate := range candidates {
+		// annotated tags are listed twice, the commit they point to being on the `^{}` line.
+		if commit, found := refs[candidate+"^{}"]; found {
+			return commit, nil
+		}
+		if commit, found := refs[candidate]; found {
+			return commit, nil
+		}
+	}
+	return "", fmt.Errorf("ref %q not found in %s", ref, base.repo)
+}
+
+// refCandidates returns the full names that a ref can have on the remote, in the order git looks them up.
+func refCandidates(ref string) []string {
+	switch {
+	case ref == "HEAD" || strings.HasPrefix(ref, "refs/"):
+		return []string{ref}
+	default:
+		return []string{"refs/tags/" + ref, "refs/heads/" + ref}
+	}
+}
+
+// pinRef returns the remote target with its ref replaced by a commit.
+func pinRef(target, commit string) string {
+	query := url.Values{}
+	if i := strings.Index(target, "?"); i != -1 {
+		query, _ = url.ParseQuery(target[i+1:])
+		target = target[:i]
+	}
+	query.Del("version")
+	query.Set("ref", commit)
+	return target + "?" + query.Encode()
+}
+
+// pinRemoteBases returns the content of a kustomization with its remote targets replaced by their pinned commits.
+func (k Kustomize) pinRemoteBases(buf []byte) ([]byte, error) {
+	if len(k.pinnedBases) == 0 {
+		return buf, nil
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(buf, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return buf, nil
+	}
+
+	pinned := false
+	fields := doc.Content[0].Content
+	for i := 0; i+1 < len(fields); i += 2 {
+		switch fields[i].Value {
+		case "resources", "bases", "components":
+			for _, entry := range fields[i+1].Content {
+				if commit, found := k.pinnedBases[entry.Value]; found {
+					entry.Value = pinRef(entry.Value, commit)
+					pinned = true
+				}
+			}
+		}
+	}
+	if !pinned {
+		return buf, nil
+	}
+	return yaml.Marshal(&doc)
+}
+
+// isRemoteEntry returns true if an entry of a kustomization is a remote target, which kustomize fetches itself.
+func isRemoteEntry(kusDir, entry string) bool {
+	if local, _ := pathExistsLocally(entry, kusDir); local {
+		return false
+	}
+	_, remote := parseRemoteBase(entry)
+	return remote
+}
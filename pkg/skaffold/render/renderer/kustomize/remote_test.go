@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+const (
+	commitA = "8be3f718c015a5fe190bebf356079a25afe0ca57"
+	commitB = "0a1e3bd8a6c9f0e4a2c3f1b1d7e8c9a0b1c2d3e4"
+)
+
+type driftConfig struct {
+	render.MockConfig
+	allowDrift bool
+}
+
+func (c driftConfig) AllowBaseDrift() bool { return c.allowDrift }
+
+func TestParseRemoteBase(t *testing.T) {
+	tests := []struct {
+		description string
+		target      string
+		expected    remoteBase
+		remote      bool
+	}{
+		{
+			description: "https url with path and ref",
+			target:      "https://github.com/org/repo//deploy/base?ref=v1.0.0",
+			expected:    remoteBase{repo: "https://github.com/org/repo", ref: "v1.0.0"},
+			remote:      true,
+		},
+		{
+			description: "known host without scheme nor separator",
+			target:      "github.com/org/repo/deploy/base?ref=main",
+			expected:    remoteBase{repo: "https://github.com/org/repo", ref: "main"},
+			remote:      true,
+		},
+		{
+			description: "ssh url",
+			target:      "git@github.com:org/repo//base?version=v2",
+			expected:    remoteBase{repo: "git@github.com:org/repo", ref: "v2"},
+			remote:      true,
+		},
+		{
+			description: "forced git url to a .git repository",
+			target:      "git::https://example.com/org/repo.git/base",
+			expected:    remoteBase{repo: "https://example.com/org/repo.git"},
+			remote:      true,
+		},
+		{
+			description: "url to a single file",
+			target:      "https://raw.githubusercontent.com/org/repo/main/deployment.yaml",
+		},
+		{
+			description: "local path",
+			target:      "../base",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			base, remote := parseRemoteBase(test.target)
+
+			t.CheckDeepEqual(test.remote, remote)
+			t.CheckDeepEqual(test.expected, base, cmp.AllowUnexported(remoteBase{}))
+		})
+	}
+}
+
+func TestResolveCommit(t *testing.T) {
+	tests := []struct {
+		description string
+		base        remoteBase
+		commands    util.Command
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "branch",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: "main"},
+			commands:    testutil.CmdRunOut("git ls-remote https://github.com/org/repo refs/tags/main refs/tags/main^{} refs/heads/main refs/heads/main^{}", commitA+"\trefs/heads/main\n"),
+			expected:    commitA,
+		},
+		{
+			description: "annotated tag",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: "v1"},
+			commands:    testutil.CmdRunOut("git ls-remote https://github.com/org/repo refs/tags/v1 refs/tags/v1^{} refs/heads/v1 refs/heads/v1^{}", commitA+"\trefs/tags/v1\n"+commitB+"\trefs/tags/v1^{}\n"),
+			expected:    commitB,
+		},
+		{
+			description: "default branch",
+			base:        remoteBase{repo: "https://github.com/org/repo"},
+			commands:    testutil.CmdRunOut("git ls-remote https://github.com/org/repo HEAD HEAD^{}", commitA+"\tHEAD\n"),
+			expected:    commitA,
+		},
+		{
+			description: "commit",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: commitA},
+			expected:    commitA,
+		},
+		{
+			description: "unknown ref",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: "missing"},
+			commands:    testutil.CmdRunOut("git ls-remote https://github.com/org/repo refs/tags/missing refs/tags/missing^{} refs/heads/missing refs/heads/missing^{}", ""),
+			shouldErr:   true,
+		},
+		{
+			description: "tag before branch",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: "v1"},
+			commands:    testutil.CmdRunOut("git ls-remote https://github.com/org/repo refs/tags/v1 refs/tags/v1^{} refs/heads/v1 refs/heads/v1^{}", commitA+"\trefs/heads/v1\n"+commitB+"\trefs/tags/v1\n"),
+			expected:    commitB,
+		},
+		{
+			description: "ref matched by suffix only",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: "v1"},
+			commands:    testutil.CmdRunOut("git ls-remote https://github.com/org/repo refs/tags/v1 refs/tags/v1^{} refs/heads/v1 refs/heads/v1^{}", commitA+"\trefs/heads/x/v1\n"),
+			shouldErr:   true,
+		},
+		{
+			description: "full ref",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: "refs/heads/main"},
+			commands:    testutil.CmdRunOut("git ls-remote https://github.com/org/repo refs/heads/main refs/heads/main^{}", commitA+"\trefs/heads/main\n"),
+			expected:    commitA,
+		},
+		{
+			description: "abbreviated commit",
+			base:        remoteBase{repo: "https://github.com/org/repo", ref: "8be3f71"},
+			expected:    "8be3f71",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			commit, err := resolveCommit(context.Background(), test.base)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, commit)
+		})
+	}
+}
+
+func TestVerifyRemoteBases(t *testing.T) {
+	kustomization := `resources:
+- deployment.yaml
+- https://github.com/org/repo//base?ref=v1
+`
+	tests := []struct {
+		description    string
+		lockFile       string
+		allowDrift     bool
+		offline        bool
+		expectedLock   string
+		expectedCommit string
+		expectedCalls  int
+		rewritten      bool
+		shouldErr      bool
+	}{
+		{
+			description:    "records the commits",
+			expectedLock:   "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitA + "\n",
+			expectedCommit: commitA,
+			expectedCalls:  1,
+			rewritten:      true,
+		},
+		{
+			description:    "recorded commits aren't resolved again",
+			lockFile:       "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitB + "\n",
+			expectedLock:   "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitB + "\n",
+			expectedCommit: commitB,
+		},
+		{
+			description:    "allowed drift",
+			lockFile:       "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitB + "\n",
+			allowDrift:     true,
+			expectedLock:   "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitA + "\n",
+			expectedCommit: commitA,
+			expectedCalls:  1,
+			rewritten:      true,
+		},
+		{
+			description:    "allowed drift without new commits",
+			lockFile:       "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitA + "\n",
+			allowDrift:     true,
+			expectedLock:   "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitA + "\n",
+			expectedCommit: commitA,
+			expectedCalls:  1,
+		},
+		{
+			description:    "offline with recorded commits",
+			lockFile:       "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitB + "\n",
+			allowDrift:     true,
+			offline:        true,
+			expectedLock:   "remoteBases:\n    https://github.com/org/repo//base?ref=v1: " + commitB + "\n",
+			expectedCommit: commitB,
+		},
+		{
+			description: "offline without recorded commits",
+			offline:     true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cmd := testutil.CmdRunOut("git ls-remote https://github.com/org/repo refs/tags/v1 refs/tags/v1^{} refs/heads/v1 refs/heads/v1^{}", commitA+"\trefs/tags/v1\n")
+			t.Override(&util.DefaultExecCommand, cmd)
+			tmpDir := t.NewTempDir().
+				Write("overlay/kustomization.yaml", "resources:\n- ../base\n").
+				Write("base/kustomization.yaml", kustomization).
+				Write("base/deployment.yaml", "")
+			past := time.Now().Add(-time.Hour).Truncate(time.Second)
+			if test.lockFile != "" {
+				tmpDir.Write("kustomize.lock", test.lockFile)
+				t.CheckNoError(os.Chtimes(tmpDir.Path("kustomize.lock"), past, past))
+			}
+
+			k := Kustomize{
+				cfg:  driftConfig{MockConfig: render.MockConfig{WorkingDir: tmpDir.Root()}, allowDrift: test.allowDrift},
+				rCfg: latest.RenderConfig{Generate: latest.Generate{Kustomize: &latest.Kustomize{RemoteBasesLockFile: tmpDir.Path("kustomize.lock")}}},
+			}
+			pinned, err := k.verifyRemoteBases(context.Background(), io.Discard, []string{"overlay"}, test.offline)
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedCalls, cmd.TimesCalled())
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual(map[string]string{"https://github.com/org/repo//base?ref=v1": test.expectedCommit}, pinned)
+
+			lock, err := os.ReadFile(tmpDir.Path("kustomize.lock"))
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedLock, string(lock))
+			info, err := os.Stat(tmpDir.Path("kustomize.lock"))
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.rewritten, !info.ModTime().Equal(past))
+		})
+	}
+}
+
+func TestPinRef(t *testing.T) {
+	tests := []struct {
+		description string
+		target      string
+		expected    string
+	}{
+		{
+			description: "no ref",
+			target:      "https://github.com/org/repo//base",
+			expected:    "https://github.com/org/repo//base?ref=" + commitA,
+		},
+		{
+			description: "ref",
+			target:      "https://github.com/org/repo//base?ref=v1",
+			expected:    "https://github.com/org/repo//base?ref=" + commitA,
+		},
+		{
+			description: "version and other parameters",
+			target:      "github.com/org/repo//base?version=v1&timeout=120",
+			expected:    "github.com/org/repo//base?ref=" + commitA + "&timeout=120",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, pinRef(test.target, commitA))
+		})
+	}
+}
+
+func TestPinRemoteBases(t *testing.T) {
+	k := Kustomize{pinnedBases: map[string]string{"https://github.com/org/repo//base?ref=v1": commitA}}
+
+	pinned, err := k.pinRemoteBases([]byte("resources:\n- deployment.yaml\n- https://github.com/org/repo//base?ref=v1\nnamePrefix: dev-\n"))
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, "resources:\n    - deployment.yaml\n    - https://github.com/org/repo//base?ref="+commitA+"\nnamePrefix: dev-\n", string(pinned))
+}
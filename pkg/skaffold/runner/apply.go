@@ -31,26 +31,20 @@ import (
 
 // Apply sends Kubernetes manifests to the cluster.
 func (r *SkaffoldRunner) Apply(ctx context.Context, out io.Writer) error {
-	var manifests manifest.ManifestList
-	var err error
-	manifests, err = deployutil.GetManifestsFromHydratedManifests(ctx, r.runCtx.HydratedManifests())
-	manifestsByConfig := manifest.NewManifestListByConfig()
-	manifestsByConfig.Add(r.deployer.ConfigName(), manifests)
+	defer r.deployer.GetStatusMonitor().Reset()
 
+	manifests, err := deployutil.GetManifestsFromHydratedManifests(ctx, r.runCtx.HydratedManifests())
 	if err != nil {
 		return fmt.Errorf("getting manifests from hydrated manifests: %w", err)
 	}
-	if err := r.applyResources(ctx, out, nil, nil, manifestsByConfig); err != nil {
-		return err
-	}
+	manifestsByConfig := manifest.NewManifestListByConfig()
+	manifestsByConfig.Add(r.deployer.ConfigName(), manifests)
 
-	statusCheckOut, postStatusCheckFn, err := deployutil.WithStatusCheckLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
-	postStatusCheckFn()
-	if err != nil {
+	if err := r.applyResources(ctx, out, nil, nil, manifestsByConfig); err != nil {
 		return err
 	}
-	sErr := r.deployer.GetStatusMonitor().Check(ctx, statusCheckOut)
-	return sErr
+	// the applied resources are checked exactly like the resources deployed by `skaffold deploy`.
+	return r.checkStatus(ctx, out)
 }
 
 func (r *SkaffoldRunner) applyResources(ctx context.Context, out io.Writer, artifacts, _ []graph.Artifact, list manifest.ManifestListByConfig) error {
@@ -24,15 +24,20 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/provenance"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/sbom"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/timing"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
 	deployutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/util"
 	eventV2 "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/platform"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/tag"
+	protoV2 "github.com/GoogleContainerTools/skaffold/v2/proto/v2"
 )
 
 func NewBuilder(builder build.Builder, tagger tag.Tagger, platforms platform.Resolver, cache cache.Cache, runCtx *runcontext.RunContext) *Builder {
@@ -76,6 +81,13 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest.
 		return nil, err
 	}
 
+	// Check the provenance format before building anything rather than after all the artifacts are built and pushed.
+	provenanceFormat, err := provenance.ParseFormat(r.runCtx.ProvenanceFormat())
+	if err != nil {
+		eventV2.TaskFailed(constants.Build, err)
+		return nil, err
+	}
+
 	tags, err := deployutil.ImageTags(ctx, r.runCtx, r.tagger, out, artifacts)
 	if err != nil {
 		eventV2.TaskFailed(constants.Build, err)
@@ -96,6 +108,13 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest.
 	default:
 	}
 
+	// the build times are also recorded for the provenance of the built artifacts.
+	var timings *timing.Recorder
+	if r.runCtx.ProfileTimings() || r.runCtx.ProvenanceOutput() != "" {
+		timings = timing.NewRecorder()
+		ctx = timing.WithRecorder(ctx, timings)
+	}
+
 	bRes, err := r.cache.Build(ctx, out, tags, artifacts, r.platforms, func(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, platforms platform.Resolver) ([]graph.Artifact, error) {
 		if len(artifacts) == 0 {
 			return nil, nil
@@ -117,6 +136,21 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest.
 		return nil, err
 	}
 
+	if err := r.writeProvenance(ctx, provenanceFormat, artifacts, bRes, timings); err != nil {
+		eventV2.TaskFailed(constants.Build, err)
+		return nil, err
+	}
+
+	if err := r.writeSBOMs(ctx, bRes); err != nil {
+		eventV2.TaskFailed(constants.Build, err)
+		return nil, err
+	}
+
+	if r.runCtx.ProfileTimings() {
+		printTimings(ctx, out, timings, artifacts)
+		sendTimings(timings, artifacts, r.platforms)
+	}
+
 	// Make sure all artifacts are redeployed. Not only those that were just built.
 	r.Builds = build.MergeWithPreviousBuilds(bRes, r.Builds)
 
@@ -124,9 +158,61 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest.
 	return bRes, nil
 }
 
+// writeProvenance writes a provenance document for each built artifact if `--provenance-output` is set.
+// Cached artifacts are not built: only the built ones have a build time in their provenance.
+func (r *Builder) writeProvenance(ctx context.Context, format provenance.Format, artifacts []*latest.Artifact, bRes []graph.Artifact, timings *timing.Recorder) error {
+	if r.runCtx.ProvenanceOutput() == "" {
+		return nil
+	}
+	buildTimes := map[string]timing.Span{}
+	for _, a := range artifacts {
+		if span, found := timings.Span(a.ImageName, timing.Build); found {
+			buildTimes[a.ImageName] = span
+		}
+	}
+	return provenance.NewAssembler(format, r.runCtx.ProvenanceOutput()).Write(ctx, artifacts, bRes, buildTimes)
+}
+
+// writeSBOMs writes an SBOM for each built artifact if `--sbom-output` is set, and records its location in the builds.
+func (r *Builder) writeSBOMs(ctx context.Context, bRes []graph.Artifact) error {
+	if r.runCtx.SBOMOutput() == "" {
+		return nil
+	}
+	return sbom.NewGenerator(r.runCtx.SBOMCommand(), r.runCtx.SBOMOutput(), r.runCtx.SBOMAttach()).Generate(ctx, bRes)
+}
+
+// printTimings writes the time spent in each build phase by the artifacts.
+func printTimings(ctx context.Context, out io.Writer, timings *timing.Recorder, artifacts []*latest.Artifact) {
+	var names []string
+	for _, a := range artifacts {
+		names = append(names, a.ImageName)
+	}
+	output.Default.Fprintln(out, "Build timings:")
+	if err := timings.Print(out, names); err != nil {
+		log.Entry(ctx).Debugf("unable to print build timings: %v", err)
+	}
+}
+
+// sendTimings sends the time spent in each build phase by the artifacts to the event API.
+func sendTimings(timings *timing.Recorder, artifacts []*latest.Artifact, platforms platform.Resolver) {
+	for _, a := range artifacts {
+		durations := timings.Durations(a.ImageName)
+		if len(durations) == 0 {
+			continue
+		}
+		var phaseTimings []*protoV2.BuildPhaseTiming
+		for _, phase := range timing.Phases {
+			if d, found := durations[phase]; found {
+				phaseTimings = append(phaseTimings, &protoV2.BuildPhaseTiming{Phase: string(phase), DurationMs: d.Milliseconds()})
+			}
+		}
+		eventV2.BuildTimings(a.ImageName, platforms.GetPlatforms(a.ImageName).String(), phaseTimings)
+	}
+}
+
 // ApplyDefaultRepo applies the default repo to a given image tag.
 func (r *Builder) ApplyDefaultRepo(tag string) (string, error) {
-	return deployutil.ApplyDefaultRepo(r.runCtx.GlobalConfig(), r.runCtx.DefaultRepo(), tag)
+	return deployutil.ApplyDefaultRepo(r.runCtx.GlobalConfig(), r.runCtx.DefaultRepo(), r.runCtx.GetNamespace, tag)
 }
 
 // HasBuilt returns true if this runner has built something.
@@ -48,12 +48,13 @@ func (b *builderCtx) SourceDependenciesResolver() graph.SourceDependenciesCache
 }
 
 // GetBuilder creates a builder from a given RunContext and build pipeline type.
-func GetBuilder(ctx context.Context, r *runcontext.RunContext, s build.ArtifactStore, d graph.SourceDependenciesCache, p latest.Pipeline) (build.PipelineBuilder, error) {
+// loadTo maps the `loadTo` of the local builds to the clusters the built images are loaded into.
+func GetBuilder(ctx context.Context, r *runcontext.RunContext, s build.ArtifactStore, d graph.SourceDependenciesCache, p latest.Pipeline, loadTo map[string]string) (build.PipelineBuilder, error) {
 	bCtx := &builderCtx{artifactStore: s, sourceDependenciesCache: d, RunContext: r}
 	switch {
 	case p.Build.LocalBuild != nil:
 		log.Entry(context.TODO()).Debug("Using builder: local")
-		builder, err := local.NewBuilder(ctx, bCtx, p.Build.LocalBuild)
+		builder, err := local.NewBuilder(ctx, bCtx, p.Build.LocalBuild, loadTo[p.Build.LocalBuild.LoadTo])
 		if err != nil {
 			return nil, err
 		}
@@ -31,6 +31,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/status"
 )
 
 // DeployAndLog deploys a list of already built artifacts and optionally show the logs.
@@ -111,6 +112,13 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
 	r.deployer.RegisterLocalImages(localAndBuiltImages)
+	if m, ok := r.deployer.GetStatusMonitor().(status.BuiltImagesMonitor); ok {
+		var tags []string
+		for _, artifact := range artifacts {
+			tags = append(tags, artifact.Tag)
+		}
+		m.SetBuiltImages(tags)
+	}
 	err = r.deployer.Deploy(ctx, deployOut, artifacts, list)
 	r.deployManifests = list // set even if deploy may have failed, because we want to cleanup any partially created resources
 	postDeployFn()
@@ -121,17 +129,10 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 		return err
 	}
 
-	statusCheckOut, postStatusCheckFn, err := deployutil.WithStatusCheckLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
-	defer postStatusCheckFn()
-	if err != nil {
-		endTrace(instrumentation.TraceEndError(err))
-		return err
-	}
-
 	event.DeployComplete()
 	if !r.runCtx.IterativeStatusCheck() {
 		// run final aggregated status check only if iterative status check is turned off.
-		if err = r.deployer.GetStatusMonitor().Check(ctx, statusCheckOut); err != nil {
+		if err = r.checkStatus(ctx, out); err != nil {
 			eventV2.TaskFailed(constants.Deploy, err)
 			return err
 		}
@@ -140,6 +141,16 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	return nil
 }
 
+// checkStatus waits for the resources deployed by the current run to stabilize.
+func (r *SkaffoldRunner) checkStatus(ctx context.Context, out io.Writer) error {
+	statusCheckOut, postStatusCheckFn, err := deployutil.WithStatusCheckLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
+	defer postStatusCheckFn()
+	if err != nil {
+		return err
+	}
+	return r.deployer.GetStatusMonitor().Check(ctx, statusCheckOut)
+}
+
 func (r *SkaffoldRunner) wasBuilt(tag string) bool {
 	for _, built := range r.Builds {
 		if built.Tag == tag {
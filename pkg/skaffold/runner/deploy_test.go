@@ -26,11 +26,13 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/status"
 	"github.com/GoogleContainerTools/skaffold/v2/testutil"
 )
 
@@ -68,6 +70,48 @@ func TestDeploy(t *testing.T) {
 	}
 }
 
+type countingMonitor struct {
+	checks int
+}
+
+func (m *countingMonitor) Check(context.Context, io.Writer) error {
+	m.checks++
+	return nil
+}
+
+func (m *countingMonitor) Reset() {}
+
+type monitoredDeployer struct {
+	*TestBench
+	monitor *countingMonitor
+}
+
+func (d monitoredDeployer) GetStatusMonitor() status.Monitor {
+	return d.monitor
+}
+
+func TestDeployConcurrentlyChecksStatusOnce(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+		t.Override(&client.Client, mockK8sClient)
+
+		r := createRunner(t, &TestBench{}, nil, []*latest.Artifact{{ImageName: "img1"}}, nil)
+		r.runCtx.Opts.DeployConcurrency = 2
+		deployers := []monitoredDeployer{
+			{TestBench: &TestBench{}, monitor: &countingMonitor{}},
+			{TestBench: &TestBench{}, monitor: &countingMonitor{}},
+		}
+		r.deployer = deploy.NewConcurrentDeployerMux([]deploy.Deployer{deployers[0], deployers[1]}, 2, func(string) bool { return false })
+
+		err := r.Deploy(context.Background(), io.Discard, []graph.Artifact{{ImageName: "img1", Tag: "img1:tag1"}}, manifest.ManifestListByConfig{})
+
+		t.CheckNoError(err)
+		for _, d := range deployers {
+			t.CheckDeepEqual(1, d.monitor.checks)
+		}
+	})
+}
+
 func TestSkaffoldDeployRenderOnly(t *testing.T) {
 	testutil.Run(t, "does not make kubectl calls", func(t *testutil.T) {
 		runCtx := &runcontext.RunContext{
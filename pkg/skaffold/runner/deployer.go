@@ -75,21 +75,9 @@ func (d *deployerCtx) JSONParseConfig() latest.JSONParseConfig {
 // GetDeployer creates a deployer from a given RunContext and deploy pipeline definitions.
 func GetDeployer(ctx context.Context, runCtx *runcontext.RunContext, labeller *label.DefaultLabeller, hydrationDir string, usingLegacyHelmDeploy bool) (deploy.Deployer, error) {
 	pipelines := runCtx.Pipelines
-	scf := runCtx.StatusCheckCRDsFile()
-	var rsl manifest.ResourceSelectorList
-	var gks []manifest.GroupKindSelector
-	if scf != "" {
-		b, err := os.ReadFile(scf)
-		if err != nil {
-			return nil, err
-		}
-		err = json.Unmarshal(b, &rsl)
-		if err != nil {
-			return nil, err
-		}
-	}
-	for _, selector := range rsl.Selectors {
-		gks = append(gks, &selector)
+	gks, err := customResourceSelectors(runCtx)
+	if err != nil {
+		return nil, err
 	}
 
 	if runCtx.Opts.Apply {
@@ -214,9 +202,33 @@ func GetDeployer(ctx context.Context, runCtx *runcontext.RunContext, labeller *l
 		return nil, errors.New("docker deployment not supported alongside cluster deployments")
 	}
 
+	if c := runCtx.DeployConcurrency(); c > 1 {
+		return deploy.NewConcurrentDeployerMux(deployers, c, runCtx.Pipelines.RequiresOtherConfigs), nil
+	}
 	return deploy.NewDeployerMux(deployers, runCtx.IterativeStatusCheck()), nil
 }
 
+// customResourceSelectors returns the custom resources to status check, read from the `--status-check-crds-file`.
+func customResourceSelectors(runCtx *runcontext.RunContext) ([]manifest.GroupKindSelector, error) {
+	scf := runCtx.StatusCheckCRDsFile()
+	var rsl manifest.ResourceSelectorList
+	var gks []manifest.GroupKindSelector
+	if scf != "" {
+		b, err := os.ReadFile(scf)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(b, &rsl)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, selector := range rsl.Selectors {
+		gks = append(gks, &selector)
+	}
+	return gks, nil
+}
+
 /*
 The "default deployer" is used in `skaffold apply`, which uses a `kubectl` deployer to actuate resources
 on a cluster regardless of provided deployer configuration in the skaffold.yaml.
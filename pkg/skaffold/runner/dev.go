@@ -36,6 +36,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/status"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/term"
 	timeutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/time"
@@ -63,6 +64,8 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 	needsSync := syncIntent && (len(r.changeSet.NeedsResync()) > 0 || needsBuild)
 	needsTest := len(r.changeSet.NeedsRetest()) > 0
 	needsDeploy := deployIntent && (r.changeSet.NeedsRedeploy() || needsBuild)
+	// with no other change than rebuilt images, the status check can be restricted to the resources using them.
+	onlyImagesChanged := needsBuild && !r.changeSet.NeedsRedeploy() && r.runCtx.StatusCheckChangedOnly()
 	if !needsSync && !needsBuild && !needsTest && !needsDeploy {
 		return nil
 	}
@@ -122,6 +125,7 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 	}
 
 	var bRes []graph.Artifact
+	var changedImages []string
 	if needsBuild {
 		childCtx, endTrace := instrumentation.StartTrace(ctx, "doDev_needsBuild")
 		event.ResetStateOnBuild()
@@ -143,6 +147,11 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 			endTrace(instrumentation.TraceEndError(err))
 			return nil
 		}
+		if onlyImagesChanged {
+			for _, a := range bRes {
+				changedImages = append(changedImages, a.Tag)
+			}
+		}
 		r.changeSet.Redeploy()
 		needsDeploy = deployIntent
 		endTrace()
@@ -202,6 +211,11 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 		}
 		r.deployManifests = manifests
 
+		if onlyImagesChanged {
+			if m, ok := r.deployer.GetStatusMonitor().(status.ChangedImagesMonitor); ok {
+				m.SetChangedImages(changedImages)
+			}
+		}
 		if err := r.Deploy(childCtx, out, r.Builds, manifests); err != nil {
 			log.Entry(ctx).Warn("Skipping deploy due to error:", err)
 			event.DevLoopFailedInPhase(r.devIteration, constants.Deploy, err)
@@ -233,7 +247,7 @@ func (r *SkaffoldRunner) getSyncHandler(
 	endTrace func(options ...trace.SpanEndOption),
 ) func(s *sync.Item) error {
 	return func(s *sync.Item) error {
-		fileCount := len(s.Copy) + len(s.Delete)
+		fileCount := s.FileCount()
 		output.Default.Fprintf(out, "Syncing %d files for %s\n", fileCount, s.Image)
 		fileSyncInProgress(fileCount, s.Image)
 
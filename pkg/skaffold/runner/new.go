@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/local"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/util"
@@ -60,8 +61,19 @@ func NewForConfig(ctx context.Context, runCtx *runcontext.RunContext) (*Skaffold
 	g := graph.ToArtifactGraph(runCtx.Artifacts())
 	sourceDependencies := graph.NewSourceDependenciesCache(runCtx, store, g)
 
+	// the clusters the built images are loaded into are resolved once, for both the builders and the images they build.
+	loadTo, err := resolveLoadTo(ctx, runCtx)
+	if err != nil {
+		endTrace(instrumentation.TraceEndError(err))
+		return nil, err
+	}
 	isLocalImage := func(imageName string) (bool, error) {
-		return isImageLocal(runCtx, imageName)
+		return isImageLocal(runCtx, loadTo, imageName)
+	}
+
+	if err := checkSBOMAttach(runCtx, isLocalImage); err != nil {
+		endTrace(instrumentation.TraceEndError(err))
+		return nil, err
 	}
 
 	// Always add skaffold-specific labels, except during `skaffold render`
@@ -144,7 +156,7 @@ func NewForConfig(ctx context.Context, runCtx *runcontext.RunContext) (*Skaffold
 	// the Cluster object on the RunContext, which in turn influences whether or not we will push images.
 	var builder build.Builder
 	builder, err = build.NewBuilderMux(runCtx, store, artifactCache, func(p latest.Pipeline) (build.PipelineBuilder, error) {
-		pb, err := GetBuilder(ctx, runCtx, store, sourceDependencies, p)
+		pb, err := GetBuilder(ctx, runCtx, store, sourceDependencies, p, loadTo)
 		if err != nil {
 			return nil, err
 		}
@@ -226,7 +238,27 @@ func setupTrigger(triggerName string, setIntent func(bool), setAutoTrigger func(
 	})
 }
 
-func isImageLocal(runCtx *runcontext.RunContext, imageName string) (bool, error) {
+// resolveLoadTo resolves the clusters that the images built by the local builds are loaded into,
+// keyed by their configured `loadTo`.
+func resolveLoadTo(ctx context.Context, runCtx *runcontext.RunContext) (map[string]string, error) {
+	resolved := map[string]string{}
+	for _, p := range runCtx.GetPipelines() {
+		if p.Build.LocalBuild == nil {
+			continue
+		}
+		if _, found := resolved[p.Build.LocalBuild.LoadTo]; found {
+			continue
+		}
+		loadTo, err := local.ResolveLoadTo(ctx, p.Build.LocalBuild.LoadTo, runCtx.GetKubeContext(), runCtx.MinikubeProfile())
+		if err != nil {
+			return nil, err
+		}
+		resolved[p.Build.LocalBuild.LoadTo] = loadTo
+	}
+	return resolved, nil
+}
+
+func isImageLocal(runCtx *runcontext.RunContext, loadTo map[string]string, imageName string) (bool, error) {
 	pipeline, found := runCtx.PipelineForImage(imageName)
 	if !found {
 		pipeline = runCtx.DefaultPipeline()
@@ -242,11 +274,13 @@ func isImageLocal(runCtx *runcontext.RunContext, imageName string) (bool, error)
 
 	cl := runCtx.GetCluster()
 	var pushImages bool
-
 	switch {
 	case runCtx.Opts.PushImages.Value() != nil:
 		log.Entry(context.TODO()).Debugf("push value set via skaffold build --push flag, --push=%t", *runCtx.Opts.PushImages.Value())
 		pushImages = *runCtx.Opts.PushImages.Value()
+	case loadTo[pipeline.Build.LocalBuild.LoadTo] != "":
+		log.Entry(context.TODO()).Debugf("images are loaded into the %s cluster instead of being pushed", loadTo[pipeline.Build.LocalBuild.LoadTo])
+		pushImages = false
 	case pipeline.Build.LocalBuild.Push == nil:
 		pushImages = cl.PushImages
 		log.Entry(context.TODO()).Debugf("push value not present in isImageLocal(), defaulting to %t because cluster.PushImages is %t", pushImages, cl.PushImages)
@@ -256,6 +290,27 @@ func isImageLocal(runCtx *runcontext.RunContext, imageName string) (bool, error)
 	return !pushImages, nil
 }
 
+// checkSBOMAttach returns an error if `--sbom-attach` is set but some images won't be pushed to a registry to attach their SBOM to.
+// This is checked before building rather than after all the artifacts are built.
+func checkSBOMAttach(runCtx *runcontext.RunContext, isLocalImage func(imageName string) (bool, error)) error {
+	if !runCtx.SBOMAttach() {
+		return nil
+	}
+	if runCtx.SBOMOutput() == "" {
+		return fmt.Errorf("--sbom-attach requires --sbom-output")
+	}
+	for _, a := range runCtx.Artifacts() {
+		local, err := isLocalImage(a.ImageName)
+		if err != nil {
+			return err
+		}
+		if local {
+			return fmt.Errorf("--sbom-attach requires pushing images, but %q isn't pushed to a registry", a.ImageName)
+		}
+	}
+	return nil
+}
+
 func getTester(ctx context.Context, cfg test.Config, isLocalImage func(imageName string) (bool, error)) (test.Tester, error) {
 	tester, err := test.NewTester(ctx, cfg, isLocalImage)
 	if err != nil {
@@ -19,6 +19,7 @@ package runner
 import (
 	"context"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/hooks"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/renderer"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/renderer/helm"
@@ -32,19 +33,11 @@ func GetRenderer(ctx context.Context, runCtx *runcontext.RunContext, hydrationDi
 	configNames := runCtx.Pipelines.AllOrderedConfigNames()
 
 	var gr renderer.GroupRenderer
-	var err error
 	for _, configName := range configNames {
 		p := runCtx.Pipelines.GetForConfigName(configName)
-		mkvMap := map[string]string{}
-		if runCtx.Opts.ManifestsValueFile != "" {
-			mkvMap, err = util.ParseEnvVariablesFromFile(runCtx.Opts.ManifestsValueFile)
-			if err != nil {
-				return nil, err
-			}
-		}
-		overridesMap := util.EnvSliceToMap(runCtx.Opts.ManifestsOverrides, "=")
-		for k := range overridesMap {
-			mkvMap[k] = overridesMap[k]
+		mkvMap, err := manifestOverrides(runCtx.Opts)
+		if err != nil {
+			return nil, err
 		}
 
 		rs, err := renderer.New(ctx, runCtx, p.Render, hydrationDir, labels, configName, mkvMap)
@@ -81,6 +74,35 @@ func GetRenderer(ctx context.Context, runCtx *runcontext.RunContext, hydrationDi
 	return renderer.NewRenderMux(gr), nil
 }
 
+// manifestOverrides merges the values files, the value file and the key-value pairs overriding the templated manifest fields,
+// in increasing order of precedence.
+func manifestOverrides(opts config.SkaffoldOptions) (map[string]string, error) {
+	mkvMap := map[string]string{}
+	for _, f := range opts.ValuesFiles {
+		values, err := util.ParseValuesFile(f)
+		if err != nil {
+			return nil, err
+		}
+		for k := range values {
+			mkvMap[k] = values[k]
+		}
+	}
+	if opts.ManifestsValueFile != "" {
+		envMap, err := util.ParseEnvVariablesFromFile(opts.ManifestsValueFile)
+		if err != nil {
+			return nil, err
+		}
+		for k := range envMap {
+			mkvMap[k] = envMap[k]
+		}
+	}
+	overridesMap := util.EnvSliceToMap(opts.ManifestsOverrides, "=")
+	for k := range overridesMap {
+		mkvMap[k] = overridesMap[k]
+	}
+	return mkvMap, nil
+}
+
 // filterDuplicates removes duplicate releases defined in the legacy helm deployer
 func filterDuplicates(l *latest.LegacyHelmDeploy, h *latest.Helm) []latest.HelmRelease {
 	if l == nil {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/blang/semver"
 
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/renderer"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/render/renderer/helm"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
@@ -177,3 +178,20 @@ func TestGetRenderer(tOuter *testing.T) {
 		}
 	})
 }
+
+func TestManifestOverrides(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("base.yaml", "replicas: 1\nimage:\n  tag: v1\n").
+			Write("prod.yaml", "replicas: 3\n").
+			Write("prod.env", "image.tag=v2\n")
+
+		overrides, err := manifestOverrides(config.SkaffoldOptions{
+			ValuesFiles:        []string{tmpDir.Path("base.yaml"), tmpDir.Path("prod.yaml")},
+			ManifestsValueFile: tmpDir.Path("prod.env"),
+			ManifestsOverrides: []string{"replicas=5"},
+		})
+
+		t.CheckErrorAndDeepEqual(false, err, map[string]string{"replicas": "5", "image.tag": "v2"}, overrides)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -51,6 +52,8 @@ type Pipelines struct {
 	pipelinesByConfig    map[string]latest.Pipeline
 	pipelinesByImageName map[string]latest.Pipeline
 	orderedConfigs       []string
+	// requiringConfigs are the names of the configs that require other configs.
+	requiringConfigs map[string]bool
 }
 
 // All returns all config pipelines.
@@ -63,6 +66,11 @@ func (ps Pipelines) AllOrderedConfigNames() []string {
 	return ps.orderedConfigs
 }
 
+// RequiresOtherConfigs returns whether the config requires other configs.
+func (ps Pipelines) RequiresOtherConfigs(configName string) bool {
+	return ps.requiringConfigs[configName]
+}
+
 // Returns a pipeline given its associated config name.
 func (ps Pipelines) GetForConfigName(configName string) latest.Pipeline {
 	return ps.pipelinesByConfig[configName]
@@ -193,6 +201,114 @@ func (ps Pipelines) StatusCheckDeadlineSeconds() int {
 	}
 	return c
 }
+func (ps Pipelines) StatusCheckInitialDelaySeconds() int {
+	c := 0
+	// set the group status check initial delay to maximum of any individually specified value
+	for _, p := range ps.pipelines {
+		if p.Deploy.InitialDelaySeconds > c {
+			c = p.Deploy.InitialDelaySeconds
+		}
+	}
+	return c
+}
+
+func (ps Pipelines) StatusCheckStabilizationSeconds() int {
+	c := 0
+	// set the group status check stabilization window to maximum of any individually specified value
+	for _, p := range ps.pipelines {
+		if p.Deploy.StabilizationSeconds > c {
+			c = p.Deploy.StabilizationSeconds
+		}
+	}
+	return c
+}
+
+// StatusCheckExclude returns the combined status check exclusions from pipelines
+func (ps Pipelines) StatusCheckExclude() []latest.StatusCheckExclude {
+	var exclude []latest.StatusCheckExclude
+	for _, p := range ps.pipelines {
+		exclude = append(exclude, p.Deploy.StatusCheckExclude...)
+	}
+	return exclude
+}
+
+// StatusCheckSuggestions returns the combined custom status check suggestions from pipelines
+func (ps Pipelines) StatusCheckSuggestions() []latest.StatusCheckSuggestion {
+	var suggestions []latest.StatusCheckSuggestion
+	for _, p := range ps.pipelines {
+		suggestions = append(suggestions, p.Deploy.StatusCheckSuggestions...)
+	}
+	return suggestions
+}
+
+// StatusCheckRetryableErrors returns the combined retryable status check error substrings from pipelines
+func (ps Pipelines) StatusCheckRetryableErrors() []string {
+	var errs []string
+	for _, p := range ps.pipelines {
+		errs = append(errs, p.Deploy.StatusCheckRetryableErrors...)
+	}
+	return errs
+}
+
+// StatusCheckFailOn returns the combined status codes failing the status check from pipelines
+func (ps Pipelines) StatusCheckFailOn() []string {
+	var codes []string
+	for _, p := range ps.pipelines {
+		codes = append(codes, p.Deploy.StatusCheckFailOn...)
+	}
+	return codes
+}
+
+// StatusCheckSkipPaused returns true if any of the pipelines skips the paused deployments.
+func (ps Pipelines) StatusCheckSkipPaused() bool {
+	for _, p := range ps.pipelines {
+		if p.Deploy.StatusCheckSkipPaused {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusCheckReadyPercent returns the highest percentage of ready replicas set in the pipelines, or 0 if none is set.
+func (ps Pipelines) StatusCheckReadyPercent() int {
+	c := 0
+	for _, p := range ps.pipelines {
+		if p.Deploy.StatusCheckReadyPercent > c {
+			c = p.Deploy.StatusCheckReadyPercent
+		}
+	}
+	return c
+}
+
+// StatusCheckWebhook returns the first status check webhook URL set in the pipelines.
+func (ps Pipelines) StatusCheckWebhook() string {
+	for _, p := range ps.pipelines {
+		if p.Deploy.StatusCheckWebhook != "" {
+			return p.Deploy.StatusCheckWebhook
+		}
+	}
+	return ""
+}
+
+// StatusCheckLogPrefix returns the first status check log prefix template set in the pipelines.
+func (ps Pipelines) StatusCheckLogPrefix() string {
+	for _, p := range ps.pipelines {
+		if p.Deploy.StatusCheckLogPrefix != "" {
+			return p.Deploy.StatusCheckLogPrefix
+		}
+	}
+	return ""
+}
+
+// StatusCheckReadiness returns the combined status check readiness rules from pipelines
+func (ps Pipelines) StatusCheckReadiness() []latest.StatusCheckReadiness {
+	var rules []latest.StatusCheckReadiness
+	for _, p := range ps.pipelines {
+		rules = append(rules, p.Deploy.StatusCheckReadiness...)
+	}
+	return rules
+}
+
 func NewPipelines(pipelinesByConfig map[string]latest.Pipeline, orderedConfigs []string) Pipelines {
 	m := make(map[string]latest.Pipeline)
 	var pipelines []latest.Pipeline
@@ -231,10 +347,60 @@ func (rc *RunContext) StatusCheckDeadlineSeconds() int {
 	return rc.Pipelines.StatusCheckDeadlineSeconds()
 }
 
+func (rc *RunContext) StatusCheckInitialDelaySeconds() int {
+	return rc.Pipelines.StatusCheckInitialDelaySeconds()
+}
+
+func (rc *RunContext) StatusCheckStabilizationSeconds() int {
+	return rc.Pipelines.StatusCheckStabilizationSeconds()
+}
+
+func (rc *RunContext) StatusCheckExclude() []latest.StatusCheckExclude {
+	return rc.Pipelines.StatusCheckExclude()
+}
+
+func (rc *RunContext) StatusCheckSuggestions() []latest.StatusCheckSuggestion {
+	return rc.Pipelines.StatusCheckSuggestions()
+}
+
+func (rc *RunContext) StatusCheckRetryableErrors() []string {
+	return rc.Pipelines.StatusCheckRetryableErrors()
+}
+
+func (rc *RunContext) StatusCheckFailOn() []string {
+	return rc.Pipelines.StatusCheckFailOn()
+}
+
+func (rc *RunContext) StatusCheckSkipPaused() bool {
+	return rc.Pipelines.StatusCheckSkipPaused()
+}
+
+func (rc *RunContext) StatusCheckReadyPercent() int {
+	return rc.Pipelines.StatusCheckReadyPercent()
+}
+
+func (rc *RunContext) StatusCheckWebhook() string {
+	return rc.Pipelines.StatusCheckWebhook()
+}
+
+func (rc *RunContext) StatusCheckLogPrefix() string {
+	return rc.Pipelines.StatusCheckLogPrefix()
+}
+
+func (rc *RunContext) StatusCheckReadiness() []latest.StatusCheckReadiness {
+	return rc.Pipelines.StatusCheckReadiness()
+}
+
 func (rc *RunContext) StatusCheckTolerateFailures() bool {
 	return rc.Opts.TolerateFailuresStatusCheck || rc.Pipelines.StatusCheckTolerateFailures()
 }
 
+// IterativeStatusCheck returns whether the status of each module is checked right after it is deployed.
+// Modules deployed concurrently are always checked as they're deployed.
+func (rc *RunContext) IterativeStatusCheck() bool {
+	return rc.Opts.IterativeStatusCheck || rc.DeployConcurrency() > 1
+}
+
 func (rc *RunContext) StatusCheckCRDsFile() string {
 	return rc.Opts.StatusCheckSelectorsFile
 }
@@ -312,9 +478,11 @@ func (rc *RunContext) CheckClusterNodePlatforms() bool {
 }
 func (rc *RunContext) AutoDeploy() bool                              { return rc.Opts.AutoDeploy }
 func (rc *RunContext) AutoSync() bool                                { return rc.Opts.AutoSync }
+func (rc *RunContext) AllowBaseDrift() bool                          { return rc.Opts.AllowBaseDrift }
 func (rc *RunContext) ContainerDebugging() bool                      { return rc.Opts.ContainerDebugging }
 func (rc *RunContext) CacheArtifacts() bool                          { return rc.Opts.CacheArtifacts }
 func (rc *RunContext) CacheFile() string                             { return rc.Opts.CacheFile }
+func (rc *RunContext) CacheContentTags() bool                        { return rc.Opts.CacheContentTags }
 func (rc *RunContext) ConfigurationFile() string                     { return rc.Opts.ConfigurationFile }
 func (rc *RunContext) CustomLabels() []string                        { return rc.Opts.CustomLabels }
 func (rc *RunContext) CustomTag() string                             { return rc.Opts.CustomTag }
@@ -336,17 +504,38 @@ func (rc *RunContext) NoPruneChildren() bool                         { return rc
 func (rc *RunContext) Notification() bool                            { return rc.Opts.Notification }
 func (rc *RunContext) PortForward() bool                             { return rc.Opts.PortForward.Enabled() }
 func (rc *RunContext) PortForwardOptions() config.PortForwardOptions { return rc.Opts.PortForward }
+func (rc *RunContext) ProvenanceOutput() string                      { return rc.Opts.ProvenanceOutput }
+func (rc *RunContext) ProvenanceFormat() string                      { return rc.Opts.ProvenanceFormat }
+func (rc *RunContext) SBOMOutput() string                            { return rc.Opts.SBOMOutput }
+func (rc *RunContext) SBOMCommand() string                           { return rc.Opts.SBOMCommand }
+func (rc *RunContext) SBOMAttach() bool                              { return rc.Opts.SBOMAttach }
+func (rc *RunContext) ProfileTimings() bool                          { return rc.Opts.ProfileTimings }
 func (rc *RunContext) Prune() bool                                   { return rc.Opts.Prune() }
 func (rc *RunContext) RenderOnly() bool                              { return rc.Opts.RenderOnly }
 func (rc *RunContext) RenderOutput() string                          { return rc.Opts.RenderOutput }
 func (rc *RunContext) StatusCheck() *bool                            { return rc.Opts.StatusCheck.Value() }
-func (rc *RunContext) IterativeStatusCheck() bool                    { return rc.Opts.IterativeStatusCheck }
 func (rc *RunContext) FastFailStatusCheck() bool                     { return rc.Opts.FastFailStatusCheck }
+func (rc *RunContext) StatusCheckTail() bool                         { return rc.Opts.StatusCheckTail }
+func (rc *RunContext) StatusCheckQuiet() bool                        { return rc.Opts.StatusCheckQuiet }
+func (rc *RunContext) StatusCheckAllNamespaces() bool                { return rc.Opts.StatusCheckAllNamespaces }
+func (rc *RunContext) StatusCheckPollInterval() time.Duration        { return rc.Opts.StatusCheckPollInterval }
+func (rc *RunContext) StatusCheckAdaptivePoll() bool                 { return rc.Opts.StatusCheckAdaptivePoll }
+func (rc *RunContext) StatusCheckWaitForHPA() bool                   { return rc.Opts.StatusCheckWaitForHPA }
+func (rc *RunContext) StatusCheckWaitForEndpoints() bool             { return rc.Opts.StatusCheckWaitForEndpoints }
+func (rc *RunContext) StatusCheckChangedOnly() bool                  { return rc.Opts.StatusCheckChangedOnly }
+func (rc *RunContext) StatusCheckLogLines() int                      { return rc.Opts.StatusCheckLogLines }
+func (rc *RunContext) StatusCheckMaxLogLines() int                   { return rc.Opts.StatusCheckMaxLogLines }
+func (rc *RunContext) StatusCheckKubectl() string                    { return rc.Opts.StatusCheckKubectl }
+func (rc *RunContext) WatchResources() bool                          { return rc.Opts.WatchResources }
+func (rc *RunContext) StatusCheckJUnitOutput() string                { return rc.Opts.StatusCheckJUnitOutput }
+func (rc *RunContext) RollbackOnFailure() bool                       { return rc.Opts.RollbackOnFailure }
 func (rc *RunContext) Tail() bool                                    { return rc.Opts.Tail }
 func (rc *RunContext) Trigger() string                               { return rc.Opts.Trigger }
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions     { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                        { return rc.Opts.WatchPollInterval }
 func (rc *RunContext) BuildConcurrency() int                         { return rc.Opts.BuildConcurrency }
+func (rc *RunContext) TestConcurrency() int                          { return rc.Opts.TestConcurrency }
+func (rc *RunContext) DeployConcurrency() int                        { return rc.Opts.DeployConcurrency }
 func (rc *RunContext) IsMultiConfig() bool                           { return rc.Pipelines.IsMultiPipeline() }
 func (rc *RunContext) IsDefaultKubeContext() bool                    { return rc.Opts.KubeContext == "" }
 func (rc *RunContext) GetRunID() string                              { return rc.RunID }
@@ -354,6 +543,7 @@ func (rc *RunContext) RPCPort() *int                                 { return rc
 func (rc *RunContext) RPCHTTPPort() *int                             { return rc.Opts.RPCHTTPPort.Value() }
 func (rc *RunContext) PushImages() config.BoolOrUndefined            { return rc.Opts.PushImages }
 func (rc *RunContext) TransformRulesFile() string                    { return rc.Opts.TransformRulesFile }
+func (rc *RunContext) TransformManifestsCommand() string             { return rc.Opts.TransformManifestsCommand }
 func (rc *RunContext) VerifyDockerNetwork() string                   { return rc.Opts.VerifyDockerNetwork }
 func (rc *RunContext) JSONParseConfig() latest.JSONParseConfig {
 	return rc.DefaultPipeline().Deploy.Logs.JSONParse
@@ -389,6 +579,7 @@ func getConfigName(configName string) string {
 func GetRunContext(ctx context.Context, opts config.SkaffoldOptions, configs []schemaUtil.VersionedConfig) (*RunContext, error) {
 	pipelines := make(map[string]latest.Pipeline)
 	var orderedConfigs []string
+	requiringConfigs := map[string]bool{}
 
 	for _, cfg := range configs {
 		if cfg != nil {
@@ -396,8 +587,19 @@ func GetRunContext(ctx context.Context, opts config.SkaffoldOptions, configs []s
 			cfgName := getConfigName(cfg.(*latest.SkaffoldConfig).Metadata.Name)
 			pipelines[cfgName] = pipeline
 			orderedConfigs = append(orderedConfigs, cfgName)
+			if len(cfg.(*latest.SkaffoldConfig).Dependencies) > 0 {
+				requiringConfigs[cfgName] = true
+			}
 		}
 	}
+	// the namespace can be templated from the environment, e.g. `--namespace pr-{{.PR_NUMBER}}`,
+	// so that the deployers and the status check all use the computed namespace.
+	namespace, err := util.ExpandEnvTemplateOrFail(opts.Namespace, nil)
+	if err != nil {
+		return nil, fmt.Errorf("expanding namespace %q: %w", opts.Namespace, err)
+	}
+	opts.Namespace = namespace
+
 	kubeConfig, err := kubectx.CurrentConfig()
 	if err != nil {
 		return nil, fmt.Errorf("getting current cluster context: %w", err)
@@ -428,6 +630,7 @@ func GetRunContext(ctx context.Context, opts config.SkaffoldOptions, configs []s
 		insecureRegistries[r] = true
 	}
 	ps := NewPipelines(pipelines, orderedConfigs)
+	ps.requiringConfigs = requiringConfigs
 
 	// TODO(https://github.com/GoogleContainerTools/skaffold/issues/3668):
 	// remove minikubeProfile from here and instead detect it by matching the
@@ -582,6 +582,96 @@ func TestNewForConfig(t *testing.T) {
 	}
 }
 
+func TestCheckSBOMAttach(t *testing.T) {
+	tests := []struct {
+		description string
+		opts        config.SkaffoldOptions
+		local       bool
+		shouldErr   bool
+	}{
+		{
+			description: "not attached",
+			local:       true,
+		},
+		{
+			description: "attached to pushed images",
+			opts:        config.SkaffoldOptions{SBOMOutput: "sboms", SBOMAttach: true},
+		},
+		{
+			description: "attached to local images",
+			opts:        config.SkaffoldOptions{SBOMOutput: "sboms", SBOMAttach: true},
+			local:       true,
+			shouldErr:   true,
+		},
+		{
+			description: "attached without sbom output",
+			opts:        config.SkaffoldOptions{SBOMAttach: true},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{
+				Pipelines: runcontext.NewPipelines(
+					map[string]latest.Pipeline{
+						"default": {Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "app"}}}},
+					},
+					[]string{"default"}),
+				Opts: test.opts,
+			}
+
+			err := checkSBOMAttach(runCtx, func(string) (bool, error) { return test.local, nil })
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestResolveLoadTo(t *testing.T) {
+	tests := []struct {
+		description string
+		kubeContext string
+		expected    map[string]string
+		local       bool
+		shouldErr   bool
+	}{
+		{
+			description: "kind cluster",
+			kubeContext: "kind-dev",
+			expected:    map[string]string{"kind": "kind", "": ""},
+			local:       true,
+		},
+		{
+			description: "not a kind cluster",
+			kubeContext: "gke_project_zone_cluster",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{
+				Pipelines: runcontext.NewPipelines(
+					map[string]latest.Pipeline{
+						"app":   {Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "app"}}, BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{LoadTo: "kind"}}}},
+						"other": {Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "other"}}, BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{LoadTo: "kind"}}}},
+						"rest":  {Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}},
+					},
+					[]string{"app", "other", "rest"}),
+				KubeContext: test.kubeContext,
+				Cluster:     config.Cluster{PushImages: true},
+			}
+
+			loadTo, err := resolveLoadTo(context.Background(), runCtx)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, loadTo)
+			if test.shouldErr {
+				return
+			}
+			local, err := isImageLocal(runCtx, loadTo, "app")
+			t.CheckErrorAndDeepEqual(false, err, test.local, local)
+		})
+	}
+}
+
 func TestTriggerCallbackAndIntents(t *testing.T) {
 	var tests = []struct {
 		description          string
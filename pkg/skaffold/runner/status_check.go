@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/constants"
+	deployutil "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/util"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
+	k8sstatus "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output"
+)
+
+// newManifestStatusMonitor is overridden in tests.
+var newManifestStatusMonitor = k8sstatus.NewManifestStatusMonitor
+
+// CheckStatus waits for the resources defined in the rendered manifests to stabilize, without deploying them.
+// The resources are matched by name, so they can be deployed by other tools.
+func (r *SkaffoldRunner) CheckStatus(ctx context.Context, out io.Writer, list manifest.ManifestListByConfig) error {
+	var manifests manifest.ManifestList
+	for _, configName := range list.ConfigNames() {
+		manifests = append(manifests, list.GetForConfig(configName)...)
+	}
+	if len(manifests) == 0 {
+		return fmt.Errorf("no resources to status check")
+	}
+
+	namespaces, err := deployutil.GetAllPodNamespaces(r.runCtx.GetNamespace(), r.runCtx.GetPipelines())
+	if err != nil {
+		return err
+	}
+	manifestsNamespaces, err := manifests.CollectNamespaces()
+	if err != nil {
+		return err
+	}
+	namespaces = deployutil.ConsolidateNamespaces(namespaces, manifestsNamespaces)
+
+	selectors, err := customResourceSelectors(r.runCtx)
+	if err != nil {
+		return err
+	}
+
+	out, ctx = output.WithEventContext(ctx, out, constants.StatusCheck, constants.SubtaskIDNone)
+	statusCheckOut, postStatusCheckFn, err := deployutil.WithStatusCheckLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
+	defer postStatusCheckFn()
+	if err != nil {
+		return err
+	}
+	return newManifestStatusMonitor(r.runCtx, r.labeller, &namespaces, selectors, manifests).Check(ctx, statusCheckOut)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/manifest"
+	k8sstatus "github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/kubernetes/status"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+type fakeManifestMonitor struct {
+	k8sstatus.Monitor
+	err error
+}
+
+func (m *fakeManifestMonitor) Check(context.Context, io.Writer) error { return m.err }
+
+// manifestsByConfig returns a list with a manifest per config, from pairs of config name and manifest.
+func manifestsByConfig(manifests ...[2]string) manifest.ManifestListByConfig {
+	list := manifest.NewManifestListByConfig()
+	for _, m := range manifests {
+		list.Add(m[0], manifest.ManifestList{[]byte(m[1])})
+	}
+	return list
+}
+
+func TestCheckStatus(t *testing.T) {
+	tests := []struct {
+		description        string
+		manifests          manifest.ManifestListByConfig
+		checkErr           error
+		expectedManifests  manifest.ManifestList
+		expectedNamespaces []string
+		shouldErr          bool
+	}{
+		{
+			description: "manifests of all configs",
+			manifests: manifestsByConfig(
+				[2]string{"app", "kind: Deployment\nmetadata:\n  name: app\n"},
+				[2]string{"backend", "kind: StatefulSet\nmetadata:\n  name: db\n  namespace: data\n"},
+			),
+			expectedManifests: manifest.ManifestList{
+				[]byte("kind: Deployment\nmetadata:\n  name: app\n"),
+				[]byte("kind: StatefulSet\nmetadata:\n  name: db\n  namespace: data\n"),
+			},
+			expectedNamespaces: []string{"data", "ns"},
+		},
+		{
+			description: "failed status check",
+			manifests:   manifestsByConfig([2]string{"app", "kind: Deployment\nmetadata:\n  name: app\n"}),
+			checkErr:    errors.New("deployment/app failed"),
+			shouldErr:   true,
+		},
+		{
+			description: "no manifests",
+			manifests:   manifest.NewManifestListByConfig(),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var manifests manifest.ManifestList
+			var namespaces []string
+			t.Override(&newManifestStatusMonitor, func(_ k8sstatus.Config, _ *label.DefaultLabeller, ns *[]string, _ []manifest.GroupKindSelector, m manifest.ManifestList, _ ...k8sstatus.MonitorOption) k8sstatus.Monitor {
+				manifests, namespaces = m, *ns
+				return &fakeManifestMonitor{err: test.checkErr}
+			})
+			r := SkaffoldRunner{
+				runCtx: &runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: "ns"}},
+			}
+
+			err := r.CheckStatus(context.Background(), io.Discard, test.manifests)
+
+			t.CheckError(test.shouldErr, err)
+			if test.expectedManifests != nil {
+				t.CheckDeepEqual(test.expectedManifests, manifests)
+				t.CheckDeepEqual(test.expectedNamespaces, namespaces)
+			}
+		})
+	}
+}
+
+func TestCheckStatusTemplatedNamespace(t *testing.T) {
+	tests := []struct {
+		description        string
+		namespace          string
+		expectedNamespaces []string
+		shouldErr          bool
+	}{
+		{
+			description:        "namespace computed from the environment",
+			namespace:          "pr-{{.PR_NUMBER}}",
+			expectedNamespaces: []string{"pr-42"},
+		},
+		{
+			description: "undefined environment variable",
+			namespace:   "pr-{{.UNDEFINED}}",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"PR_NUMBER": "42"})
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			var namespaces []string
+			t.Override(&newManifestStatusMonitor, func(_ k8sstatus.Config, _ *label.DefaultLabeller, ns *[]string, _ []manifest.GroupKindSelector, _ manifest.ManifestList, _ ...k8sstatus.MonitorOption) k8sstatus.Monitor {
+				namespaces = *ns
+				return &fakeManifestMonitor{}
+			})
+
+			runCtx, err := runcontext.GetRunContext(context.Background(), config.SkaffoldOptions{Namespace: test.namespace}, nil)
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			r := SkaffoldRunner{runCtx: runCtx}
+			err = r.CheckStatus(context.Background(), io.Discard, manifestsByConfig([2]string{"app", "kind: Deployment\nmetadata:\n  name: app\n"}))
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedNamespaces, namespaces)
+		})
+	}
+}
@@ -74,6 +74,9 @@ func Set(c *latest.SkaffoldConfig) error {
 
 		case a.BuildpackArtifact != nil:
 			setBuildpackArtifactDefaults(a.BuildpackArtifact)
+
+		case a.ApkoArtifact != nil:
+			setApkoArtifactDefaults(a.ApkoArtifact)
 		}
 
 		for _, d := range a.Dependencies {
@@ -283,6 +286,10 @@ func setDockerArtifactDefaults(a *latest.DockerArtifact) {
 	a.DockerfilePath = valueOrDefault(a.DockerfilePath, constants.DefaultDockerfilePath)
 }
 
+func setApkoArtifactDefaults(a *latest.ApkoArtifact) {
+	a.Config = valueOrDefault(a.Config, constants.DefaultApkoConfigPath)
+}
+
 func setDefaultWorkspace(a *latest.Artifact) {
 	a.Workspace = valueOrDefault(a.Workspace, ".")
 }
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
+)
+
+// EnvExpansion configures the expansion of the references to environment variables in a configuration, before it's parsed.
+type EnvExpansion struct {
+	// Enabled expands `${VAR}` and `${VAR:-default}` references.
+	Enabled bool
+	// Strict fails on references to undefined variables without a default, and implies Enabled.
+	Strict bool
+}
+
+// envReference matches `${VAR}` and `${VAR:-default}`, and `$${...}` which escapes a reference.
+var envReference = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// expandEnv replaces the references to environment variables in buf with their values, or their default if the variable
+// is unset or empty. References to undefined variables without a default are replaced with an empty string,
+// or fail the expansion in strict mode. `$${VAR}` is kept as the literal `${VAR}`.
+func expandEnv(buf []byte, strict bool) ([]byte, error) {
+	undefined := map[string]bool{}
+	expanded := envReference.ReplaceAllStringFunc(string(buf), func(ref string) string {
+		if strings.HasPrefix(ref, "$$") {
+			return ref[1:]
+		}
+		match := envReference.FindStringSubmatch(ref)
+		name, hasDefault, def := match[1], match[2] != "", match[3]
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+		if hasDefault {
+			return def
+		}
+		if _, found := os.LookupEnv(name); !found {
+			undefined[name] = true
+		}
+		return ""
+	})
+	if len(undefined) == 0 {
+		return []byte(expanded), nil
+	}
+	var names []string
+	for name := range undefined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if strict {
+		return nil, fmt.Errorf("undefined environment variables referenced in the configuration: %s", strings.Join(names, ", "))
+	}
+	log.Entry(context.TODO()).Warnf("Expanding undefined environment variables referenced in the configuration to an empty string: %s", strings.Join(names, ", "))
+	return []byte(expanded), nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+func TestExpandEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		strict      bool
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "defined variable",
+			input:       "image: ${REGISTRY}/app",
+			expected:    "image: gcr.io/project/app",
+		},
+		{
+			description: "default of unset variable",
+			input:       "namespace: ${NAMESPACE:-dev}",
+			expected:    "namespace: dev",
+		},
+		{
+			description: "default of empty variable",
+			input:       "namespace: ${EMPTY:-dev}",
+			expected:    "namespace: dev",
+		},
+		{
+			description: "default ignored for defined variable",
+			input:       "image: ${REGISTRY:-localhost:5000}/app",
+			expected:    "image: gcr.io/project/app",
+		},
+		{
+			description: "empty default",
+			input:       "namespace: '${NAMESPACE:-}'",
+			strict:      true,
+			expected:    "namespace: ''",
+		},
+		{
+			description: "escaped reference",
+			input:       "buildCommand: docker build -t $${IMAGE} .",
+			strict:      true,
+			expected:    "buildCommand: docker build -t ${IMAGE} .",
+		},
+		{
+			description: "other dollar signs",
+			input:       "buildCommand: echo $IMAGE ${1} $",
+			strict:      true,
+			expected:    "buildCommand: echo $IMAGE ${1} $",
+		},
+		{
+			description: "undefined variable",
+			input:       "namespace: ${NAMESPACE}",
+			expected:    "namespace: ",
+		},
+		{
+			description: "defined empty variable in strict mode",
+			input:       "namespace: '${EMPTY}'",
+			strict:      true,
+			expected:    "namespace: ''",
+		},
+		{
+			description: "undefined variables in strict mode",
+			input:       "image: ${REPO}/${NAME}\nnamespace: ${REPO}",
+			strict:      true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"REGISTRY": "gcr.io/project", "EMPTY": ""})
+
+			actual, err := expandEnv([]byte(test.input), test.strict)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, string(actual))
+		})
+	}
+}
+
+func TestExpandEnvStrictError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		_, err := expandEnv([]byte("image: ${REPO}/${NAME}\nnamespace: ${REPO}"), true)
+
+		t.CheckErrorContains("undefined environment variables referenced in the configuration: NAME, REPO", err)
+	})
+}
+
+func TestParseConfigAndUpgradeWithEnv(t *testing.T) {
+	const config = `apiVersion: ` + latest.Version + `
+kind: Config
+build:
+  artifacts:
+  - image: ${REGISTRY:-localhost:5000}/app
+  tagPolicy:
+    envTemplate:
+      template: '{{.TAG}}'
+`
+	tests := []struct {
+		description string
+		env         EnvExpansion
+		envs        map[string]string
+		expected    string
+	}{
+		{
+			description: "not expanded by default",
+			env:         EnvExpansion{},
+			expected:    "${REGISTRY:-localhost:5000}/app",
+		},
+		{
+			description: "expanded",
+			env:         EnvExpansion{Enabled: true},
+			envs:        map[string]string{"REGISTRY": "gcr.io/project"},
+			expected:    "gcr.io/project/app",
+		},
+		{
+			description: "default in strict mode",
+			env:         EnvExpansion{Strict: true},
+			expected:    "localhost:5000/app",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(test.envs)
+			tmpDir := t.NewTempDir().Write("skaffold.yaml", config)
+
+			cfgs, err := ParseConfigAndUpgradeWithEnv(tmpDir.Path("skaffold.yaml"), test.env)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, cfgs[0].(*latest.SkaffoldConfig).Build.Artifacts[0].ImageName)
+		})
+	}
+}
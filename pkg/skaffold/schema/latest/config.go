@@ -25,8 +25,8 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/util"
 )
 
-// !!! WARNING !!! This config version is already released, please DO NOT MODIFY the structs in this file.
-const Version string = "skaffold/v4beta13"
+// This config version is not yet released, it is SAFE TO MODIFY the structs in this file.
+const Version string = "skaffold/v4beta14"
 
 // NewSkaffoldConfig creates a SkaffoldConfig
 func NewSkaffoldConfig() util.VersionedConfig {
@@ -204,6 +204,10 @@ type PortForwardResource struct {
 
 	// LocalPort is the local port to forward to. If the port is unavailable, Skaffold will choose a random open port to forward to. *Optional*.
 	LocalPort int `yaml:"localPort,omitempty"`
+
+	// Protocol is the protocol of the port, `TCP` or `UDP`. Defaults to `TCP`.
+	// `UDP` is only supported for `Service` and `Pod` resources.
+	Protocol string `yaml:"protocol,omitempty"`
 }
 
 // ResourceSelectorConfig contains all the configuration needed by the deploy steps.
@@ -377,6 +381,11 @@ type LocalBuild struct {
 	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
 	// Defaults to `1`.
 	Concurrency *int `yaml:"concurrency,omitempty"`
+
+	// LoadTo loads the built images into a local cluster with `kind load docker-image` or `minikube image load`
+	// instead of pushing them to a registry.
+	// Valid values are `kind`, `minikube`, and `auto` to detect the cluster from the current Kubernetes context.
+	LoadTo string `yaml:"loadTo,omitempty"`
 }
 
 // GoogleCloudBuild *beta* describes how to do a remote build on
@@ -494,7 +503,10 @@ type KanikoCache struct {
 	Repo string `yaml:"repo,omitempty"`
 	// HostPath specifies a path on the host that is mounted to each pod as read only cache volume containing base images.
 	// If set, must exist on each node and prepopulated with kaniko-warmer.
-	HostPath string `yaml:"hostPath,omitempty"`
+	HostPath string `yaml:"hostPath,omitempty" yamltags:"oneOf=cacheDir"`
+	// VolumeClaim is the name of a PersistentVolumeClaim, in the namespace of the kaniko pod, that is mounted
+	// as a writable cache directory and passed to kaniko as `--cache-dir`, so that the cache persists across builds.
+	VolumeClaim string `yaml:"volumeClaim,omitempty" yamltags:"oneOf=cacheDir"`
 	// TTL Cache timeout in hours.
 	TTL string `yaml:"ttl,omitempty"`
 	// CacheCopyLayers enables caching of copy layers.
@@ -802,6 +814,13 @@ type Kustomize struct {
 
 	// BuildArgs are additional args passed to `kustomize build`.
 	BuildArgs []string `yaml:"buildArgs,omitempty"`
+
+	// SourceComments adds a `# Source: <path>` comment to each rendered manifest with the kustomization path it was built from.
+	SourceComments bool `yaml:"sourceComments,omitempty"`
+
+	// RemoteBasesLockFile is the path to a file recording the commit that each remote git base of the kustomizations resolves to.
+	// The file is created if it doesn't exist. The remote bases are built from the recorded commits, and their refs are only resolved again with `--allow-base-drift`.
+	RemoteBasesLockFile string `yaml:"remoteBasesLockFile,omitempty" skaffold:"filepath"`
 }
 
 // Helm defines the manifests from helm releases.
@@ -876,6 +895,58 @@ type DeployConfig struct {
 	// timeouts such as progressDeadlineSeconds, etc.
 	TolerateFailuresUntilDeadline bool `yaml:"tolerateFailuresUntilDeadline,omitempty"`
 
+	// InitialDelaySeconds is the grace period in seconds, after deployment, during which the Skaffold "status-check"
+	// doesn't report failures. This tolerates pods briefly failing while they are mutated after creation,
+	// for example by a sidecar injection webhook.
+	InitialDelaySeconds int `yaml:"initialDelaySeconds,omitempty"`
+
+	// StabilizationSeconds is the time in seconds a resource must stay healthy before the Skaffold "status-check"
+	// considers it stable. This catches applications that briefly pass their readiness checks and then crash.
+	StabilizationSeconds int `yaml:"stabilizationSeconds,omitempty"`
+
+	// StatusCheckExclude lists the resources that are deployed but not waited for by the Skaffold "status-check",
+	// like a long-running migration job.
+	StatusCheckExclude []StatusCheckExclude `yaml:"statusCheckExclude,omitempty"`
+
+	// StatusCheckSuggestions lists custom suggestions shown, before the built-in ones, when the Skaffold "status-check"
+	// fails with a status code, like a link to a team runbook.
+	StatusCheckSuggestions []StatusCheckSuggestion `yaml:"statusCheckSuggestions,omitempty"`
+
+	// StatusCheckRetryableErrors lists substrings of kubectl errors that the Skaffold "status-check" retries
+	// instead of failing, whatever their status code, like transient errors of a managed control plane.
+	// For example: `["etcdserver: request timed out"]`.
+	StatusCheckRetryableErrors []string `yaml:"statusCheckRetryableErrors,omitempty"`
+
+	// StatusCheckFailOn lists status codes that fail the Skaffold "status-check" of a resource as soon as they're reported,
+	// even if they're normally retried until the deadline, like unschedulable pods in a strict CI environment.
+	// For example: `["STATUSCHECK_NODE_UNSCHEDULABLE", "STATUSCHECK_KUBECTL_CONNECTION_ERR"]`.
+	StatusCheckFailOn []string `yaml:"statusCheckFailOn,omitempty"`
+
+	// StatusCheckSkipPaused configures the Skaffold "status-check" to skip the deployments whose rollout is paused,
+	// like in canary workflows, instead of failing them.
+	StatusCheckSkipPaused bool `yaml:"statusCheckSkipPaused,omitempty"`
+
+	// StatusCheckReadyPercent is the percentage of the desired replicas of a deployment that must be updated and available
+	// for the Skaffold "status-check" to consider its rollout complete, instead of all of them. This speeds up large
+	// deployments that scale up gradually. Defaults to `100`.
+	// For example: `80`.
+	StatusCheckReadyPercent int `yaml:"statusCheckReadyPercent,omitempty"`
+
+	// StatusCheckWebhook is the URL that the Skaffold "status-check" notifies with a JSON payload
+	// whenever a resource completes or fails, like a chat or dashboard integration.
+	// For example: `https://hooks.example.com/skaffold`.
+	StatusCheckWebhook string `yaml:"statusCheckWebhook,omitempty"`
+
+	// StatusCheckLogPrefix is a Go template for the prefix of the container log lines that the Skaffold "status-check"
+	// reports for failing pods, instead of `[pod container]`. It can use `{{.Pod}}`, `{{.Container}}`, `{{.Namespace}}`,
+	// the workload owning the pod with `{{.Owner}}`, e.g. `deployment/web`, and the pod labels with `{{.Labels.app}}`.
+	// For example: `[app={{.Labels.app}} {{.Owner}}]`.
+	StatusCheckLogPrefix string `yaml:"statusCheckLogPrefix,omitempty"`
+
+	// StatusCheckReadiness declares when the deployed resources of other kinds than the built-in ones are ready,
+	// so that the Skaffold "status-check" waits for them, like a cert-manager Certificate to be issued.
+	StatusCheckReadiness []StatusCheckReadiness `yaml:"statusCheckReadiness,omitempty"`
+
 	// KubeContext is the Kubernetes context that Skaffold should deploy to.
 	// For example: `minikube`.
 	KubeContext string `yaml:"kubeContext,omitempty"`
@@ -887,6 +958,52 @@ type DeployConfig struct {
 	TransformableAllowList []ResourceFilter `yaml:"-"`
 }
 
+// StatusCheckExclude selects resources to exclude from the Skaffold "status-check".
+// A resource is excluded when it matches all the specified fields.
+type StatusCheckExclude struct {
+	// Name is a glob pattern matched against the resource names.
+	// For example: `db-migration-*`.
+	Name string `yaml:"name,omitempty"`
+
+	// Labels is a label selector matched against the resource labels.
+	// For example: `app=migration`.
+	Labels string `yaml:"labels,omitempty"`
+}
+
+// StatusCheckSuggestion is a custom suggestion for a Skaffold "status-check" status code.
+type StatusCheckSuggestion struct {
+	// Code is the status code the suggestion is shown for.
+	// For example: `STATUSCHECK_IMAGE_PULL_ERR`.
+	Code string `yaml:"code" yamltags:"required"`
+
+	// Action is the suggested action.
+	// For example: `Check that the image was pushed to the team registry`.
+	Action string `yaml:"action,omitempty"`
+
+	// URL is a link to more information, like a runbook page.
+	URL string `yaml:"url,omitempty"`
+}
+
+// StatusCheckReadiness declares the field of a resource kind that the Skaffold "status-check" waits for.
+// A resource is ready once the field path evaluates to the expected value.
+type StatusCheckReadiness struct {
+	// Kind is the kind of the resources.
+	// For example: `Certificate`.
+	Kind string `yaml:"kind" yamltags:"required"`
+
+	// Group is the API group of the resources, to tell apart kinds with the same name.
+	// For example: `cert-manager.io`.
+	Group string `yaml:"group,omitempty"`
+
+	// JSONPath is the field path evaluated on the resources, in the kubectl JSONPath syntax.
+	// For example: `{.status.phase}`.
+	JSONPath string `yaml:"jsonPath" yamltags:"required"`
+
+	// Value is the value of the field path once the resources are ready.
+	// For example: `Bound`.
+	Value string `yaml:"value" yamltags:"required"`
+}
+
 // DeployType contains the specific implementation and parameters needed
 // for the deploy step. All three deployer types can be used at the same
 // time for hybrid workflows.
@@ -965,6 +1082,18 @@ type KubectlFlags struct {
 	// DisableValidation passes the `--validate=false` flag to supported
 	// `kubectl` commands when enabled.
 	DisableValidation bool `yaml:"disableValidation,omitempty"`
+
+	// ServerSideApply uses server-side apply (`kubectl apply --server-side`) with `skaffold` as the field manager.
+	// Server-side apply doesn't store the `last-applied-configuration` annotation, which is too large for some resources like big CRDs.
+	ServerSideApply bool `yaml:"serverSideApply,omitempty"`
+
+	// ForceConflicts passes the `--force-conflicts` flag to server-side apply so that Skaffold takes ownership of fields
+	// managed by other field managers. Requires `serverSideApply`. It is always set when deploying with `--force`.
+	ForceConflicts bool `yaml:"forceConflicts,omitempty"`
+
+	// WaitOnDelete waits for the resources of each deletion step to be gone, for example for their finalizers to run,
+	// before deleting the next step. Resources are deleted first, then custom resource definitions, then namespaces.
+	WaitOnDelete bool `yaml:"waitOnDelete,omitempty"`
 }
 
 // LegacyHelmDeploy *beta* uses the `helm` CLI to apply the charts to the cluster.
@@ -1034,6 +1163,10 @@ type HelmRelease struct {
 	// all parsed pairs after the flag.
 	SetValueTemplates util.FlatMap `yaml:"setValueTemplates,omitempty" skaffold:"template"`
 
+	// ImageValues set the helm values of the repository and tag of built images, for charts whose values don't follow
+	// the layout expected by `setValueTemplates` shortcuts.
+	ImageValues []HelmImageValues `yaml:"imageValues,omitempty"`
+
 	// SetFiles are key-value pairs.
 	// If present, Skaffold will send `--set-file` flag to Helm CLI and append all pairs after the flag.
 	SetFiles map[string]string `yaml:"setFiles,omitempty" skaffold:"filepath"`
@@ -1047,6 +1180,12 @@ type HelmRelease struct {
 	// Defaults to `false`.
 	Wait bool `yaml:"wait,omitempty"`
 
+	// WaitWithStatusCheck if `true`, Skaffold won't send `--wait` flag to Helm CLI and instead waits for the
+	// resources of the release with its status check, so that they aren't waited for twice.
+	// Only applies when `wait` is `true` and the status check is enabled.
+	// Defaults to `false`.
+	WaitWithStatusCheck bool `yaml:"waitWithStatusCheck,omitempty"`
+
 	// RecreatePods if `true`, Skaffold will send `--recreate-pods` flag to Helm CLI
 	// when upgrading a new version of a chart in subsequent dev loop deploy.
 	// Defaults to `false`.
@@ -1067,6 +1206,10 @@ type HelmRelease struct {
 	// If present, Skaffold will send `--repo` Helm CLI flag or flags.
 	Repo string `yaml:"repo,omitempty"`
 
+	// PostRenderer is an executable that gets the manifests rendered by Helm on stdin, and writes the modified manifests to stdout,
+	// like a kustomize wrapper. It runs before Skaffold sets the images and labels of the manifests, as Helm only supports one post-renderer.
+	PostRenderer string `yaml:"postRenderer,omitempty"`
+
 	// UpgradeOnChange specifies whether to upgrade helm chart on code changes.
 	// Default is `true` when helm chart is local (has `chartPath`).
 	// Default is `false` when helm chart is remote (has `remoteChart`).
@@ -1084,6 +1227,30 @@ type HelmRelease struct {
 	DependsOn []string `yaml:"dependsOn,omitempty"`
 }
 
+// HelmImageValues sets the helm values of the reference of a built image.
+type HelmImageValues struct {
+	// Image is the name of the built artifact.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Repository is the key of the value set to the repository of the image.
+	// For example: `image.repository`.
+	Repository string `yaml:"repository,omitempty"`
+
+	// Tag is the key of the value set to the tag of the image.
+	// For example: `image.tag`.
+	Tag string `yaml:"tag,omitempty"`
+
+	// Digest is the key of the value set to the digest of the image, `sha256:...`.
+	// For example: `image.digest`. It's not set for images without digest, like local images.
+	Digest string `yaml:"digest,omitempty"`
+
+	// UseDigest sets the tag value to the tag and digest of the image, `tag@sha256:...`, so that the image is pinned by digest.
+	// It's ignored for images without digest, like local images, and can't be used for images that are only referenced by digest:
+	// use `digest` for those instead.
+	// Defaults to `false`.
+	UseDigest bool `yaml:"useDigest,omitempty"`
+}
+
 // HelmPackaged parameters for packaging helm chart (`helm package`).
 type HelmPackaged struct {
 	// Version sets the `version` on the chart to this semver version.
@@ -1181,6 +1348,9 @@ type SyncRule struct {
 	Src string `yaml:"src,omitempty" yamltags:"required"`
 
 	// Dest is the destination path in the container where the files should be synced to.
+	// It can also be a template giving the destination of each file, with `{{.RelPath}}` the path of the file
+	// relative to the static part of `src`: `src: "src/**"` and `dest: "/app/{{.RelPath}}"` sync the `src` folder
+	// to `/app/`, preserving its structure. Templates can't be used with `strip`.
 	// For example: `"app/"`
 	Dest string `yaml:"dest,omitempty" yamltags:"required"`
 
@@ -1188,6 +1358,11 @@ type SyncRule struct {
 	// transplanting the files into the destination folder.
 	// For example: `"css/"`
 	Strip string `yaml:"strip,omitempty"`
+
+	// Container is the name of the container to sync the files to, in the pods running the artifact's image.
+	// Use it to sync files to a sidecar. Relative destinations are still resolved against the working directory of the artifact's image.
+	// Defaults to the containers running the artifact's image.
+	Container string `yaml:"container,omitempty"`
 }
 
 // Profile is used to override any `build`, `test` or `deploy` configuration.
@@ -1264,6 +1439,9 @@ type ArtifactType struct {
 	// KoArtifact builds images using [ko](https://github.com/google/ko).
 	KoArtifact *KoArtifact `yaml:"ko,omitempty" yamltags:"oneOf=artifact"`
 
+	// ApkoArtifact *alpha* builds images from a YAML configuration using [apko](https://github.com/chainguard-dev/apko).
+	ApkoArtifact *ApkoArtifact `yaml:"apko,omitempty" yamltags:"oneOf=artifact"`
+
 	// JibArtifact builds images using the
 	// [Jib plugins for Maven or Gradle](https://github.com/GoogleContainerTools/jib/).
 	JibArtifact *JibArtifact `yaml:"jib,omitempty" yamltags:"oneOf=artifact"`
@@ -1622,6 +1800,17 @@ type DockerArtifact struct {
 
 	// SSH is used to pass in --ssh to docker build to use SSH agent. Format is "default|<id>[=<socket>|<key>[,<key>]]".
 	SSH string `yaml:"ssh,omitempty"`
+
+	// DockerHost is the address of the Docker daemon that builds the image, instead of the daemon of the local build.
+	// This builds images needing specific hardware, like GPUs, on a remote machine.
+	// The daemon is checked to be reachable before the build. For example: `ssh://user@gpu-builder` or `tcp://10.0.0.5:2376`.
+	DockerHost string `yaml:"dockerHost,omitempty"`
+
+	// InlineCache embeds the BuildKit build cache in the built image, with `BUILDKIT_INLINE_CACHE=1`,
+	// so that later builds reuse its layers without a separate cache repository. The cache is pulled from the `latest` tag
+	// of the image, which is pushed along with the built tag, unless `cacheFrom` lists an image of the same repository.
+	// It's ignored if BuildKit isn't available.
+	InlineCache bool `yaml:"inlineCache,omitempty"`
 }
 
 // DockerSecret is used to pass in --secret to docker build, `useBuildKit: true` is required.
@@ -1651,6 +1840,22 @@ type BazelArtifact struct {
 	PlatformMappings []BazelPlatformMapping `yaml:"platforms,omitempty"`
 }
 
+// ApkoArtifact describes an artifact built with [apko](https://github.com/chainguard-dev/apko).
+type ApkoArtifact struct {
+	// Config is the path to the apko configuration file, relative to the context directory.
+	// Defaults to `apko.yaml`.
+	Config string `yaml:"config,omitempty"`
+
+	// Architectures are the architectures of the image.
+	// For example: `["amd64", "arm64"]`.
+	// Defaults to the target platforms of the build, or to the architectures of the apko configuration.
+	Architectures []string `yaml:"archs,omitempty"`
+
+	// Args are additional args to pass to `apko build` or `apko publish`.
+	// For example: `["--sbom=false"]`.
+	Args []string `yaml:"args,omitempty"`
+}
+
 // KoArtifact builds images using [ko](https://github.com/google/ko).
 type KoArtifact struct {
 	// BaseImage overrides the default ko base image (`gcr.io/distroless/static:nonroot`).
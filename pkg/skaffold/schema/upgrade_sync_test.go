@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v1beta9"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v2beta8"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/v2/testutil"
+)
+
+const legacySyncMapConfig = `apiVersion: %s
+kind: Config
+build:
+  artifacts:
+  - image: app
+    sync:
+      'src/*.js': app/
+      '*.html': static/
+profiles:
+- name: dev
+  build:
+    artifacts:
+    - image: app
+      sync:
+        '*.css': static/
+`
+
+const legacySyncAutoConfig = `apiVersion: %s
+kind: Config
+build:
+  artifacts:
+  - image: app
+    sync:
+      auto: {}
+`
+
+func TestUpgradeLegacySync(t *testing.T) {
+	manualSync := &latest.Sync{Manual: []*latest.SyncRule{
+		{Src: "*.html", Dest: "static/"},
+		{Src: "src/*.js", Dest: "app/", Strip: "src/"},
+	}}
+	profileSync := &latest.Sync{Manual: []*latest.SyncRule{{Src: "*.css", Dest: "static/"}}}
+	autoSync := &latest.Sync{Auto: util.Ptr(true)}
+
+	tests := []struct {
+		description         string
+		config              string
+		apiVersion          string
+		expectedSync        *latest.Sync
+		expectedProfileSync *latest.Sync
+	}{
+		{
+			description:         "sync map",
+			config:              legacySyncMapConfig,
+			apiVersion:          v1beta9.Version,
+			expectedSync:        manualSync,
+			expectedProfileSync: profileSync,
+		},
+		{
+			description:  "empty auto sync",
+			config:       legacySyncAutoConfig,
+			apiVersion:   v2beta8.Version,
+			expectedSync: autoSync,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("skaffold.yaml", fmt.Sprintf(test.config, test.apiVersion))
+
+			cfgs, err := ParseConfigAndUpgrade(tmpDir.Path("skaffold.yaml"))
+			t.CheckNoError(err)
+			cfg := cfgs[0].(*latest.SkaffoldConfig)
+			t.CheckDeepEqual(test.expectedSync, cfg.Build.Artifacts[0].Sync)
+			if test.expectedProfileSync != nil {
+				t.CheckDeepEqual(test.expectedProfileSync, cfg.Profiles[0].Build.Artifacts[0].Sync)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package v1beta9
 import (
 	"context"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/output/log"
@@ -75,37 +76,8 @@ func convertSyncRules(artifacts []*Artifact) [][]*next.SyncRule {
 	var incompatiblePatterns []string
 	newSync := make([][]*next.SyncRule, len(artifacts))
 	for i, a := range artifacts {
-		newRules := make([]*next.SyncRule, 0, len(a.Sync))
-		for src, dest := range a.Sync {
-			var syncRule *next.SyncRule
-			switch {
-			case compatibleSimplePattern.MatchString(src):
-				dest, strip := simplify(dest, compatibleSimplePattern.FindStringSubmatch(src)[1])
-				syncRule = &next.SyncRule{
-					Src:   src,
-					Dest:  dest,
-					Strip: strip,
-				}
-			case strings.Contains(src, "***"):
-				dest, strip := simplify(dest, strings.Split(src, "***")[0])
-				syncRule = &next.SyncRule{
-					Src:   strings.ReplaceAll(src, "***", "**"),
-					Dest:  dest,
-					Strip: strip,
-				}
-			default:
-				// Incompatible patterns contain `**` or glob directories.
-				// Such patterns flatten the content at the destination which
-				// cannot be reproduced with the current config. For example:
-				// `/app/**/subdir/*.html`, `/app/*/*.html`
-				incompatiblePatterns = append(incompatiblePatterns, src)
-				syncRule = &next.SyncRule{
-					Src:  src,
-					Dest: dest,
-				}
-			}
-			newRules = append(newRules, syncRule)
-		}
+		newRules, incompatible := convertSyncMap(a.Sync)
+		incompatiblePatterns = append(incompatiblePatterns, incompatible...)
 		newSync[i] = newRules
 		// blank input sync because it breaks cloning
 		a.Sync = nil
@@ -116,6 +88,54 @@ func convertSyncRules(artifacts []*Artifact) [][]*next.SyncRule {
 	return newSync
 }
 
+// convertSyncMap converts a sync map into sync rules, sorted by pattern, that preserve its semantics.
+// It also returns the patterns whose semantics can't be preserved.
+func convertSyncMap(sync map[string]string) ([]*next.SyncRule, []string) {
+	var incompatiblePatterns []string
+	newRules := make([]*next.SyncRule, 0, len(sync))
+	for _, src := range sortedKeys(sync) {
+		dest := sync[src]
+		var syncRule *next.SyncRule
+		switch {
+		case compatibleSimplePattern.MatchString(src):
+			dest, strip := simplify(dest, compatibleSimplePattern.FindStringSubmatch(src)[1])
+			syncRule = &next.SyncRule{
+				Src:   src,
+				Dest:  dest,
+				Strip: strip,
+			}
+		case strings.Contains(src, "***"):
+			dest, strip := simplify(dest, strings.Split(src, "***")[0])
+			syncRule = &next.SyncRule{
+				Src:   strings.ReplaceAll(src, "***", "**"),
+				Dest:  dest,
+				Strip: strip,
+			}
+		default:
+			// Incompatible patterns contain `**` or glob directories.
+			// Such patterns flatten the content at the destination which
+			// cannot be reproduced with the current config. For example:
+			// `/app/**/subdir/*.html`, `/app/*/*.html`
+			incompatiblePatterns = append(incompatiblePatterns, src)
+			syncRule = &next.SyncRule{
+				Src:  src,
+				Dest: dest,
+			}
+		}
+		newRules = append(newRules, syncRule)
+	}
+	return newRules, incompatiblePatterns
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // simplify dest and strip, if strip is a suffix of dest modulo a trailing `/`.
 func simplify(dest, strip string) (string, string) {
 	if strip == "" || strip == "/" || dest == "" {
@@ -19,14 +19,18 @@ package validation
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
 	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
@@ -38,6 +42,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/parser/configlocations"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/util/stringslice"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/yamltags"
@@ -82,12 +87,24 @@ func ProcessToErrorWithLocation(configs parser.SkaffoldConfigSet, validateConfig
 		errs = append(errs, validateJibPluginTypes(config, config.Build.Artifacts)...)
 		errs = append(errs, validateKoSync(config, config.Build.Artifacts)...)
 		errs = append(errs, validateLogPrefix(config, config.Deploy.Logs)...)
+		errs = append(errs, validateStatusCheckDeadline(config)...)
+		errs = append(errs, validateStatusCheckExclude(config, config.Deploy.StatusCheckExclude)...)
+		errs = append(errs, validateStatusCheckSuggestions(config, config.Deploy.StatusCheckSuggestions)...)
+		errs = append(errs, validateStatusCheckRetryableErrors(config)...)
+		errs = append(errs, validateStatusCheckFailOn(config)...)
+		errs = append(errs, validateStatusCheckWebhook(config)...)
+		errs = append(errs, validateStatusCheckLogPrefix(config)...)
+		errs = append(errs, validateStatusCheckReadyPercent(config)...)
+		errs = append(errs, validateStatusCheckReadiness(config, config.Deploy.StatusCheckReadiness)...)
+		errs = append(errs, validateKubectlFlags(config, config.Deploy.KubectlDeploy)...)
 		errs = append(errs, validateArtifactTypes(config, config.Build)...)
 		errs = append(errs, validateTaggingPolicy(config, config.Build)...)
 		errs = append(errs, validateCustomTest(config, config.Test)...)
 		errs = append(errs, validateGCBConfig(config, config.Build)...)
+		errs = append(errs, validateLoadTo(config, config.Build)...)
 	}
 	errs = append(errs, validateArtifactDependencies(configs)...)
+	errs = append(errs, validateHelmImageValues(configs)...)
 	if validateConfig.CheckDeploySource {
 		// TODO(6050) validate for other deploy types - helm, kpt, etc.
 		errs = append(errs, validateKubectlManifests(configs)...)
@@ -125,6 +142,7 @@ func ProcessWithRunContext(ctx context.Context, runCtx *runcontext.RunContext) e
 	errs = append(errs, validateCustomActionsLists(runCtx)...)
 	errs = append(errs, validateCustomActionsNames(runCtx)...)
 	errs = append(errs, validateCustomActionsExecModes(runCtx)...)
+	errs = append(errs, validateDefaultRepo(runCtx)...)
 
 	if len(errs) == 0 {
 		return nil
@@ -214,6 +232,37 @@ func validateArtifactDependencies(configs parser.SkaffoldConfigSet) (cfgErrs []E
 	return
 }
 
+// validateHelmImageValues makes sure that the images of the helm `imageValues` are built artifacts.
+func validateHelmImageValues(configs parser.SkaffoldConfigSet) (cfgErrs []ErrorWithLocation) {
+	artifacts := map[string]bool{}
+	for _, c := range configs {
+		for _, a := range c.Build.Artifacts {
+			artifacts[a.ImageName] = true
+		}
+	}
+	for _, c := range configs {
+		var releases []latest.HelmRelease
+		if c.Deploy.LegacyHelmDeploy != nil {
+			releases = append(releases, c.Deploy.LegacyHelmDeploy.Releases...)
+		}
+		if c.Render.Helm != nil {
+			releases = append(releases, c.Render.Helm.Releases...)
+		}
+		for _, r := range releases {
+			for i, v := range r.ImageValues {
+				if artifacts[v.Image] {
+					continue
+				}
+				cfgErrs = append(cfgErrs, ErrorWithLocation{
+					Error:    fmt.Errorf("image %q of the imageValues of helm release %q isn't a built artifact", v.Image, r.Name),
+					Location: c.YAMLInfos.LocateField(&r.ImageValues[i], "Image"),
+				})
+			}
+		}
+	}
+	return
+}
+
 // validateAcyclicDependencies makes sure all artifact dependencies are found and don't have cyclic references
 func validateAcyclicDependencies(cfgs *parser.SkaffoldConfigSet, artifacts []*latest.Artifact) (cfgErrs []ErrorWithLocation) {
 	m := make(map[string]*latest.Artifact)
@@ -549,14 +598,24 @@ func visitStructs(cfg *parser.SkaffoldConfigEntry, v reflect.Value, visitor func
 	}
 }
 
-// validateSyncRules checks that all manual sync rules have a valid strip prefix
+// validateSyncRules checks that all manual sync rules have a valid strip prefix and destination template
 func validateSyncRules(cfg *parser.SkaffoldConfigEntry, artifacts []*latest.Artifact) []ErrorWithLocation {
 	var cfgErrs []ErrorWithLocation
 	for i, a := range artifacts {
 		if a.Sync != nil {
 			for _, r := range a.Sync.Manual {
-				if !strings.HasPrefix(r.Src, r.Strip) {
-					err := fmt.Errorf("sync rule pattern '%s' does not have prefix '%s'", r.Src, r.Strip)
+				var err error
+				switch {
+				case !strings.HasPrefix(r.Src, r.Strip):
+					err = fmt.Errorf("sync rule pattern '%s' does not have prefix '%s'", r.Src, r.Strip)
+				case sync.IsDestTemplate(r.Dest) && r.Strip != "":
+					err = fmt.Errorf("sync rule destination template '%s' can't be used with strip '%s'", r.Dest, r.Strip)
+				case sync.IsDestTemplate(r.Dest):
+					if _, parseErr := util.ParseEnvTemplate(r.Dest); parseErr != nil {
+						err = fmt.Errorf("sync rule destination '%s' is not a valid template: %w", r.Dest, parseErr)
+					}
+				}
+				if err != nil {
 					cfgErrs = append(cfgErrs, ErrorWithLocation{
 						Error:    err,
 						Location: cfg.YAMLInfos.LocateField(cfg.Build.Artifacts[i], "Sync"),
@@ -569,7 +628,7 @@ func validateSyncRules(cfg *parser.SkaffoldConfigEntry, artifacts []*latest.Arti
 }
 
 // validatePortForwardResources checks that all user defined port forward resources
-// have a valid resourceType
+// have a valid resourceType and protocol
 func validatePortForwardResources(cfg *parser.SkaffoldConfigEntry, pfrs []*latest.PortForwardResource) []ErrorWithLocation {
 	var errs []ErrorWithLocation
 	validResourceTypes := map[string]struct{}{
@@ -592,6 +651,21 @@ func validatePortForwardResources(cfg *parser.SkaffoldConfigEntry, pfrs []*lates
 				Location: cfg.YAMLInfos.Locate(pfrs[i]),
 			})
 		}
+		switch strings.ToUpper(pfr.Protocol) {
+		case "", "TCP":
+		case "UDP":
+			if resourceType != "pod" && resourceType != "service" {
+				errs = append(errs, ErrorWithLocation{
+					Error:    fmt.Errorf("UDP port forwarding is only supported for pods and services, not %s", pfr.Type),
+					Location: cfg.YAMLInfos.Locate(pfrs[i]),
+				})
+			}
+		default:
+			errs = append(errs, ErrorWithLocation{
+				Error:    fmt.Errorf("%s is not a valid protocol for port forwarding", pfr.Protocol),
+				Location: cfg.YAMLInfos.Locate(pfrs[i]),
+			})
+		}
 	}
 	return errs
 }
@@ -688,6 +762,28 @@ func validateGCBConfig(cfg *parser.SkaffoldConfigEntry, bc latest.BuildConfig) (
 	return cfgErrs
 }
 
+// validateLoadTo checks that built images are loaded into a supported cluster, and not pushed too.
+func validateLoadTo(cfg *parser.SkaffoldConfigEntry, bc latest.BuildConfig) (cfgErrs []ErrorWithLocation) {
+	if bc.LocalBuild == nil || bc.LocalBuild.LoadTo == "" {
+		return nil
+	}
+	switch bc.LocalBuild.LoadTo {
+	case "kind", "minikube", "auto":
+	default:
+		cfgErrs = append(cfgErrs, ErrorWithLocation{
+			Error:    fmt.Errorf("invalid value %q for loadTo. Must be one of kind, minikube or auto", bc.LocalBuild.LoadTo),
+			Location: cfg.YAMLInfos.LocateField(cfg.Build.LocalBuild, "LoadTo"),
+		})
+	}
+	if bc.LocalBuild.Push != nil && *bc.LocalBuild.Push {
+		cfgErrs = append(cfgErrs, ErrorWithLocation{
+			Error:    fmt.Errorf("loadTo can't be used with push: true, images are loaded into the cluster instead of being pushed"),
+			Location: cfg.YAMLInfos.LocateField(cfg.Build.LocalBuild, "LoadTo"),
+		})
+	}
+	return cfgErrs
+}
+
 // validateLogPrefix checks that logs are configured with a valid prefix.
 func validateLogPrefix(cfg *parser.SkaffoldConfigEntry, lc latest.LogsConfig) []ErrorWithLocation {
 	validPrefixes := []string{"", "auto", "container", "podAndContainer", "none"}
@@ -704,6 +800,193 @@ func validateLogPrefix(cfg *parser.SkaffoldConfigEntry, lc latest.LogsConfig) []
 	return nil
 }
 
+// validateStatusCheckDeadline checks that the status check deadline isn't negative, which would be silently ignored.
+func validateStatusCheckDeadline(cfg *parser.SkaffoldConfigEntry) []ErrorWithLocation {
+	if cfg.Deploy.StatusCheckDeadlineSeconds >= 0 {
+		return nil
+	}
+	return []ErrorWithLocation{
+		{
+			Error:    statusCheckErr("statusCheckDeadlineSeconds", fmt.Errorf("deadline %d must not be negative", cfg.Deploy.StatusCheckDeadlineSeconds)),
+			Location: cfg.YAMLInfos.LocateField(&cfg.Deploy, "StatusCheckDeadlineSeconds"),
+		},
+	}
+}
+
+// validateStatusCheckExclude checks that the status check exclusions have a valid name pattern and label selector.
+func validateStatusCheckExclude(cfg *parser.SkaffoldConfigEntry, excludes []latest.StatusCheckExclude) (cfgErrs []ErrorWithLocation) {
+	for i := range excludes {
+		e := &excludes[i]
+		field := fmt.Sprintf("statusCheckExclude[%d]", i)
+		if e.Name == "" && e.Labels == "" {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(field, errors.New("entries must specify a name or labels")),
+				Location: cfg.YAMLInfos.Locate(e),
+			})
+			continue
+		}
+		if _, err := path.Match(e.Name, ""); err != nil {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(field+".name", fmt.Errorf("invalid name pattern '%s': %w", e.Name, err)),
+				Location: cfg.YAMLInfos.LocateField(e, "Name"),
+			})
+		}
+		if _, err := labels.Parse(e.Labels); err != nil {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(field+".labels", fmt.Errorf("invalid label selector '%s': %w", e.Labels, err)),
+				Location: cfg.YAMLInfos.LocateField(e, "Labels"),
+			})
+		}
+	}
+	return
+}
+
+// validateStatusCheckSuggestions checks that the custom status check suggestions are for status check codes,
+// and have an action or a URL.
+func validateStatusCheckSuggestions(cfg *parser.SkaffoldConfigEntry, suggestions []latest.StatusCheckSuggestion) (cfgErrs []ErrorWithLocation) {
+	for i := range suggestions {
+		s := &suggestions[i]
+		field := fmt.Sprintf("statusCheckSuggestions[%d]", i)
+		if !isStatusCheckCode(s.Code) {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(field+".code", fmt.Errorf("invalid code '%s': must be a STATUSCHECK_* status code", s.Code)),
+				Location: cfg.YAMLInfos.LocateField(s, "Code"),
+			})
+		}
+		if s.Action == "" && s.URL == "" {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(field, fmt.Errorf("entry for '%s' must specify an action or a url", s.Code)),
+				Location: cfg.YAMLInfos.Locate(s),
+			})
+		}
+	}
+	return
+}
+
+// validateStatusCheckRetryableErrors checks that the retryable error substrings aren't empty, which would retry all errors.
+func validateStatusCheckRetryableErrors(cfg *parser.SkaffoldConfigEntry) (cfgErrs []ErrorWithLocation) {
+	for i, e := range cfg.Deploy.StatusCheckRetryableErrors {
+		if strings.TrimSpace(e) == "" {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(fmt.Sprintf("statusCheckRetryableErrors[%d]", i), errors.New("entries must not be empty")),
+				Location: cfg.YAMLInfos.LocateElement(&cfg.Deploy.StatusCheckRetryableErrors, i),
+			})
+		}
+	}
+	return
+}
+
+// validateStatusCheckFailOn checks that the status codes failing the status check are status check codes.
+func validateStatusCheckFailOn(cfg *parser.SkaffoldConfigEntry) (cfgErrs []ErrorWithLocation) {
+	for i, code := range cfg.Deploy.StatusCheckFailOn {
+		if !isStatusCheckCode(code) {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(fmt.Sprintf("statusCheckFailOn[%d]", i), fmt.Errorf("invalid code '%s': must be a STATUSCHECK_* status code", code)),
+				Location: cfg.YAMLInfos.LocateElement(&cfg.Deploy.StatusCheckFailOn, i),
+			})
+		}
+	}
+	return
+}
+
+// validateStatusCheckWebhook checks that the status check webhook, if set, is an http or https URL.
+func validateStatusCheckWebhook(cfg *parser.SkaffoldConfigEntry) []ErrorWithLocation {
+	webhook := cfg.Deploy.StatusCheckWebhook
+	if webhook == "" {
+		return nil
+	}
+	if u, err := url.Parse(webhook); err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		return nil
+	}
+	return []ErrorWithLocation{
+		{
+			Error:    statusCheckErr("statusCheckWebhook", fmt.Errorf("%q must be an http or https URL", webhook)),
+			Location: cfg.YAMLInfos.LocateField(&cfg.Deploy, "StatusCheckWebhook"),
+		},
+	}
+}
+
+// validateStatusCheckLogPrefix checks that the status check log prefix, if set, is a valid Go template.
+func validateStatusCheckLogPrefix(cfg *parser.SkaffoldConfigEntry) []ErrorWithLocation {
+	prefix := cfg.Deploy.StatusCheckLogPrefix
+	if prefix == "" {
+		return nil
+	}
+	if _, err := template.New("statusCheckLogPrefix").Parse(prefix); err != nil {
+		return []ErrorWithLocation{
+			{
+				Error:    statusCheckErr("statusCheckLogPrefix", fmt.Errorf("invalid template %q: %w", prefix, err)),
+				Location: cfg.YAMLInfos.LocateField(&cfg.Deploy, "StatusCheckLogPrefix"),
+			},
+		}
+	}
+	return nil
+}
+
+// validateStatusCheckReadyPercent checks that the ready percentage, if set, is between 1 and 100.
+func validateStatusCheckReadyPercent(cfg *parser.SkaffoldConfigEntry) []ErrorWithLocation {
+	percent := cfg.Deploy.StatusCheckReadyPercent
+	if percent >= 0 && percent <= 100 {
+		return nil
+	}
+	return []ErrorWithLocation{
+		{
+			Error:    statusCheckErr("statusCheckReadyPercent", fmt.Errorf("%d must be between 1 and 100", percent)),
+			Location: cfg.YAMLInfos.LocateField(&cfg.Deploy, "StatusCheckReadyPercent"),
+		},
+	}
+}
+
+// statusCheckBuiltinKinds are the kinds that the status check already waits for, by API group.
+var statusCheckBuiltinKinds = map[string]string{
+	"deployment":            "apps",
+	"statefulset":           "apps",
+	"replicaset":            "apps",
+	"pod":                   "",
+	"service":               "",
+	"ingress":               "networking.k8s.io",
+	"persistentvolumeclaim": "",
+}
+
+// validateStatusCheckReadiness checks that the readiness rules don't apply to the kinds with a built-in status check.
+func validateStatusCheckReadiness(cfg *parser.SkaffoldConfigEntry, rules []latest.StatusCheckReadiness) (cfgErrs []ErrorWithLocation) {
+	for i := range rules {
+		rule := &rules[i]
+		group, builtin := statusCheckBuiltinKinds[strings.ToLower(rule.Kind)]
+		if builtin && (rule.Group == "" || rule.Group == group) {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    statusCheckErr(fmt.Sprintf("statusCheckReadiness[%d].kind", i), fmt.Errorf("can't be set for kind '%s', which the status check already waits for", rule.Kind)),
+				Location: cfg.YAMLInfos.LocateField(rule, "Kind"),
+			})
+		}
+	}
+	return
+}
+
+func isStatusCheckCode(code string) bool {
+	_, found := proto.StatusCode_value[code]
+	return found && strings.HasPrefix(code, "STATUSCHECK_")
+}
+
+// statusCheckErr prefixes an error with the YAML path of the invalid status check field, relative to `deploy`,
+// since the same error can be reported for several entries of a list.
+func statusCheckErr(field string, err error) error {
+	return fmt.Errorf("deploy.%s: %w", field, err)
+}
+
+// validateKubectlFlags checks that `forceConflicts` is only set along with `serverSideApply`.
+func validateKubectlFlags(cfg *parser.SkaffoldConfigEntry, kd *latest.KubectlDeploy) []ErrorWithLocation {
+	if kd == nil || !kd.Flags.ForceConflicts || kd.Flags.ServerSideApply {
+		return nil
+	}
+	return []ErrorWithLocation{
+		{
+			Error:    errors.New("kubectl flag 'forceConflicts' requires 'serverSideApply' to be enabled"),
+			Location: cfg.YAMLInfos.Locate(&kd.Flags),
+		},
+	}
+}
+
 // validateVerifyTests
 // - makes sure that each test name is unique
 // - makes sure that each container name is unique
@@ -791,6 +1074,18 @@ func validateCustomActionsExecModes(runCtx *runcontext.RunContext) (errs []error
 	return
 }
 
+// validateDefaultRepo fails fast on invalid default repo templates instead of when the first image is tagged.
+func validateDefaultRepo(runCtx *runcontext.RunContext) []error {
+	defaultRepo, err := config.GetDefaultRepo(runCtx.GlobalConfig(), runCtx.DefaultRepo())
+	if err != nil {
+		return nil
+	}
+	if err := docker.ValidateDefaultRepo(defaultRepo); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
 // validateCustomTest
 // - makes sure that command is not empty
 // - makes sure that dependencies.ignore is only used in conjunction with dependencies.paths
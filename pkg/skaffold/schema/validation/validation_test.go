@@ -830,6 +830,45 @@ func TestValidateSyncRules(t *testing.T) {
 			}},
 			shouldErr: true,
 		},
+		{
+			description: "destination template",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync: &latest.Sync{Manual: []*latest.SyncRule{
+					{
+						Src:  "src/**",
+						Dest: "/app/{{.RelPath}}",
+					},
+				}},
+			}},
+		},
+		{
+			description: "destination template with strip",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync: &latest.Sync{Manual: []*latest.SyncRule{
+					{
+						Src:   "src/**",
+						Dest:  "/app/{{.RelPath}}",
+						Strip: "src/",
+					},
+				}},
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "invalid destination template",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync: &latest.Sync{Manual: []*latest.SyncRule{
+					{
+						Src:  "src/**",
+						Dest: "/app/{{.RelPath",
+					},
+				}},
+			}},
+			shouldErr: true,
+		},
 		{
 			description: "two bad rules",
 			artifacts: []*latest.Artifact{{
@@ -946,6 +985,7 @@ func TestValidateCustomDependencies(t *testing.T) {
 func TestValidatePortForwardResources(t *testing.T) {
 	tests := []struct {
 		resourceType string
+		protocol     string
 		shouldErr    bool
 	}{
 		{resourceType: "pod"},
@@ -958,12 +998,18 @@ func TestValidatePortForwardResources(t *testing.T) {
 		{resourceType: "cronjob"},
 		{resourceType: "job"},
 		{resourceType: "dne", shouldErr: true},
+		{resourceType: "service", protocol: "UDP"},
+		{resourceType: "pod", protocol: "udp"},
+		{resourceType: "deployment", protocol: "TCP"},
+		{resourceType: "deployment", protocol: "UDP", shouldErr: true},
+		{resourceType: "service", protocol: "SCTP", shouldErr: true},
 	}
 	for _, test := range tests {
-		testutil.Run(t, test.resourceType, func(t *testutil.T) {
+		testutil.Run(t, test.resourceType+" "+test.protocol, func(t *testutil.T) {
 			pfrs := []*latest.PortForwardResource{
 				{
-					Type: latest.ResourceType(test.resourceType),
+					Type:     latest.ResourceType(test.resourceType),
+					Protocol: test.protocol,
 				},
 			}
 			errs := validatePortForwardResources(&parser.SkaffoldConfigEntry{YAMLInfos: configlocations.NewYAMLInfos()}, pfrs)
@@ -1268,6 +1314,358 @@ func TestValidateLogsConfig(t *testing.T) {
 	}
 }
 
+func TestValidateStatusCheckExclude(t *testing.T) {
+	tests := []struct {
+		description string
+		exclude     latest.StatusCheckExclude
+		shouldErr   bool
+	}{
+		{description: "name pattern", exclude: latest.StatusCheckExclude{Name: "db-migration-*"}},
+		{description: "label selector", exclude: latest.StatusCheckExclude{Labels: "app=migration,tier!=web"}},
+		{description: "name and labels", exclude: latest.StatusCheckExclude{Name: "migration", Labels: "app"}},
+		{description: "empty", shouldErr: true},
+		{description: "invalid name pattern", exclude: latest.StatusCheckExclude{Name: "migration-["}, shouldErr: true},
+		{description: "invalid label selector", exclude: latest.StatusCheckExclude{Labels: "app in (web"}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckExclude: []latest.StatusCheckExclude{test.exclude},
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckSuggestions(t *testing.T) {
+	tests := []struct {
+		description string
+		suggestion  latest.StatusCheckSuggestion
+		shouldErr   bool
+	}{
+		{description: "action", suggestion: latest.StatusCheckSuggestion{Code: "STATUSCHECK_IMAGE_PULL_ERR", Action: "Check the team registry"}},
+		{description: "url", suggestion: latest.StatusCheckSuggestion{Code: "STATUSCHECK_IMAGE_PULL_ERR", URL: "https://wiki.example.com/image-pull"}},
+		{description: "no action nor url", suggestion: latest.StatusCheckSuggestion{Code: "STATUSCHECK_IMAGE_PULL_ERR"}, shouldErr: true},
+		{description: "unknown code", suggestion: latest.StatusCheckSuggestion{Code: "STATUSCHECK_NOPE", Action: "Check"}, shouldErr: true},
+		{description: "not a status check code", suggestion: latest.StatusCheckSuggestion{Code: "BUILD_PUSH_ACCESS_DENIED", Action: "Check"}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckSuggestions: []latest.StatusCheckSuggestion{test.suggestion},
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckRetryableErrors(t *testing.T) {
+	tests := []struct {
+		description string
+		errs        []string
+		shouldErr   bool
+	}{
+		{description: "none"},
+		{description: "substring", errs: []string{"etcdserver: request timed out"}},
+		{description: "empty substring", errs: []string{"etcdserver: request timed out", " "}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckRetryableErrors: test.errs,
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckFailOn(t *testing.T) {
+	tests := []struct {
+		description string
+		codes       []string
+		shouldErr   bool
+	}{
+		{description: "none"},
+		{description: "status check codes", codes: []string{"STATUSCHECK_NODE_UNSCHEDULABLE", "STATUSCHECK_KUBECTL_CONNECTION_ERR"}},
+		{description: "unknown code", codes: []string{"STATUSCHECK_NODE_UNSCHEDULABLE", "UNSCHEDULABLE"}, shouldErr: true},
+		{description: "not a status check code", codes: []string{"BUILD_PUSH_ACCESS_DENIED"}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckFailOn: test.codes,
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckWebhook(t *testing.T) {
+	tests := []struct {
+		description string
+		webhook     string
+		shouldErr   bool
+	}{
+		{description: "none"},
+		{description: "https", webhook: "https://hooks.example.com/skaffold"},
+		{description: "http", webhook: "http://localhost:8080"},
+		{description: "no scheme", webhook: "hooks.example.com/skaffold", shouldErr: true},
+		{description: "unsupported scheme", webhook: "ftp://hooks.example.com", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckWebhook: test.webhook,
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckLogPrefix(t *testing.T) {
+	tests := []struct {
+		description string
+		prefix      string
+		shouldErr   bool
+	}{
+		{description: "not set"},
+		{description: "labels and owner", prefix: "[app={{.Labels.app}} {{.Owner}}]"},
+		{description: "invalid template", prefix: "[{{.Labels.app}]", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckLogPrefix: test.prefix,
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckReadyPercent(t *testing.T) {
+	tests := []struct {
+		description string
+		percent     int
+		shouldErr   bool
+	}{
+		{description: "not set"},
+		{description: "partial", percent: 80},
+		{description: "full", percent: 100},
+		{description: "negative", percent: -1, shouldErr: true},
+		{description: "above 100", percent: 120, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckReadyPercent: test.percent,
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckReadiness(t *testing.T) {
+	tests := []struct {
+		description string
+		rules       []latest.StatusCheckReadiness
+		shouldErr   bool
+	}{
+		{description: "none"},
+		{description: "custom kind", rules: []latest.StatusCheckReadiness{{Kind: "Certificate", Group: "cert-manager.io", JSONPath: "{.status.conditions[0].status}", Value: "True"}}},
+		{description: "core kind", rules: []latest.StatusCheckReadiness{{Kind: "PersistentVolume", JSONPath: "{.status.phase}", Value: "Available"}}},
+		{description: "custom kind named like a built-in one", rules: []latest.StatusCheckReadiness{{Kind: "Deployment", Group: "example.com", JSONPath: "{.status.ready}", Value: "true"}}},
+		{description: "built-in kind", rules: []latest.StatusCheckReadiness{{Kind: "deployment", JSONPath: "{.status.readyReplicas}", Value: "1"}}, shouldErr: true},
+		{description: "persistent volume claim", rules: []latest.StatusCheckReadiness{{Kind: "PersistentVolumeClaim", JSONPath: "{.status.phase}", Value: "Bound"}}, shouldErr: true},
+		{description: "built-in kind and group", rules: []latest.StatusCheckReadiness{{Kind: "Ingress", Group: "networking.k8s.io", JSONPath: "{.status}", Value: "ok"}}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckReadiness: test.rules,
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckDeadline(t *testing.T) {
+	tests := []struct {
+		description string
+		deadline    int
+		shouldErr   bool
+	}{
+		{description: "not set"},
+		{description: "deadline", deadline: 300},
+		{description: "negative", deadline: -1, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							StatusCheckDeadlineSeconds: test.deadline,
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateStatusCheckLocations(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("skaffold.yaml", `apiVersion: skaffold/v4beta14
+kind: Config
+deploy:
+  statusCheckDeadlineSeconds: -30
+  statusCheckFailOn:
+  - STATUSCHECK_NODE_UNSCHEDULABLE
+  - UNSCHEDULABLE
+  statusCheckExclude:
+  - name: db-migration
+  - labels: "app in (web"
+  statusCheckReadyPercent: 120
+  kubectl: {}
+`)
+		configs, err := parser.GetConfigSet(context.Background(), config.SkaffoldOptions{ConfigurationFile: tmpDir.Path("skaffold.yaml")})
+		t.CheckNoError(err)
+
+		type location struct {
+			Error string
+			Line  int
+		}
+		var actual []location
+		for _, e := range ProcessToErrorWithLocation(configs, Options{}) {
+			actual = append(actual, location{Error: e.Error.Error(), Line: e.Location.StartLine})
+		}
+		t.CheckDeepEqual([]location{
+			{Error: "deploy.statusCheckDeadlineSeconds: deadline -30 must not be negative", Line: 4},
+			{Error: "deploy.statusCheckExclude[1].labels: invalid label selector 'app in (web': unable to parse requirement: found '', expected: ',' or ')'", Line: 10},
+			{Error: "deploy.statusCheckFailOn[1]: invalid code 'UNSCHEDULABLE': must be a STATUSCHECK_* status code", Line: 7},
+			{Error: "deploy.statusCheckReadyPercent: 120 must be between 1 and 100", Line: 11},
+		}, actual)
+	})
+}
+
+func TestValidateKubectlFlags(t *testing.T) {
+	tests := []struct {
+		description string
+		flags       latest.KubectlFlags
+		shouldErr   bool
+	}{
+		{description: "no flags"},
+		{description: "server-side apply", flags: latest.KubectlFlags{ServerSideApply: true}},
+		{description: "server-side apply with force conflicts", flags: latest.KubectlFlags{ServerSideApply: true, ForceConflicts: true}},
+		{description: "force conflicts without server-side apply", flags: latest.KubectlFlags{ForceConflicts: true}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							DeployType: latest.DeployType{
+								KubectlDeploy: &latest.KubectlDeploy{Flags: test.flags},
+							},
+						},
+					},
+				}}}, Options{CheckDeploySource: false})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateGCBConfig(t *testing.T) {
 	tests := []struct {
 		desc      string
@@ -1323,6 +1721,85 @@ func TestValidateGCBConfig(t *testing.T) {
 	}
 }
 
+func TestValidateLoadTo(t *testing.T) {
+	tests := []struct {
+		description string
+		local       latest.LocalBuild
+		shouldErr   bool
+	}{
+		{description: "not set"},
+		{description: "kind", local: latest.LocalBuild{LoadTo: "kind"}},
+		{description: "minikube", local: latest.LocalBuild{LoadTo: "minikube"}},
+		{description: "auto", local: latest.LocalBuild{LoadTo: "auto", Push: util.Ptr(false)}},
+		{description: "unknown cluster", local: latest.LocalBuild{LoadTo: "k3d"}, shouldErr: true},
+		{description: "with push", local: latest.LocalBuild{LoadTo: "kind", Push: util.Ptr(true)}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			bc := latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &test.local}}
+			err := validateLoadTo(&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Build: bc,
+					},
+				},
+			}, bc)
+
+			t.CheckDeepEqual(test.shouldErr, len(err) > 0)
+		})
+	}
+}
+
+func TestValidateHelmImageValues(t *testing.T) {
+	tests := []struct {
+		description string
+		deploy      latest.DeployType
+		render      latest.RenderConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no helm releases",
+		},
+		{
+			description: "built image in deploy",
+			deploy: latest.DeployType{LegacyHelmDeploy: &latest.LegacyHelmDeploy{Releases: []latest.HelmRelease{{
+				Name: "app", ImageValues: []latest.HelmImageValues{{Image: "web", Tag: "image.tag"}},
+			}}}},
+		},
+		{
+			description: "unknown image in deploy",
+			deploy: latest.DeployType{LegacyHelmDeploy: &latest.LegacyHelmDeploy{Releases: []latest.HelmRelease{{
+				Name: "app", ImageValues: []latest.HelmImageValues{{Image: "wbe", Tag: "image.tag"}},
+			}}}},
+			shouldErr: true,
+		},
+		{
+			description: "unknown image in render",
+			render: latest.RenderConfig{Generate: latest.Generate{Helm: &latest.Helm{Releases: []latest.HelmRelease{{
+				Name: "app", ImageValues: []latest.HelmImageValues{{Image: "wbe", Tag: "image.tag"}},
+			}}}}},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateHelmImageValues(parser.SkaffoldConfigSet{&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Build:  latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "web"}}},
+						Render: test.render,
+						Deploy: latest.DeployConfig{DeployType: test.deploy},
+					},
+				},
+			}})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateAcyclicDependencies(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1840,6 +2317,33 @@ func TestValidateCloudRunLocation(t *testing.T) {
 	}
 }
 
+func TestValidateDefaultRepo(t *testing.T) {
+	tests := []struct {
+		description string
+		defaultRepo string
+		shouldErr   bool
+	}{
+		{
+			description: "template",
+			defaultRepo: "gcr.io/project/{{.Namespace}}",
+		},
+		{
+			description: "invalid template",
+			defaultRepo: "gcr.io/project/{{.Namespace",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := ProcessWithRunContext(context.Background(), &runcontext.RunContext{
+				Pipelines: runcontext.NewPipelines(map[string]latest.Pipeline{"default": {}}, []string{"default"}),
+				Cluster:   config.Cluster{DefaultRepo: config.NewStringOrUndefined(&test.defaultRepo)},
+			})
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateCustomActions(t *testing.T) {
 	tests := []struct {
 		description string
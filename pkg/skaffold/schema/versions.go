@@ -94,6 +94,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v4beta10"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v4beta11"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v4beta12"
+	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v4beta13"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v4beta2"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v4beta3"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/schema/v4beta4"
@@ -188,6 +189,7 @@ var SchemaVersionsV1 = Versions{
 	{v4beta10.Version, v4beta10.NewSkaffoldConfig},
 	{v4beta11.Version, v4beta11.NewSkaffoldConfig},
 	{v4beta12.Version, v4beta12.NewSkaffoldConfig},
+	{v4beta13.Version, v4beta13.NewSkaffoldConfig},
 }
 
 // SchemaVersionsV2 refers to all the supported API Schemas for skaffold v2 executables. The API schema versions are
@@ -224,10 +226,20 @@ func IsSkaffoldConfig(file string) bool {
 
 // ParseConfig reads a configuration file.
 func ParseConfig(filename string) ([]util.VersionedConfig, error) {
+	return ParseConfigWithEnv(filename, EnvExpansion{})
+}
+
+// ParseConfigWithEnv reads a configuration file, after expanding its references to environment variables if enabled.
+func ParseConfigWithEnv(filename string, env EnvExpansion) ([]util.VersionedConfig, error) {
 	buf, err := misc.ReadConfiguration(filename)
 	if err != nil {
 		return nil, fmt.Errorf("read skaffold config: %w", err)
 	}
+	if env.Enabled || env.Strict {
+		if buf, err = expandEnv(buf, env.Strict); err != nil {
+			return nil, err
+		}
+	}
 	factories, err := configFactoryFromAPIVersion(buf)
 	if err != nil {
 		return nil, err
@@ -241,7 +253,13 @@ func ParseConfig(filename string) ([]util.VersionedConfig, error) {
 
 // ParseConfigAndUpgrade reads a configuration file and upgrades it to a given version.
 func ParseConfigAndUpgrade(filename string) ([]util.VersionedConfig, error) {
-	configs, err := ParseConfig(filename)
+	return ParseConfigAndUpgradeWithEnv(filename, EnvExpansion{})
+}
+
+// ParseConfigAndUpgradeWithEnv reads a configuration file, after expanding its references to environment variables if enabled,
+// and upgrades it to a given version.
+func ParseConfigAndUpgradeWithEnv(filename string, env EnvExpansion) ([]util.VersionedConfig, error) {
+	configs, err := ParseConfigWithEnv(filename, env)
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +29,20 @@ type Monitor interface {
 	Reset()
 }
 
+// ChangedImagesMonitor is a Monitor that can restrict its next check to the resources using changed images.
+type ChangedImagesMonitor interface {
+	// SetChangedImages restricts the next check to the resources using one of the image references.
+	// The restriction is lifted by Reset.
+	SetChangedImages([]string)
+}
+
+// BuiltImagesMonitor is a Monitor that can check that the deployed containers run the images built by Skaffold.
+type BuiltImagesMonitor interface {
+	// SetBuiltImages sets the references of the built images, as deployed.
+	// The images are cleared by Reset.
+	SetBuiltImages([]string)
+}
+
 // NoopMonitor is used if status checking has been disabled, either via the CLI
 // or via the Skaffold config.
 type NoopMonitor struct{}
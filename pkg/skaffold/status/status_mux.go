@@ -44,3 +44,21 @@ func (c MonitorMux) Reset() {
 		monitor.Reset()
 	}
 }
+
+// SetChangedImages restricts the next check of the monitors that support it to the resources using the images.
+func (c MonitorMux) SetChangedImages(images []string) {
+	for _, monitor := range c {
+		if m, ok := monitor.(ChangedImagesMonitor); ok {
+			m.SetChangedImages(images)
+		}
+	}
+}
+
+// SetBuiltImages sets the built images of the monitors that support it.
+func (c MonitorMux) SetBuiltImages(images []string) {
+	for _, monitor := range c {
+		if m, ok := monitor.(BuiltImagesMonitor); ok {
+			m.SetBuiltImages(images)
+		}
+	}
+}
@@ -79,3 +79,21 @@ func (m *MockMonitor) Check(ctx context.Context, out io.Writer) error {
 }
 
 func (m *MockMonitor) Reset() { m.run = false }
+
+type changedImagesMonitor struct {
+	MockMonitor
+	images []string
+}
+
+func (m *changedImagesMonitor) SetChangedImages(images []string) {
+	m.images = images
+}
+
+func TestMonitorMuxSetChangedImages(t *testing.T) {
+	changed := &changedImagesMonitor{}
+	m := MonitorMux{&MockMonitor{}, changed}
+
+	m.SetChangedImages([]string{"app:v2"})
+
+	testutil.CheckDeepEqual(t, []string{"app:v2"}, changed.images)
+}
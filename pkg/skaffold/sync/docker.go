@@ -33,6 +33,10 @@ func NewContainerSyncer() *ContainerSyncer {
 }
 
 func (s *ContainerSyncer) Sync(ctx context.Context, _ io.Writer, item *Item) error {
+	for name := range item.Containers {
+		log.Entry(ctx).Warnf("Ignoring sync rules targeting container %q: Docker deployments run a single container per image", name)
+	}
+
 	if len(item.Copy) > 0 {
 		log.Entry(ctx).Info("Copying files:", item.Copy, "to", item.Image)
 		if _, err := util.RunCmdOut(ctx, s.copyFileFn(ctx, item.Artifact.ImageName, item.Copy)); err != nil {
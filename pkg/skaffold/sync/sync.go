@@ -24,6 +24,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/bmatcuk/doublestar"
@@ -103,7 +104,32 @@ func syncItem(ctx context.Context, a *latest.Artifact, tag string, e filemon.Eve
 		return nil, nil
 	}
 
-	return &Item{Image: tag, Artifact: a, Copy: toCopy, Delete: toDelete}, nil
+	item := &Item{Image: tag, Artifact: a, Copy: syncMap{}, Delete: syncMap{}}
+	for container, files := range toCopy {
+		if container == "" {
+			item.Copy = files
+		} else {
+			item.container(container).Copy = files
+		}
+	}
+	for container, files := range toDelete {
+		if container == "" {
+			item.Delete = files
+		} else {
+			item.container(container).Delete = files
+		}
+	}
+	return item, nil
+}
+
+func (i *Item) container(name string) *ContainerItem {
+	if i.Containers == nil {
+		i.Containers = map[string]*ContainerItem{}
+	}
+	if _, found := i.Containers[name]; !found {
+		i.Containers[name] = &ContainerItem{Copy: syncMap{}, Delete: syncMap{}}
+	}
+	return i.Containers[name]
 }
 
 func inferredSyncItem(ctx context.Context, a *latest.Artifact, tag string, e filemon.Events, cfg docker.Config) (*Item, error) {
@@ -225,8 +251,10 @@ func latestTag(image string, builds []graph.Artifact) string {
 	return ""
 }
 
-func intersect(ctx context.Context, contextWd, containerWd string, syncRules []*latest.SyncRule, files []string) (syncMap, error) {
-	ret := make(syncMap)
+// intersect maps the files to their destinations, grouped by the container targeted by the matching sync rules.
+// Destinations of rules that don't name a container are keyed by "".
+func intersect(ctx context.Context, contextWd, containerWd string, syncRules []*latest.SyncRule, files []string) (map[string]syncMap, error) {
+	ret := make(map[string]syncMap)
 	for _, f := range files {
 		relPath, err := filepath.Rel(contextWd, f)
 		if err != nil {
@@ -243,13 +271,18 @@ func intersect(ctx context.Context, contextWd, containerWd string, syncRules []*
 			return nil, nil
 		}
 
-		ret[f] = dsts
+		for container, containerDsts := range dsts {
+			if ret[container] == nil {
+				ret[container] = make(syncMap)
+			}
+			ret[container][f] = containerDsts
+		}
 	}
 	return ret, nil
 }
 
-func matchSyncRules(syncRules []*latest.SyncRule, relPath, containerWd string) ([]string, error) {
-	dsts := make([]string, 0, 1)
+func matchSyncRules(syncRules []*latest.SyncRule, relPath, containerWd string) (map[string][]string, error) {
+	dsts := make(map[string][]string)
 	for _, r := range syncRules {
 		matches, err := doublestar.PathMatch(filepath.FromSlash(r.Src), relPath)
 		if err != nil {
@@ -260,19 +293,44 @@ func matchSyncRules(syncRules []*latest.SyncRule, relPath, containerWd string) (
 			continue
 		}
 
-		wd := ""
-		if !path.IsAbs(r.Dest) {
-			// Convert relative destinations to absolute via the working dir in the container.
-			wd = containerWd
+		dest := r.Dest
+		if IsDestTemplate(r.Dest) {
+			// The template gives the destination of the file itself.
+			dest, err = util.ExpandEnvTemplateOrFail(r.Dest, map[string]string{
+				"RelPath": strings.TrimPrefix(filepath.ToSlash(relPath), patternBase(r.Src)),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("evaluating sync destination %q for %q: %w", r.Dest, relPath, err)
+			}
+		} else {
+			// Map the paths as a tree from the prefix.
+			dest = path.Join(dest, strings.TrimPrefix(filepath.ToSlash(relPath), r.Strip))
 		}
 
-		// Map the paths as a tree from the prefix.
-		subPath := strings.TrimPrefix(filepath.ToSlash(relPath), r.Strip)
-		dsts = append(dsts, path.Join(wd, r.Dest, subPath))
+		if !path.IsAbs(dest) {
+			// Convert relative destinations to absolute via the working dir in the container.
+			dest = path.Join(containerWd, dest)
+		}
+		dsts[r.Container] = append(dsts[r.Container], path.Clean(dest))
 	}
 	return dsts, nil
 }
 
+// IsDestTemplate returns true if the destination of a sync rule is a template, like `/app/{{.RelPath}}`.
+func IsDestTemplate(dest string) bool {
+	return strings.Contains(dest, "{{")
+}
+
+// patternBase returns the static part of a glob pattern, up to the last `/` before its first special character.
+// For example, the base of `src/**/*.js` is `src/`.
+func patternBase(pattern string) string {
+	pattern = filepath.ToSlash(pattern)
+	if i := strings.IndexAny(pattern, "*?[{\\"); i != -1 {
+		pattern = pattern[:i]
+	}
+	return pattern[:strings.LastIndex(pattern, "/")+1]
+}
+
 func (s *PodSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
 	if !item.HasChanges() {
 		return nil
@@ -285,6 +343,18 @@ func (s *PodSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
 	for k := range item.Delete {
 		delete = append(delete, k)
 	}
+	for _, c := range item.Containers {
+		for k := range c.Copy {
+			if _, found := item.Copy[k]; !found {
+				copy = append(copy, k)
+			}
+		}
+		for k := range c.Delete {
+			if _, found := item.Delete[k]; !found {
+				delete = append(delete, k)
+			}
+		}
+	}
 
 	opts, err := hooks.NewSyncEnvOpts(item.Artifact, item.Image, copy, delete, *s.namespaces, s.kubectl.KubeContext)
 	if err != nil {
@@ -304,18 +374,38 @@ func (s *PodSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
 }
 
 func (s *PodSyncer) sync(ctx context.Context, item *Item) error {
-	if len(item.Copy) > 0 {
-		log.Entry(ctx).Info("Copying files:", item.Copy, "to", item.Image)
+	if err := s.syncContainer(ctx, item.Image, "", item.Copy, item.Delete); err != nil {
+		return err
+	}
+
+	containers := make([]string, 0, len(item.Containers))
+	for name := range item.Containers {
+		containers = append(containers, name)
+	}
+	sort.Strings(containers)
+	for _, name := range containers {
+		c := item.Containers[name]
+		if err := s.syncContainer(ctx, item.Image, name, c.Copy, c.Delete); err != nil {
+			return fmt.Errorf("container %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *PodSyncer) syncContainer(ctx context.Context, image, container string, toCopy, toDelete syncMap) error {
+	if len(toCopy) > 0 {
+		log.Entry(ctx).Info("Copying files:", toCopy, "to", image, container)
 
-		if err := Perform(ctx, item.Image, item.Copy, s.copyFileFn, *s.namespaces, s.kubectl.KubeContext); err != nil {
+		if err := Perform(ctx, image, container, toCopy, s.copyFileFn, *s.namespaces, s.kubectl.KubeContext); err != nil {
 			return fmt.Errorf("copying files: %w", err)
 		}
 	}
 
-	if len(item.Delete) > 0 {
-		log.Entry(ctx).Info("Deleting files:", item.Delete, "from", item.Image)
+	if len(toDelete) > 0 {
+		log.Entry(ctx).Info("Deleting files:", toDelete, "from", image, container)
 
-		if err := Perform(ctx, item.Image, item.Delete, s.deleteFileFn, *s.namespaces, s.kubectl.KubeContext); err != nil {
+		if err := Perform(ctx, image, container, toDelete, s.deleteFileFn, *s.namespaces, s.kubectl.KubeContext); err != nil {
 			return fmt.Errorf("deleting files: %w", err)
 		}
 	}
@@ -323,7 +413,9 @@ func (s *PodSyncer) sync(ctx context.Context, item *Item) error {
 	return nil
 }
 
-func Perform(ctx context.Context, image string, files syncMap, cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd, namespaces []string, kubeContext string) error {
+// Perform runs the sync command against the containers running the image.
+// If a container name is given, the command runs against that container of the pods running the image instead.
+func Perform(ctx context.Context, image, container string, files syncMap, cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd, namespaces []string, kubeContext string) error {
 	if len(files) == 0 {
 		return nil
 	}
@@ -351,11 +443,7 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 		}
 
 		for _, p := range pods.Items {
-			for _, c := range p.Spec.Containers {
-				if c.Image != image {
-					continue
-				}
-
+			for _, c := range targetContainers(ctx, p, image, container) {
 				cmd := cmdFn(ctx, p, c, files)
 				errs.Go(func() error {
 					_, err := util.RunCmdOut(ctx, cmd)
@@ -377,6 +465,27 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 	return nil
 }
 
+// targetContainers returns the containers of a pod to sync files to.
+func targetContainers(ctx context.Context, pod v1.Pod, image, container string) []v1.Container {
+	var running []v1.Container
+	for _, c := range pod.Spec.Containers {
+		if c.Image == image {
+			running = append(running, c)
+		}
+	}
+	if container == "" || len(running) == 0 {
+		return running
+	}
+
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container {
+			return []v1.Container{c}
+		}
+	}
+	log.Entry(ctx).Warnf("pod %q runs image %q but has no container %q to sync files to", pod.Name, image, container)
+	return nil
+}
+
 func Init(ctx context.Context, artifacts []*latest.Artifact) error {
 	for _, a := range artifacts {
 		if a.Sync == nil {
@@ -101,6 +101,44 @@ func TestNewSyncItem(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{
+			description: "manual: match copy to named container",
+			artifact: &latest.Artifact{
+				ImageName: "test",
+				Sync: &latest.Sync{
+					Manual: []*latest.SyncRule{
+						{Src: "*.html", Dest: "."},
+						{Src: "*.conf", Dest: "/etc/nginx", Container: "proxy"},
+					},
+				},
+				Workspace: ".",
+			},
+			builds: []graph.Artifact{
+				{
+					ImageName: "test",
+					Tag:       "test:123",
+				},
+			},
+			evt: filemon.Events{
+				Added:   []string{"nginx.conf"},
+				Deleted: []string{"index.html"},
+			},
+			expected: &Item{
+				Image: "test:123",
+				Copy:  map[string][]string{},
+				Delete: map[string][]string{
+					"index.html": {"index.html"},
+				},
+				Containers: map[string]*ContainerItem{
+					"proxy": {
+						Copy: map[string][]string{
+							"nginx.conf": {"/etc/nginx/nginx.conf"},
+						},
+						Delete: map[string][]string{},
+					},
+				},
+			},
+		},
 		{
 			description: "manual: multiple sync patterns",
 			artifact: &latest.Artifact{
@@ -812,12 +850,12 @@ func TestIntersect(t *testing.T) {
 		files       []string
 		context     string
 		workingDir  string
-		expected    syncMap
+		expected    map[string]syncMap
 		shouldErr   bool
 	}{
 		{
 			description: "nil sync patterns doesn't sync",
-			expected:    map[string][]string{},
+			expected:    map[string]syncMap{},
 		},
 		{
 			description: "copy nested file to correct destination",
@@ -825,10 +863,10 @@ func TestIntersect(t *testing.T) {
 			syncRules: []*latest.SyncRule{
 				{Src: filepath.Join("static", "*.html"), Dest: "/html", Strip: "static/"},
 			},
-			expected: map[string][]string{
+			expected: map[string]syncMap{"": {
 				filepath.Join("static", "index.html"): {"/html/index.html"},
 				filepath.Join("static", "test.html"):  {"/html/test.html"},
-			},
+			}},
 		},
 		{
 			description: "double-star matches depth zero",
@@ -836,9 +874,9 @@ func TestIntersect(t *testing.T) {
 			syncRules: []*latest.SyncRule{
 				{Src: filepath.Join("**", "*.html"), Dest: "/html"},
 			},
-			expected: map[string][]string{
+			expected: map[string]syncMap{"": {
 				"index.html": {"/html/index.html"},
-			},
+			}},
 		},
 		{
 			description: "file not in . copies to correct destination",
@@ -847,9 +885,57 @@ func TestIntersect(t *testing.T) {
 			syncRules: []*latest.SyncRule{
 				{Src: "*.js", Dest: "/"},
 			},
-			expected: map[string][]string{
+			expected: map[string]syncMap{"": {
 				filepath.Join("node", "server.js"): {"/server.js"},
+			}},
+		},
+		{
+			description: "rules targeting containers",
+			files:       []string{"index.html", "nginx.conf"},
+			syncRules: []*latest.SyncRule{
+				{Src: "*.html", Dest: "/html"},
+				{Src: "*.html", Dest: "/usr/share/nginx/html", Container: "proxy"},
+				{Src: "*.conf", Dest: "/etc/nginx", Container: "proxy"},
 			},
+			expected: map[string]syncMap{
+				"": {
+					"index.html": {"/html/index.html"},
+				},
+				"proxy": {
+					"index.html": {"/usr/share/nginx/html/index.html"},
+					"nginx.conf": {"/etc/nginx/nginx.conf"},
+				},
+			},
+		},
+		{
+			description: "destination template preserves the structure below the pattern base",
+			files:       []string{filepath.Join("src", "index.js"), filepath.Join("src", "lib", "util.js")},
+			syncRules: []*latest.SyncRule{
+				{Src: "src/**/*.js", Dest: "/app/{{.RelPath}}"},
+			},
+			expected: map[string]syncMap{"": {
+				filepath.Join("src", "index.js"):       {"/app/index.js"},
+				filepath.Join("src", "lib", "util.js"): {"/app/lib/util.js"},
+			}},
+		},
+		{
+			description: "relative destination template",
+			files:       []string{filepath.Join("web", "static", "app.css")},
+			workingDir:  "/srv",
+			syncRules: []*latest.SyncRule{
+				{Src: "web/**", Dest: "public/{{.RelPath}}"},
+			},
+			expected: map[string]syncMap{"": {
+				filepath.Join("web", "static", "app.css"): {"/srv/public/static/app.css"},
+			}},
+		},
+		{
+			description: "invalid destination template",
+			files:       []string{"index.js"},
+			syncRules: []*latest.SyncRule{
+				{Src: "*.js", Dest: "/app/{{.Unknown}}"},
+			},
+			shouldErr: true,
 		},
 		{
 			description: "file change not relative to context throws error",
@@ -870,6 +956,25 @@ func TestIntersect(t *testing.T) {
 	}
 }
 
+func TestPatternBase(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{pattern: "src/**/*.js", expected: "src/"},
+		{pattern: "src/lib/*.js", expected: "src/lib/"},
+		{pattern: "src/**", expected: "src/"},
+		{pattern: "*.js", expected: ""},
+		{pattern: "src/index.js", expected: "src/"},
+		{pattern: "src/{a,b}/*.js", expected: "src/"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.pattern, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, patternBase(test.pattern))
+		})
+	}
+}
+
 type TestCmdRecorder struct {
 	cmds []string
 	err  error
@@ -903,6 +1008,33 @@ func fakeCmd(ctx context.Context, _ v1.Pod, _ v1.Container, files syncMap) *exec
 	return exec.CommandContext(ctx, "copy", args...)
 }
 
+func containerCmd(ctx context.Context, p v1.Pod, c v1.Container, files syncMap) *exec.Cmd {
+	cmd := fakeCmd(ctx, p, c, files)
+	cmd.Args = append([]string{cmd.Args[0], c.Name}, cmd.Args[1:]...)
+	return cmd
+}
+
+var runningPodWithSidecar = &v1.Pod{
+	ObjectMeta: metav1.ObjectMeta{
+		Name: "podname",
+	},
+	Status: v1.PodStatus{
+		Phase: v1.PodRunning,
+	},
+	Spec: v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Name:  "container_name",
+				Image: "gcr.io/k8s-skaffold:123",
+			},
+			{
+				Name:  "sidecar",
+				Image: "gcr.io/sidecar:1",
+			},
+		},
+	},
+}
+
 var runningPod = &v1.Pod{
 	ObjectMeta: metav1.ObjectMeta{
 		Name: "podname",
@@ -924,6 +1056,7 @@ func TestPerform(t *testing.T) {
 	tests := []struct {
 		description string
 		image       string
+		container   string
 		files       syncMap
 		pod         *v1.Pod
 		cmdFn       func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd
@@ -974,6 +1107,33 @@ func TestPerform(t *testing.T) {
 			cmdFn:       fakeCmd,
 			shouldErr:   true,
 		},
+		{
+			description: "named container",
+			image:       "gcr.io/k8s-skaffold:123",
+			container:   "sidecar",
+			files:       syncMap{"test.go": {"/test.go"}},
+			pod:         runningPodWithSidecar,
+			cmdFn:       containerCmd,
+			expected:    []string{"copy sidecar test.go /test.go"},
+		},
+		{
+			description: "named container missing",
+			image:       "gcr.io/k8s-skaffold:123",
+			container:   "other",
+			files:       syncMap{"test.go": {"/test.go"}},
+			pod:         runningPodWithSidecar,
+			cmdFn:       containerCmd,
+			shouldErr:   true,
+		},
+		{
+			description: "named container in pod not running the image",
+			image:       "gcr.io/different-pod:123",
+			container:   "sidecar",
+			files:       syncMap{"test.go": {"/test.go"}},
+			pod:         runningPodWithSidecar,
+			cmdFn:       containerCmd,
+			shouldErr:   true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -988,7 +1148,7 @@ func TestPerform(t *testing.T) {
 				return fake.NewSimpleClientset(test.pod), test.clientErr
 			})
 
-			err := Perform(context.Background(), test.image, test.files, test.cmdFn, []string{""}, "")
+			err := Perform(context.Background(), test.image, test.container, test.files, test.cmdFn, []string{""}, "")
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, cmdRecord.cmds)
 		})
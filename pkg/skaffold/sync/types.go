@@ -32,6 +32,14 @@ type Item struct {
 	Artifact *latest.Artifact
 	Copy     map[string][]string
 	Delete   map[string][]string
+	// Containers holds the files synced to named containers of the pods running Image, keyed by container name.
+	Containers map[string]*ContainerItem
+}
+
+// ContainerItem holds the files synced to a named container.
+type ContainerItem struct {
+	Copy   map[string][]string
+	Delete map[string][]string
 }
 
 type Syncer interface {
@@ -59,5 +67,17 @@ func (s *NoopSyncer) Sync(context.Context, io.Writer, *Item) error {
 }
 
 func (i *Item) HasChanges() bool {
-	return i != nil && (len(i.Copy) > 0 || len(i.Delete) > 0)
+	return i.FileCount() > 0
+}
+
+// FileCount returns the number of files copied or deleted, across all containers.
+func (i *Item) FileCount() int {
+	if i == nil {
+		return 0
+	}
+	count := len(i.Copy) + len(i.Delete)
+	for _, c := range i.Containers {
+		count += len(c.Copy) + len(c.Delete)
+	}
+	return count
 }
@@ -21,6 +21,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
 
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/v2/pkg/skaffold/docker"
@@ -37,7 +40,9 @@ type Config interface {
 	docker.Config
 
 	TestCases() []*latest.TestCase
+	Artifacts() []*latest.Artifact
 	Muted() config.Muted
+	TestConcurrency() int
 }
 
 // NewTester parses the provided test cases from the Skaffold config,
@@ -49,12 +54,30 @@ func NewTester(ctx context.Context, cfg Config, imagesAreLocal func(imageName st
 		return nil, err
 	}
 
+	concurrency := cfg.TestConcurrency()
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+
 	return FullTester{
-		Testers: testers,
-		muted:   cfg.Muted(),
+		Testers:      testers,
+		muted:        cfg.Muted(),
+		concurrency:  concurrency,
+		dependencies: imageDependencies(cfg.Artifacts()),
 	}, nil
 }
 
+// imageDependencies maps the name of each image to the names of the images it requires.
+func imageDependencies(artifacts []*latest.Artifact) map[string][]string {
+	deps := make(map[string][]string)
+	for _, a := range artifacts {
+		for _, d := range a.Dependencies {
+			deps[a.ImageName] = append(deps[a.ImageName], d.ImageName)
+		}
+	}
+	return deps
+}
+
 // TestDependencies returns the watch dependencies for the target artifact to the runner.
 func (t FullTester) TestDependencies(ctx context.Context, artifact *latest.Artifact) ([]string, error) {
 	var deps []string
@@ -107,18 +130,99 @@ func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []graph.Artifa
 	return nil
 }
 
+// runTests runs the tests of up to `concurrency` images in parallel. The tests of an image run in order, and only
+// once the tests of the images it requires have passed.
+// When images are tested in parallel, the output of each image is buffered and printed in the order of the images.
 func (t FullTester) runTests(ctx context.Context, out io.Writer, bRes []graph.Artifact) error {
+	testerIDs := make([]int, len(bRes))
 	testerID := 0
-	for _, b := range bRes {
-		for _, tester := range t.Testers[b.ImageName] {
-			eventV2.TesterInProgress(testerID)
-			if err := tester.Test(ctx, out, b.Tag); err != nil {
-				eventV2.TesterFailed(testerID, err)
-				return fmt.Errorf("running tests: %w", err)
+	for i, b := range bRes {
+		testerIDs[i] = testerID
+		testerID += len(t.Testers[b.ImageName])
+	}
+
+	if len(bRes) <= 1 {
+		for i, b := range bRes {
+			if err := t.runImageTests(ctx, out, b, testerIDs[i]); err != nil {
+				return err
 			}
-			eventV2.TesterSucceeded(testerID)
-			testerID++
 		}
+		return nil
+	}
+
+	// passed is closed once all the tests of an image have passed.
+	passed := make(map[string]chan struct{}, len(bRes))
+	for _, b := range bRes {
+		passed[b.ImageName] = make(chan struct{})
+	}
+
+	concurrency := t.concurrency
+	if concurrency <= 0 {
+		concurrency = len(bRes)
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, concurrency)
+	outs := make([]bytes.Buffer, len(bRes))
+	errs := make([]error, len(bRes))
+	done := make([]chan struct{}, len(bRes))
+	for i := range bRes {
+		i := i
+		done[i] = make(chan struct{})
+		g.Go(func() error {
+			defer close(done[i])
+			errs[i] = t.testImage(gCtx, &outs[i], bRes[i], testerIDs[i], passed, sem)
+			return errs[i]
+		})
+	}
+
+	for i := range bRes {
+		<-done[i]
+		outs[i].WriteTo(out)
+		if errs[i] != nil {
+			break
+		}
+	}
+	return g.Wait()
+}
+
+// testImage waits for the images that `b` requires to pass their tests and for a free slot, then runs the tests of `b`.
+func (t FullTester) testImage(ctx context.Context, out io.Writer, b graph.Artifact, testerID int, passed map[string]chan struct{}, sem chan struct{}) error {
+	for _, dep := range t.dependencies[b.ImageName] {
+		ch, found := passed[dep]
+		if !found {
+			continue
+		}
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case sem <- struct{}{}:
+		defer func() { <-sem }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := t.runImageTests(ctx, out, b, testerID); err != nil {
+		return err
+	}
+	close(passed[b.ImageName])
+	return nil
+}
+
+func (t FullTester) runImageTests(ctx context.Context, out io.Writer, b graph.Artifact, testerID int) error {
+	for _, tester := range t.Testers[b.ImageName] {
+		eventV2.TesterInProgress(testerID)
+		if err := tester.Test(ctx, out, b.Tag); err != nil {
+			eventV2.TesterFailed(testerID, err)
+			return fmt.Errorf("running tests: %w", err)
+		}
+		eventV2.TesterSucceeded(testerID)
+		testerID++
 	}
 	return nil
 }
@@ -20,10 +20,14 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/docker/docker/client"
 
@@ -264,6 +268,130 @@ func TestTestMuted(t *testing.T) {
 	})
 }
 
+type fakeImageTester struct {
+	name    string
+	err     error
+	lock    *sync.Mutex
+	running *int
+	max     *int
+	order   *[]string
+}
+
+func (f fakeImageTester) Test(_ context.Context, out io.Writer, tag string) error {
+	f.lock.Lock()
+	*f.running++
+	if *f.running > *f.max {
+		*f.max = *f.running
+	}
+	f.lock.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+	fmt.Fprintf(out, "%s %s\n", f.name, tag)
+
+	f.lock.Lock()
+	*f.running--
+	*f.order = append(*f.order, f.name)
+	f.lock.Unlock()
+	return f.err
+}
+
+func (f fakeImageTester) TestDependencies(context.Context) ([]string, error) { return nil, nil }
+
+func TestTestConcurrency(t *testing.T) {
+	tests := []struct {
+		description     string
+		concurrency     int
+		failing         string
+		dependencies    map[string][]string
+		expectedOutput  string
+		expectedOrder   []string
+		expectedSkipped string
+		expectedMax     int
+		shouldErr       bool
+	}{
+		{
+			description:    "sequential",
+			concurrency:    1,
+			expectedOutput: "a1 a:tag\na2 a:tag\nb1 b:tag\nc1 c:tag\n",
+			expectedMax:    1,
+		},
+		{
+			description:    "two at a time",
+			concurrency:    2,
+			expectedOutput: "a1 a:tag\na2 a:tag\nb1 b:tag\nc1 c:tag\n",
+			expectedMax:    2,
+		},
+		{
+			description:    "failure stops at the failing image",
+			concurrency:    3,
+			failing:        "b1",
+			expectedOutput: "a1 a:tag\na2 a:tag\nb1 b:tag\n",
+			expectedMax:    3,
+			shouldErr:      true,
+		},
+		{
+			description:    "images wait for the images they require",
+			concurrency:    3,
+			dependencies:   map[string][]string{"a": {"b"}, "b": {"c"}},
+			expectedOutput: "a1 a:tag\na2 a:tag\nb1 b:tag\nc1 c:tag\n",
+			expectedOrder:  []string{"c1", "b1", "a1", "a2"},
+			expectedMax:    1,
+		},
+		{
+			description:     "failure of a required image skips the images that require it",
+			concurrency:     3,
+			failing:         "c1",
+			dependencies:    map[string][]string{"a": {"c"}},
+			expectedOutput:  "",
+			expectedSkipped: "a1",
+			expectedMax:     2,
+			shouldErr:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var lock sync.Mutex
+			var running, maxRunning int
+			var order []string
+			tester := func(name string) ImageTester {
+				f := fakeImageTester{name: name, lock: &lock, running: &running, max: &maxRunning, order: &order}
+				if name == test.failing {
+					f.err = errors.New("FAIL")
+				}
+				return f
+			}
+			fullTester := FullTester{
+				Testers: ImageTesters{
+					"a": {tester("a1"), tester("a2")},
+					"b": {tester("b1")},
+					"c": {tester("c1")},
+				},
+				concurrency:  test.concurrency,
+				dependencies: test.dependencies,
+			}
+
+			var out bytes.Buffer
+			err := fullTester.runTests(context.Background(), &out, []graph.Artifact{
+				{ImageName: "a", Tag: "a:tag"},
+				{ImageName: "b", Tag: "b:tag"},
+				{ImageName: "c", Tag: "c:tag"},
+			})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+			lock.Lock()
+			defer lock.Unlock()
+			t.CheckTrue(maxRunning <= test.expectedMax)
+			if test.expectedOrder != nil {
+				t.CheckDeepEqual(test.expectedOrder, order)
+			}
+			if test.expectedSkipped != "" {
+				t.CheckFalse(slices.Contains(order, test.expectedSkipped))
+			}
+		})
+	}
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -47,6 +47,10 @@ type Muted interface {
 type FullTester struct {
 	Testers ImageTesters
 	muted   Muted
+	// concurrency is the maximum number of images tested in parallel.
+	concurrency int
+	// dependencies maps the name of each image to the names of the images it requires.
+	dependencies map[string][]string
 	// imagesAreLocal func(imageName string) (bool, error)
 }
 
@@ -362,3 +362,39 @@ func ParseEnvVariablesFromFile(fp string) (map[string]string, error) {
 	}
 	return envMap, nil
 }
+
+// ParseValuesFile reads a YAML file of values and flattens it into `--set` style keys,
+// like `image.tag` for nested maps and `hosts[0]` for lists.
+func ParseValuesFile(fp string) (map[string]string, error) {
+	b, err := os.ReadFile(fp)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, fmt.Errorf("parsing values file %q: %w", fp, err)
+	}
+	flattened := map[string]string{}
+	flattenValues(flattened, "", values)
+	return flattened, nil
+}
+
+func flattenValues(flattened map[string]string, key string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, nested := range v {
+			if key != "" {
+				k = key + "." + k
+			}
+			flattenValues(flattened, k, nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			flattenValues(flattened, fmt.Sprintf("%s[%d]", key, i), nested)
+		}
+	case nil:
+		flattened[key] = ""
+	default:
+		flattened[key] = fmt.Sprint(v)
+	}
+}
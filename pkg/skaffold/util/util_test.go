@@ -483,3 +483,42 @@ func TestParseEnvVariablesFromFile(t *testing.T) {
 		})
 	}
 }
+
+func TestParseValuesFile(t *testing.T) {
+	tests := []struct {
+		description string
+		text        string
+		expected    map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "nested values are flattened",
+			text:        "replicas: 3\nimage:\n  repository: app\n  tag: v1\nhosts:\n- a.example.com\n- b.example.com\nempty:\n",
+			expected: map[string]string{
+				"replicas":         "3",
+				"image.repository": "app",
+				"image.tag":        "v1",
+				"hosts[0]":         "a.example.com",
+				"hosts[1]":         "b.example.com",
+				"empty":            "",
+			},
+		},
+		{
+			description: "empty file",
+			expected:    map[string]string{},
+		},
+		{
+			description: "not a map of values",
+			text:        "- a\n- b\n",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpfile := t.TempFile("", []byte(test.text))
+			values, err := ParseValuesFile(tmpfile)
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expected, values)
+		})
+	}
+}
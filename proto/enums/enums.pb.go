@@ -40,6 +40,8 @@ const (
 	BuilderType_DOCKER BuilderType = 6
 	// Ko Builder
 	BuilderType_KO BuilderType = 7
+	// apko Builder
+	BuilderType_APKO BuilderType = 8
 )
 
 // Enum value maps for BuilderType.
@@ -53,6 +55,7 @@ var (
 		5: "KANIKO",
 		6: "DOCKER",
 		7: "KO",
+		8: "APKO",
 	}
 	BuilderType_value = map[string]int32{
 		"UNKNOWN_BUILDER_TYPE": 0,
@@ -63,6 +66,7 @@ var (
 		"KANIKO":               5,
 		"DOCKER":               6,
 		"KO":                   7,
+		"APKO":                 8,
 	}
 )
 
@@ -596,6 +600,10 @@ const (
 	StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING StatusCode = 304
 	// Standalone pods pending to stabilize
 	StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING StatusCode = 305
+	// Deployment exceeded its progressDeadlineSeconds
+	StatusCode_STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED StatusCode = 306
+	// Container image has no manifest for the node platform
+	StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH StatusCode = 307
 	// Container restarting error
 	StatusCode_STATUSCHECK_CONTAINER_RESTARTING StatusCode = 356
 	// Readiness probe failed
@@ -629,6 +637,14 @@ const (
 	StatusCode_STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR StatusCode = 414
 	StatusCode_STATUSCHECK_STATEFULSET_FETCH_ERR                StatusCode = 415
 	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR            StatusCode = 416
+	// Service fetch error
+	StatusCode_STATUSCHECK_SERVICE_FETCH_ERR StatusCode = 417
+	// Ingress fetch error
+	StatusCode_STATUSCHECK_INGRESS_FETCH_ERR StatusCode = 418
+	// ReplicaSet fetch error
+	StatusCode_STATUSCHECK_REPLICASET_FETCH_ERR StatusCode = 419
+	// PersistentVolumeClaim fetch error
+	StatusCode_STATUSCHECK_PVC_FETCH_ERR StatusCode = 420
 	// Pod Initializing
 	StatusCode_STATUSCHECK_POD_INITIALIZING StatusCode = 451
 	// The actual state of the resource has not yet reached the desired state
@@ -649,6 +665,42 @@ const (
 	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_TERMINATING StatusCode = 458
 	// The resource does not exist
 	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_NOT_FOUND StatusCode = 459
+	// Service of type LoadBalancer is waiting for its load balancer ingress
+	StatusCode_STATUSCHECK_SERVICE_PENDING_LB StatusCode = 460
+	// Resource status not evaluated during the status check initial delay
+	StatusCode_STATUSCHECK_INITIAL_DELAY StatusCode = 461
+	// Ingress is waiting for its address
+	StatusCode_STATUSCHECK_INGRESS_PENDING StatusCode = 462
+	// Pod was evicted from its node
+	StatusCode_STATUSCHECK_POD_EVICTED StatusCode = 463
+	// Pod was preempted by a higher-priority workload
+	StatusCode_STATUSCHECK_POD_PREEMPTED StatusCode = 464
+	// Resource is healthy and waiting for the stabilization window to elapse
+	StatusCode_STATUSCHECK_STABILIZING StatusCode = 465
+	// Service is waiting for ready endpoints of the deployment pods
+	StatusCode_STATUSCHECK_SERVICE_ENDPOINTS_PENDING StatusCode = 466
+	// ReplicaSet is waiting for its replicas to be ready
+	StatusCode_STATUSCHECK_REPLICASET_PENDING StatusCode = 467
+	// Deployment rollout is paused
+	StatusCode_STATUSCHECK_DEPLOYMENT_PAUSED StatusCode = 468
+	// Resource field path does not have the expected value yet
+	StatusCode_STATUSCHECK_READINESS_PENDING StatusCode = 469
+	// PersistentVolumeClaim is waiting to be bound
+	StatusCode_STATUSCHECK_PVC_PENDING StatusCode = 470
+	// StorageClass of the PersistentVolumeClaim does not exist
+	StatusCode_STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND StatusCode = 471
+	// Resource was deleted during the status check before becoming ready
+	StatusCode_STATUSCHECK_RESOURCE_DELETED StatusCode = 472
+	// Argo Rollout is progressing
+	StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING StatusCode = 473
+	// Argo Rollout is paused
+	StatusCode_STATUSCHECK_ARGO_ROLLOUT_PAUSED StatusCode = 474
+	// Argo Rollout is degraded
+	StatusCode_STATUSCHECK_ARGO_ROLLOUT_DEGRADED StatusCode = 475
+	// Resource is waiting for its dependencies to become ready
+	StatusCode_STATUSCHECK_WAITING_FOR_DEPENDENCIES StatusCode = 476
+	// Kubernetes credentials of kubectl expired
+	StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED StatusCode = 477
 	// Could not determine error and phase
 	StatusCode_UNKNOWN_ERROR StatusCode = 500
 	// Status Check error unknown
@@ -971,6 +1023,8 @@ var (
 		303:  "STATUSCHECK_CONTAINER_TERMINATED",
 		304:  "STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING",
 		305:  "STATUSCHECK_STANDALONE_PODS_PENDING",
+		306:  "STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED",
+		307:  "STATUSCHECK_IMAGE_PLATFORM_MISMATCH",
 		356:  "STATUSCHECK_CONTAINER_RESTARTING",
 		357:  "STATUSCHECK_UNHEALTHY",
 		358:  "STATUSCHECK_CONTAINER_EXEC_ERROR",
@@ -990,6 +1044,10 @@ var (
 		414:  "STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR",
 		415:  "STATUSCHECK_STATEFULSET_FETCH_ERR",
 		416:  "STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR",
+		417:  "STATUSCHECK_SERVICE_FETCH_ERR",
+		418:  "STATUSCHECK_INGRESS_FETCH_ERR",
+		419:  "STATUSCHECK_REPLICASET_FETCH_ERR",
+		420:  "STATUSCHECK_PVC_FETCH_ERR",
 		451:  "STATUSCHECK_POD_INITIALIZING",
 		452:  "STATUSCHECK_CONFIG_CONNECTOR_IN_PROGRESS",
 		453:  "STATUSCHECK_CONFIG_CONNECTOR_FAILED",
@@ -999,6 +1057,24 @@ var (
 		457:  "STATUSCHECK_CUSTOM_RESOURCE_FAILED",
 		458:  "STATUSCHECK_CUSTOM_RESOURCE_TERMINATING",
 		459:  "STATUSCHECK_CUSTOM_RESOURCE_NOT_FOUND",
+		460:  "STATUSCHECK_SERVICE_PENDING_LB",
+		461:  "STATUSCHECK_INITIAL_DELAY",
+		462:  "STATUSCHECK_INGRESS_PENDING",
+		463:  "STATUSCHECK_POD_EVICTED",
+		464:  "STATUSCHECK_POD_PREEMPTED",
+		465:  "STATUSCHECK_STABILIZING",
+		466:  "STATUSCHECK_SERVICE_ENDPOINTS_PENDING",
+		467:  "STATUSCHECK_REPLICASET_PENDING",
+		468:  "STATUSCHECK_DEPLOYMENT_PAUSED",
+		469:  "STATUSCHECK_READINESS_PENDING",
+		470:  "STATUSCHECK_PVC_PENDING",
+		471:  "STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND",
+		472:  "STATUSCHECK_RESOURCE_DELETED",
+		473:  "STATUSCHECK_ARGO_ROLLOUT_PENDING",
+		474:  "STATUSCHECK_ARGO_ROLLOUT_PAUSED",
+		475:  "STATUSCHECK_ARGO_ROLLOUT_DEGRADED",
+		476:  "STATUSCHECK_WAITING_FOR_DEPENDENCIES",
+		477:  "STATUSCHECK_KUBECTL_AUTH_EXPIRED",
 		500:  "UNKNOWN_ERROR",
 		501:  "STATUSCHECK_UNKNOWN",
 		502:  "STATUSCHECK_UNKNOWN_UNSCHEDULABLE",
@@ -1123,216 +1199,240 @@ var (
 		1603: "LOG_STREAM_RUN_GCLOUD_NOT_FOUND",
 	}
 	StatusCode_value = map[string]int32{
-		"OK":                                               0,
-		"STATUSCHECK_SUCCESS":                              200,
-		"BUILD_SUCCESS":                                    201,
-		"RENDER_SUCCESS":                                   204,
-		"DEPLOY_SUCCESS":                                   202,
-		"TEST_SUCCESS":                                     203,
-		"BUILD_PUSH_ACCESS_DENIED":                         101,
-		"BUILD_PROJECT_NOT_FOUND":                          102,
-		"BUILD_DOCKER_DAEMON_NOT_RUNNING":                  103,
-		"BUILD_USER_ERROR":                                 104,
-		"BUILD_DOCKER_UNAVAILABLE":                         105,
-		"BUILD_DOCKER_UNAUTHORIZED":                        106,
-		"BUILD_DOCKER_SYSTEM_ERR":                          107,
-		"BUILD_DOCKER_NOT_MODIFIED_ERR":                    108,
-		"BUILD_DOCKER_NOT_IMPLEMENTED_ERR":                 109,
-		"BUILD_DOCKER_DATA_LOSS_ERR":                       110,
-		"BUILD_DOCKER_FORBIDDEN_ERR":                       111,
-		"BUILD_DOCKER_CONFLICT_ERR":                        112,
-		"BUILD_DOCKER_ERROR_NOT_FOUND":                     113,
-		"BUILD_DOCKER_INVALID_PARAM_ERR":                   114,
-		"BUILD_DOCKERFILE_NOT_FOUND":                       115,
-		"BUILD_DOCKER_CACHE_FROM_PULL_ERR":                 116,
-		"BUILD_DOCKER_GET_DIGEST_ERR":                      117,
-		"BUILD_DOCKER_NO_SPACE_ERR":                        127,
-		"BUILD_REGISTRY_GET_DIGEST_ERR":                    118,
-		"BUILD_UNKNOWN_JIB_PLUGIN_TYPE":                    119,
-		"BUILD_JIB_GRADLE_DEP_ERR":                         120,
-		"BUILD_JIB_MAVEN_DEP_ERR":                          121,
-		"INIT_DOCKER_NETWORK_LISTING_CONTAINERS":           122,
-		"INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME":       123,
-		"INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST":     124,
-		"INIT_DOCKER_NETWORK_INVALID_MODE":                 125,
-		"INIT_DOCKER_NETWORK_PARSE_ERR":                    126,
-		"BUILD_GCB_CREATE_BUILD_ERR":                       128,
-		"BUILD_GCB_GET_BUILD_ID_ERR":                       129,
-		"BUILD_GCB_GET_BUILD_STATUS_ERR":                   130,
-		"BUILD_GCB_GET_BUILD_LOG_ERR":                      131,
-		"BUILD_GCB_COPY_BUILD_LOG_ERR":                     132,
-		"BUILD_GCB_GET_BUILT_IMAGE_ERR":                    133,
-		"BUILD_GCB_BUILD_FAILED":                           134,
-		"BUILD_GCB_BUILD_INTERNAL_ERR":                     135,
-		"BUILD_GCB_BUILD_TIMEOUT":                          136,
-		"BUILD_GCB_GENERATE_BUILD_DESCRIPTOR_ERR":          137,
-		"BUILD_GCB_UPLOAD_TO_GCS_ERR":                      138,
-		"BUILD_GCB_JIB_DEPENDENCY_ERR":                     139,
-		"BUILD_GCB_GET_DEPENDENCY_ERR":                     140,
-		"BUILD_GCB_GET_GCS_BUCKET_ERR":                     141,
-		"BUILD_GCB_CREATE_BUCKET_ERR":                      142,
-		"BUILD_GCB_EXTRACT_PROJECT_ID":                     143,
-		"BUILD_GET_CLOUD_STORAGE_CLIENT_ERR":               144,
-		"BUILD_GET_CLOUD_BUILD_CLIENT_ERR":                 145,
-		"BUILD_UNKNOWN_PLATFORM_FLAG":                      150,
-		"BUILD_CROSS_PLATFORM_ERR":                         151,
-		"BUILD_CROSS_PLATFORM_NO_REGISTRY_ERR":             152,
-		"STATUSCHECK_IMAGE_PULL_ERR":                       300,
-		"STATUSCHECK_CONTAINER_CREATING":                   301,
-		"STATUSCHECK_RUN_CONTAINER_ERR":                    302,
-		"STATUSCHECK_CONTAINER_TERMINATED":                 303,
-		"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":           304,
-		"STATUSCHECK_STANDALONE_PODS_PENDING":              305,
-		"STATUSCHECK_CONTAINER_RESTARTING":                 356,
-		"STATUSCHECK_UNHEALTHY":                            357,
-		"STATUSCHECK_CONTAINER_EXEC_ERROR":                 358,
-		"STATUSCHECK_NODE_MEMORY_PRESSURE":                 400,
-		"STATUSCHECK_NODE_DISK_PRESSURE":                   401,
-		"STATUSCHECK_NODE_NETWORK_UNAVAILABLE":             402,
-		"STATUSCHECK_NODE_PID_PRESSURE":                    403,
-		"STATUSCHECK_NODE_UNSCHEDULABLE":                   404,
-		"STATUSCHECK_NODE_UNREACHABLE":                     405,
-		"STATUSCHECK_NODE_NOT_READY":                       406,
-		"STATUSCHECK_FAILED_SCHEDULING":                    407,
-		"STATUSCHECK_KUBECTL_CONNECTION_ERR":               409,
-		"STATUSCHECK_KUBECTL_PID_KILLED":                   410,
-		"STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR":             411,
-		"STATUSCHECK_DEPLOYMENT_FETCH_ERR":                 412,
-		"STATUSCHECK_STANDALONE_PODS_FETCH_ERR":            413,
-		"STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR": 414,
-		"STATUSCHECK_STATEFULSET_FETCH_ERR":                415,
-		"STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR":            416,
-		"STATUSCHECK_POD_INITIALIZING":                     451,
-		"STATUSCHECK_CONFIG_CONNECTOR_IN_PROGRESS":         452,
-		"STATUSCHECK_CONFIG_CONNECTOR_FAILED":              453,
-		"STATUSCHECK_CONFIG_CONNECTOR_TERMINATING":         454,
-		"STATUSCHECK_CONFIG_CONNECTOR_NOT_FOUND":           455,
-		"STATUSCHECK_CUSTOM_RESOURCE_IN_PROGRESS":          456,
-		"STATUSCHECK_CUSTOM_RESOURCE_FAILED":               457,
-		"STATUSCHECK_CUSTOM_RESOURCE_TERMINATING":          458,
-		"STATUSCHECK_CUSTOM_RESOURCE_NOT_FOUND":            459,
-		"UNKNOWN_ERROR":                                    500,
-		"STATUSCHECK_UNKNOWN":                              501,
-		"STATUSCHECK_UNKNOWN_UNSCHEDULABLE":                502,
-		"STATUSCHECK_CONTAINER_WAITING_UNKNOWN":            503,
-		"STATUSCHECK_UNKNOWN_EVENT":                        509,
-		"STATUSCHECK_INTERNAL_ERROR":                       514,
-		"DEPLOY_UNKNOWN":                                   504,
-		"SYNC_UNKNOWN":                                     505,
-		"BUILD_UNKNOWN":                                    506,
-		"DEVINIT_UNKNOWN":                                  507,
-		"CLEANUP_UNKNOWN":                                  508,
-		"INIT_UNKNOWN":                                     510,
-		"BUILD_DOCKER_UNKNOWN":                             511,
-		"TEST_UNKNOWN":                                     512,
-		"BUILD_GCB_BUILD_UNKNOWN_STATUS":                   513,
-		"SYNC_INIT_ERROR":                                  601,
-		"DEVINIT_REGISTER_BUILD_DEPS":                      701,
-		"DEVINIT_REGISTER_TEST_DEPS":                       702,
-		"DEVINIT_REGISTER_DEPLOY_DEPS":                     703,
-		"DEVINIT_REGISTER_CONFIG_DEP":                      704,
-		"DEVINIT_UNSUPPORTED_V1_MANIFEST":                  705,
-		"DEVINIT_REGISTER_RENDER_DEPS":                     706,
-		"STATUSCHECK_USER_CANCELLED":                       800,
-		"STATUSCHECK_DEADLINE_EXCEEDED":                    801,
-		"BUILD_CANCELLED":                                  802,
-		"DEPLOY_CANCELLED":                                 803,
-		"BUILD_DOCKER_CANCELLED":                           804,
-		"BUILD_DOCKER_DEADLINE":                            805,
-		"BUILD_GCB_BUILD_CANCELLED":                        806,
-		"INIT_CREATE_TAGGER_ERROR":                         901,
-		"INIT_MINIKUBE_PAUSED_ERROR":                       902,
-		"INIT_MINIKUBE_NOT_RUNNING_ERROR":                  903,
-		"INIT_CREATE_BUILDER_ERROR":                        904,
-		"INIT_CREATE_DEPLOYER_ERROR":                       905,
-		"INIT_CREATE_TEST_DEP_ERROR":                       906,
-		"INIT_CACHE_ERROR":                                 907,
-		"INIT_CREATE_WATCH_TRIGGER_ERROR":                  908,
-		"INIT_CREATE_ARTIFACT_DEP_ERROR":                   909,
-		"INIT_CLOUD_RUN_LOCATION_ERROR":                    910,
-		"DEPLOY_CLUSTER_CONNECTION_ERR":                    1001,
-		"DEPLOY_DEBUG_HELPER_RETRIEVE_ERR":                 1002,
-		"DEPLOY_CLEANUP_ERR":                               1003,
-		"DEPLOY_HELM_APPLY_LABELS":                         1004,
-		"DEPLOY_HELM_USER_ERR":                             1005,
-		"DEPLOY_NO_MATCHING_BUILD":                         1006,
-		"DEPLOY_HELM_VERSION_ERR":                          1007,
-		"DEPLOY_HELM_MIN_VERSION_ERR":                      1008,
-		"DEPLOY_KUBECTL_VERSION_ERR":                       1109,
-		"DEPLOY_KUBECTL_OFFLINE_MODE_ERR":                  1010,
-		"DEPLOY_ERR_WAITING_FOR_DELETION":                  1011,
-		"DEPLOY_READ_MANIFEST_ERR":                         1012,
-		"DEPLOY_READ_REMOTE_MANIFEST_ERR":                  1013,
-		"DEPLOY_LIST_MANIFEST_ERR":                         1014,
-		"DEPLOY_KUBECTL_USER_ERR":                          1015,
-		"DEPLOY_KUSTOMIZE_USER_ERR":                        1016,
-		"DEPLOY_REPLACE_IMAGE_ERR":                         1017,
-		"DEPLOY_TRANSFORM_MANIFEST_ERR":                    1018,
-		"DEPLOY_SET_LABEL_ERR":                             1019,
-		"DEPLOY_MANIFEST_WRITE_ERR":                        1020,
-		"DEPLOY_PARSE_MANIFEST_IMAGES_ERR":                 1021,
-		"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":              1022,
-		"DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR":               1023,
-		"DEPLOY_KPTFILE_INIT_ERR":                          1024,
-		"DEPLOY_KPT_SOURCE_ERR":                            1025,
-		"DEPLOY_KPTFILE_INVALID_YAML_ERR":                  1026,
-		"DEPLOY_KPT_APPLY_ERR":                             1027,
-		"DEPLOY_GET_CLOUD_RUN_CLIENT_ERR":                  1028,
-		"DEPLOY_CLOUD_RUN_GET_SERVICE_ERR":                 1029,
-		"DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR":              1030,
-		"DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR":              1031,
-		"DEPLOY_CLOUD_RUN_GET_WORKER_POOL_ERR":             1032,
-		"DEPLOY_CLOUD_RUN_UPDATE_WORKER_POOL_ERR":          1033,
-		"DEPLOY_CLOUD_RUN_DELETE_WORKER_POOL_ERR":          1034,
-		"TEST_USER_CONFIG_ERR":                             1101,
-		"TEST_CST_USER_ERR":                                1102,
-		"TEST_IMG_PULL_ERR":                                1103,
-		"TEST_CUSTOM_CMD_PARSE_ERR":                        1104,
-		"TEST_CUSTOM_CMD_RUN_NON_ZERO_EXIT_ERR":            1105,
-		"TEST_CUSTOM_CMD_RUN_TIMEDOUT_ERR":                 1106,
-		"TEST_CUSTOM_CMD_RUN_CANCELLED_ERR":                1107,
-		"TEST_CUSTOM_CMD_RUN_EXECUTION_ERR":                1108,
-		"TEST_CUSTOM_CMD_RUN_EXITED_ERR":                   1110,
-		"TEST_CUSTOM_CMD_RUN_ERR":                          1111,
-		"TEST_CUSTOM_DEPENDENCIES_CMD_ERR":                 1112,
-		"TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR":          1113,
-		"TEST_CUSTOM_CMD_RETRIEVE_ERR":                     1114,
-		"RENDER_KPTFILE_INIT_ERR":                          1501,
-		"RENDER_KPTFILE_INVALID_YAML_ERR":                  1401,
-		"RENDER_KPTFILE_INVALID_SCHEMA_ERR":                1402,
-		"RENDER_SET_NAMESPACE_ERR":                         1403,
-		"RENDER_NAMESPACE_ALREADY_SET_ERR":                 1404,
-		"RENDER_REPLACE_IMAGE_ERR":                         1405,
-		"RENDER_TRANSFORM_MANIFEST_ERR":                    1406,
-		"RENDER_SET_LABEL_ERR":                             1407,
-		"RENDER_MANIFEST_WRITE_ERR":                        1408,
-		"RENDER_PARSE_MANIFEST_IMAGES_ERR":                 1409,
-		"RENDER_HELM_PLUGIN_ERR":                           1410,
-		"CONFIG_FILE_PARSING_ERR":                          1201,
-		"CONFIG_FILE_NOT_FOUND_ERR":                        1202,
-		"CONFIG_DEPENDENCY_NOT_FOUND_ERR":                  1203,
-		"CONFIG_DUPLICATE_NAMES_SAME_FILE_ERR":             1204,
-		"CONFIG_DUPLICATE_NAMES_ACROSS_FILES_ERR":          1205,
-		"CONFIG_BAD_FILTER_ERR":                            1206,
-		"CONFIG_ZERO_FOUND_ERR":                            1207,
-		"CONFIG_APPLY_PROFILES_ERR":                        1208,
-		"CONFIG_DEFAULT_VALUES_ERR":                        1209,
-		"CONFIG_FILE_PATHS_SUBSTITUTION_ERR":               1210,
-		"CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR":         1211,
-		"CONFIG_PROFILES_NOT_FOUND_ERR":                    1212,
-		"CONFIG_UNKNOWN_API_VERSION_ERR":                   1213,
-		"CONFIG_UNKNOWN_VALIDATOR":                         1214,
-		"CONFIG_UNKNOWN_TRANSFORMER":                       1215,
-		"CONFIG_MISSING_MANIFEST_FILE_ERR":                 1216,
-		"CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR":           1217,
-		"CONFIG_UPGRADE_ERR":                               1218,
-		"INSPECT_UNKNOWN_ERR":                              1301,
-		"INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR":             1302,
-		"INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR":             1303,
-		"INSPECT_PROFILE_NOT_FOUND_ERR":                    1304,
-		"PORT_FORWARD_RUN_GCLOUD_NOT_FOUND":                1601,
-		"PORT_FORWARD_RUN_PROXY_START_ERROR":               1602,
-		"LOG_STREAM_RUN_GCLOUD_NOT_FOUND":                  1603,
+		"OK":                                                0,
+		"STATUSCHECK_SUCCESS":                               200,
+		"BUILD_SUCCESS":                                     201,
+		"RENDER_SUCCESS":                                    204,
+		"DEPLOY_SUCCESS":                                    202,
+		"TEST_SUCCESS":                                      203,
+		"BUILD_PUSH_ACCESS_DENIED":                          101,
+		"BUILD_PROJECT_NOT_FOUND":                           102,
+		"BUILD_DOCKER_DAEMON_NOT_RUNNING":                   103,
+		"BUILD_USER_ERROR":                                  104,
+		"BUILD_DOCKER_UNAVAILABLE":                          105,
+		"BUILD_DOCKER_UNAUTHORIZED":                         106,
+		"BUILD_DOCKER_SYSTEM_ERR":                           107,
+		"BUILD_DOCKER_NOT_MODIFIED_ERR":                     108,
+		"BUILD_DOCKER_NOT_IMPLEMENTED_ERR":                  109,
+		"BUILD_DOCKER_DATA_LOSS_ERR":                        110,
+		"BUILD_DOCKER_FORBIDDEN_ERR":                        111,
+		"BUILD_DOCKER_CONFLICT_ERR":                         112,
+		"BUILD_DOCKER_ERROR_NOT_FOUND":                      113,
+		"BUILD_DOCKER_INVALID_PARAM_ERR":                    114,
+		"BUILD_DOCKERFILE_NOT_FOUND":                        115,
+		"BUILD_DOCKER_CACHE_FROM_PULL_ERR":                  116,
+		"BUILD_DOCKER_GET_DIGEST_ERR":                       117,
+		"BUILD_DOCKER_NO_SPACE_ERR":                         127,
+		"BUILD_REGISTRY_GET_DIGEST_ERR":                     118,
+		"BUILD_UNKNOWN_JIB_PLUGIN_TYPE":                     119,
+		"BUILD_JIB_GRADLE_DEP_ERR":                          120,
+		"BUILD_JIB_MAVEN_DEP_ERR":                           121,
+		"INIT_DOCKER_NETWORK_LISTING_CONTAINERS":            122,
+		"INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME":        123,
+		"INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST":      124,
+		"INIT_DOCKER_NETWORK_INVALID_MODE":                  125,
+		"INIT_DOCKER_NETWORK_PARSE_ERR":                     126,
+		"BUILD_GCB_CREATE_BUILD_ERR":                        128,
+		"BUILD_GCB_GET_BUILD_ID_ERR":                        129,
+		"BUILD_GCB_GET_BUILD_STATUS_ERR":                    130,
+		"BUILD_GCB_GET_BUILD_LOG_ERR":                       131,
+		"BUILD_GCB_COPY_BUILD_LOG_ERR":                      132,
+		"BUILD_GCB_GET_BUILT_IMAGE_ERR":                     133,
+		"BUILD_GCB_BUILD_FAILED":                            134,
+		"BUILD_GCB_BUILD_INTERNAL_ERR":                      135,
+		"BUILD_GCB_BUILD_TIMEOUT":                           136,
+		"BUILD_GCB_GENERATE_BUILD_DESCRIPTOR_ERR":           137,
+		"BUILD_GCB_UPLOAD_TO_GCS_ERR":                       138,
+		"BUILD_GCB_JIB_DEPENDENCY_ERR":                      139,
+		"BUILD_GCB_GET_DEPENDENCY_ERR":                      140,
+		"BUILD_GCB_GET_GCS_BUCKET_ERR":                      141,
+		"BUILD_GCB_CREATE_BUCKET_ERR":                       142,
+		"BUILD_GCB_EXTRACT_PROJECT_ID":                      143,
+		"BUILD_GET_CLOUD_STORAGE_CLIENT_ERR":                144,
+		"BUILD_GET_CLOUD_BUILD_CLIENT_ERR":                  145,
+		"BUILD_UNKNOWN_PLATFORM_FLAG":                       150,
+		"BUILD_CROSS_PLATFORM_ERR":                          151,
+		"BUILD_CROSS_PLATFORM_NO_REGISTRY_ERR":              152,
+		"STATUSCHECK_IMAGE_PULL_ERR":                        300,
+		"STATUSCHECK_CONTAINER_CREATING":                    301,
+		"STATUSCHECK_RUN_CONTAINER_ERR":                     302,
+		"STATUSCHECK_CONTAINER_TERMINATED":                  303,
+		"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":            304,
+		"STATUSCHECK_STANDALONE_PODS_PENDING":               305,
+		"STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED": 306,
+		"STATUSCHECK_IMAGE_PLATFORM_MISMATCH":               307,
+		"STATUSCHECK_CONTAINER_RESTARTING":                  356,
+		"STATUSCHECK_UNHEALTHY":                             357,
+		"STATUSCHECK_CONTAINER_EXEC_ERROR":                  358,
+		"STATUSCHECK_NODE_MEMORY_PRESSURE":                  400,
+		"STATUSCHECK_NODE_DISK_PRESSURE":                    401,
+		"STATUSCHECK_NODE_NETWORK_UNAVAILABLE":              402,
+		"STATUSCHECK_NODE_PID_PRESSURE":                     403,
+		"STATUSCHECK_NODE_UNSCHEDULABLE":                    404,
+		"STATUSCHECK_NODE_UNREACHABLE":                      405,
+		"STATUSCHECK_NODE_NOT_READY":                        406,
+		"STATUSCHECK_FAILED_SCHEDULING":                     407,
+		"STATUSCHECK_KUBECTL_CONNECTION_ERR":                409,
+		"STATUSCHECK_KUBECTL_PID_KILLED":                    410,
+		"STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR":              411,
+		"STATUSCHECK_DEPLOYMENT_FETCH_ERR":                  412,
+		"STATUSCHECK_STANDALONE_PODS_FETCH_ERR":             413,
+		"STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR":  414,
+		"STATUSCHECK_STATEFULSET_FETCH_ERR":                 415,
+		"STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR":             416,
+		"STATUSCHECK_SERVICE_FETCH_ERR":                     417,
+		"STATUSCHECK_INGRESS_FETCH_ERR":                     418,
+		"STATUSCHECK_REPLICASET_FETCH_ERR":                  419,
+		"STATUSCHECK_PVC_FETCH_ERR":                         420,
+		"STATUSCHECK_POD_INITIALIZING":                      451,
+		"STATUSCHECK_CONFIG_CONNECTOR_IN_PROGRESS":          452,
+		"STATUSCHECK_CONFIG_CONNECTOR_FAILED":               453,
+		"STATUSCHECK_CONFIG_CONNECTOR_TERMINATING":          454,
+		"STATUSCHECK_CONFIG_CONNECTOR_NOT_FOUND":            455,
+		"STATUSCHECK_CUSTOM_RESOURCE_IN_PROGRESS":           456,
+		"STATUSCHECK_CUSTOM_RESOURCE_FAILED":                457,
+		"STATUSCHECK_CUSTOM_RESOURCE_TERMINATING":           458,
+		"STATUSCHECK_CUSTOM_RESOURCE_NOT_FOUND":             459,
+		"STATUSCHECK_SERVICE_PENDING_LB":                    460,
+		"STATUSCHECK_INITIAL_DELAY":                         461,
+		"STATUSCHECK_INGRESS_PENDING":                       462,
+		"STATUSCHECK_POD_EVICTED":                           463,
+		"STATUSCHECK_POD_PREEMPTED":                         464,
+		"STATUSCHECK_STABILIZING":                           465,
+		"STATUSCHECK_SERVICE_ENDPOINTS_PENDING":             466,
+		"STATUSCHECK_REPLICASET_PENDING":                    467,
+		"STATUSCHECK_DEPLOYMENT_PAUSED":                     468,
+		"STATUSCHECK_READINESS_PENDING":                     469,
+		"STATUSCHECK_PVC_PENDING":                           470,
+		"STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND":           471,
+		"STATUSCHECK_RESOURCE_DELETED":                      472,
+		"STATUSCHECK_ARGO_ROLLOUT_PENDING":                  473,
+		"STATUSCHECK_ARGO_ROLLOUT_PAUSED":                   474,
+		"STATUSCHECK_ARGO_ROLLOUT_DEGRADED":                 475,
+		"STATUSCHECK_WAITING_FOR_DEPENDENCIES":              476,
+		"STATUSCHECK_KUBECTL_AUTH_EXPIRED":                  477,
+		"UNKNOWN_ERROR":                                     500,
+		"STATUSCHECK_UNKNOWN":                               501,
+		"STATUSCHECK_UNKNOWN_UNSCHEDULABLE":                 502,
+		"STATUSCHECK_CONTAINER_WAITING_UNKNOWN":             503,
+		"STATUSCHECK_UNKNOWN_EVENT":                         509,
+		"STATUSCHECK_INTERNAL_ERROR":                        514,
+		"DEPLOY_UNKNOWN":                                    504,
+		"SYNC_UNKNOWN":                                      505,
+		"BUILD_UNKNOWN":                                     506,
+		"DEVINIT_UNKNOWN":                                   507,
+		"CLEANUP_UNKNOWN":                                   508,
+		"INIT_UNKNOWN":                                      510,
+		"BUILD_DOCKER_UNKNOWN":                              511,
+		"TEST_UNKNOWN":                                      512,
+		"BUILD_GCB_BUILD_UNKNOWN_STATUS":                    513,
+		"SYNC_INIT_ERROR":                                   601,
+		"DEVINIT_REGISTER_BUILD_DEPS":                       701,
+		"DEVINIT_REGISTER_TEST_DEPS":                        702,
+		"DEVINIT_REGISTER_DEPLOY_DEPS":                      703,
+		"DEVINIT_REGISTER_CONFIG_DEP":                       704,
+		"DEVINIT_UNSUPPORTED_V1_MANIFEST":                   705,
+		"DEVINIT_REGISTER_RENDER_DEPS":                      706,
+		"STATUSCHECK_USER_CANCELLED":                        800,
+		"STATUSCHECK_DEADLINE_EXCEEDED":                     801,
+		"BUILD_CANCELLED":                                   802,
+		"DEPLOY_CANCELLED":                                  803,
+		"BUILD_DOCKER_CANCELLED":                            804,
+		"BUILD_DOCKER_DEADLINE":                             805,
+		"BUILD_GCB_BUILD_CANCELLED":                         806,
+		"INIT_CREATE_TAGGER_ERROR":                          901,
+		"INIT_MINIKUBE_PAUSED_ERROR":                        902,
+		"INIT_MINIKUBE_NOT_RUNNING_ERROR":                   903,
+		"INIT_CREATE_BUILDER_ERROR":                         904,
+		"INIT_CREATE_DEPLOYER_ERROR":                        905,
+		"INIT_CREATE_TEST_DEP_ERROR":                        906,
+		"INIT_CACHE_ERROR":                                  907,
+		"INIT_CREATE_WATCH_TRIGGER_ERROR":                   908,
+		"INIT_CREATE_ARTIFACT_DEP_ERROR":                    909,
+		"INIT_CLOUD_RUN_LOCATION_ERROR":                     910,
+		"DEPLOY_CLUSTER_CONNECTION_ERR":                     1001,
+		"DEPLOY_DEBUG_HELPER_RETRIEVE_ERR":                  1002,
+		"DEPLOY_CLEANUP_ERR":                                1003,
+		"DEPLOY_HELM_APPLY_LABELS":                          1004,
+		"DEPLOY_HELM_USER_ERR":                              1005,
+		"DEPLOY_NO_MATCHING_BUILD":                          1006,
+		"DEPLOY_HELM_VERSION_ERR":                           1007,
+		"DEPLOY_HELM_MIN_VERSION_ERR":                       1008,
+		"DEPLOY_KUBECTL_VERSION_ERR":                        1109,
+		"DEPLOY_KUBECTL_OFFLINE_MODE_ERR":                   1010,
+		"DEPLOY_ERR_WAITING_FOR_DELETION":                   1011,
+		"DEPLOY_READ_MANIFEST_ERR":                          1012,
+		"DEPLOY_READ_REMOTE_MANIFEST_ERR":                   1013,
+		"DEPLOY_LIST_MANIFEST_ERR":                          1014,
+		"DEPLOY_KUBECTL_USER_ERR":                           1015,
+		"DEPLOY_KUSTOMIZE_USER_ERR":                         1016,
+		"DEPLOY_REPLACE_IMAGE_ERR":                          1017,
+		"DEPLOY_TRANSFORM_MANIFEST_ERR":                     1018,
+		"DEPLOY_SET_LABEL_ERR":                              1019,
+		"DEPLOY_MANIFEST_WRITE_ERR":                         1020,
+		"DEPLOY_PARSE_MANIFEST_IMAGES_ERR":                  1021,
+		"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":               1022,
+		"DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR":                1023,
+		"DEPLOY_KPTFILE_INIT_ERR":                           1024,
+		"DEPLOY_KPT_SOURCE_ERR":                             1025,
+		"DEPLOY_KPTFILE_INVALID_YAML_ERR":                   1026,
+		"DEPLOY_KPT_APPLY_ERR":                              1027,
+		"DEPLOY_GET_CLOUD_RUN_CLIENT_ERR":                   1028,
+		"DEPLOY_CLOUD_RUN_GET_SERVICE_ERR":                  1029,
+		"DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR":               1030,
+		"DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR":               1031,
+		"DEPLOY_CLOUD_RUN_GET_WORKER_POOL_ERR":              1032,
+		"DEPLOY_CLOUD_RUN_UPDATE_WORKER_POOL_ERR":           1033,
+		"DEPLOY_CLOUD_RUN_DELETE_WORKER_POOL_ERR":           1034,
+		"TEST_USER_CONFIG_ERR":                              1101,
+		"TEST_CST_USER_ERR":                                 1102,
+		"TEST_IMG_PULL_ERR":                                 1103,
+		"TEST_CUSTOM_CMD_PARSE_ERR":                         1104,
+		"TEST_CUSTOM_CMD_RUN_NON_ZERO_EXIT_ERR":             1105,
+		"TEST_CUSTOM_CMD_RUN_TIMEDOUT_ERR":                  1106,
+		"TEST_CUSTOM_CMD_RUN_CANCELLED_ERR":                 1107,
+		"TEST_CUSTOM_CMD_RUN_EXECUTION_ERR":                 1108,
+		"TEST_CUSTOM_CMD_RUN_EXITED_ERR":                    1110,
+		"TEST_CUSTOM_CMD_RUN_ERR":                           1111,
+		"TEST_CUSTOM_DEPENDENCIES_CMD_ERR":                  1112,
+		"TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR":           1113,
+		"TEST_CUSTOM_CMD_RETRIEVE_ERR":                      1114,
+		"RENDER_KPTFILE_INIT_ERR":                           1501,
+		"RENDER_KPTFILE_INVALID_YAML_ERR":                   1401,
+		"RENDER_KPTFILE_INVALID_SCHEMA_ERR":                 1402,
+		"RENDER_SET_NAMESPACE_ERR":                          1403,
+		"RENDER_NAMESPACE_ALREADY_SET_ERR":                  1404,
+		"RENDER_REPLACE_IMAGE_ERR":                          1405,
+		"RENDER_TRANSFORM_MANIFEST_ERR":                     1406,
+		"RENDER_SET_LABEL_ERR":                              1407,
+		"RENDER_MANIFEST_WRITE_ERR":                         1408,
+		"RENDER_PARSE_MANIFEST_IMAGES_ERR":                  1409,
+		"RENDER_HELM_PLUGIN_ERR":                            1410,
+		"CONFIG_FILE_PARSING_ERR":                           1201,
+		"CONFIG_FILE_NOT_FOUND_ERR":                         1202,
+		"CONFIG_DEPENDENCY_NOT_FOUND_ERR":                   1203,
+		"CONFIG_DUPLICATE_NAMES_SAME_FILE_ERR":              1204,
+		"CONFIG_DUPLICATE_NAMES_ACROSS_FILES_ERR":           1205,
+		"CONFIG_BAD_FILTER_ERR":                             1206,
+		"CONFIG_ZERO_FOUND_ERR":                             1207,
+		"CONFIG_APPLY_PROFILES_ERR":                         1208,
+		"CONFIG_DEFAULT_VALUES_ERR":                         1209,
+		"CONFIG_FILE_PATHS_SUBSTITUTION_ERR":                1210,
+		"CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR":          1211,
+		"CONFIG_PROFILES_NOT_FOUND_ERR":                     1212,
+		"CONFIG_UNKNOWN_API_VERSION_ERR":                    1213,
+		"CONFIG_UNKNOWN_VALIDATOR":                          1214,
+		"CONFIG_UNKNOWN_TRANSFORMER":                        1215,
+		"CONFIG_MISSING_MANIFEST_FILE_ERR":                  1216,
+		"CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR":            1217,
+		"CONFIG_UPGRADE_ERR":                                1218,
+		"INSPECT_UNKNOWN_ERR":                               1301,
+		"INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR":              1302,
+		"INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR":              1303,
+		"INSPECT_PROFILE_NOT_FOUND_ERR":                     1304,
+		"PORT_FORWARD_RUN_GCLOUD_NOT_FOUND":                 1601,
+		"PORT_FORWARD_RUN_PROXY_START_ERROR":                1602,
+		"LOG_STREAM_RUN_GCLOUD_NOT_FOUND":                   1603,
 	}
 )
 
@@ -1440,6 +1540,8 @@ const (
 	SuggestionCode_CHECK_READINESS_PROBE SuggestionCode = 302
 	// Check Container image
 	SuggestionCode_CHECK_CONTAINER_IMAGE SuggestionCode = 303
+	// Rebuild the image for the cluster platform
+	SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM SuggestionCode = 304
 	// Node pressure error
 	SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE SuggestionCode = 400
 	// Node disk pressure error
@@ -1507,6 +1609,8 @@ const (
 	SuggestionCode_INSPECT_CHECK_INPUT_PROFILE SuggestionCode = 802
 	// Open an issue so this situation can be diagnosed
 	SuggestionCode_OPEN_ISSUE SuggestionCode = 900
+	// Suggestion configured by the user
+	SuggestionCode_CUSTOM_SUGGESTION SuggestionCode = 901
 	// Test error suggestion codes
 	SuggestionCode_CHECK_CUSTOM_COMMAND                    SuggestionCode = 1000
 	SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT              SuggestionCode = 1001
@@ -1553,6 +1657,7 @@ var (
 		301:  "CHECK_CONTAINER_LOGS",
 		302:  "CHECK_READINESS_PROBE",
 		303:  "CHECK_CONTAINER_IMAGE",
+		304:  "REBUILD_IMAGE_FOR_PLATFORM",
 		400:  "ADDRESS_NODE_MEMORY_PRESSURE",
 		401:  "ADDRESS_NODE_DISK_PRESSURE",
 		402:  "ADDRESS_NODE_NETWORK_UNAVAILABLE",
@@ -1586,6 +1691,7 @@ var (
 		801:  "INSPECT_USE_ADD_BUILD_ENV",
 		802:  "INSPECT_CHECK_INPUT_PROFILE",
 		900:  "OPEN_ISSUE",
+		901:  "CUSTOM_SUGGESTION",
 		1000: "CHECK_CUSTOM_COMMAND",
 		1001: "FIX_CUSTOM_COMMAND_TIMEOUT",
 		1002: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD",
@@ -1628,6 +1734,7 @@ var (
 		"CHECK_CONTAINER_LOGS":                                   301,
 		"CHECK_READINESS_PROBE":                                  302,
 		"CHECK_CONTAINER_IMAGE":                                  303,
+		"REBUILD_IMAGE_FOR_PLATFORM":                             304,
 		"ADDRESS_NODE_MEMORY_PRESSURE":                           400,
 		"ADDRESS_NODE_DISK_PRESSURE":                             401,
 		"ADDRESS_NODE_NETWORK_UNAVAILABLE":                       402,
@@ -1661,6 +1768,7 @@ var (
 		"INSPECT_USE_ADD_BUILD_ENV":                              801,
 		"INSPECT_CHECK_INPUT_PROFILE":                            802,
 		"OPEN_ISSUE":                                             900,
+		"CUSTOM_SUGGESTION":                                      901,
 		"CHECK_CUSTOM_COMMAND":                                   1000,
 		"FIX_CUSTOM_COMMAND_TIMEOUT":                             1001,
 		"CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD":                  1002,
@@ -1700,654 +1808,714 @@ var File_enums_proto protoreflect.FileDescriptor
 
 var file_enums_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2a, 0x77, 0x0a, 0x0b, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4a, 0x49, 0x42, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
-	0x42, 0x41, 0x5a, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x50, 0x41, 0x43, 0x4b, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f,
-	0x4d, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x41, 0x4e, 0x49, 0x4b, 0x4f, 0x10, 0x05, 0x12,
-	0x0a, 0x0a, 0x06, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x06, 0x12, 0x06, 0x0a, 0x02, 0x4b,
-	0x4f, 0x10, 0x07, 0x2a, 0x44, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x42, 0x55, 0x49, 0x4c,
-	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x43, 0x42, 0x10, 0x02, 0x12, 0x09,
-	0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x0a, 0x54, 0x65, 0x73,
-	0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x55, 0x4e, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x54,
-	0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f,
-	0x54, 0x45, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
-	0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x52, 0x41, 0x57, 0x4b, 0x38, 0x53, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x55, 0x53,
-	0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x10,
-	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x54, 0x10,
-	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x50, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53,
-	0x54, 0x10, 0x04, 0x2a, 0x5c, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44,
-	0x45, 0x50, 0x4c, 0x4f, 0x59, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x09, 0x4b, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x10, 0x02, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4b,
-	0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4b, 0x50, 0x54, 0x10,
-	0x04, 0x2a, 0x49, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x49,
-	0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x4b, 0x45, 0x10,
-	0x02, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55,
-	0x47, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x09, 0x0a,
-	0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43,
-	0x45, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10,
-	0x07, 0x2a, 0xf4, 0x38, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
-	0xc8, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x55, 0x43, 0x43,
-	0x45, 0x53, 0x53, 0x10, 0xc9, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52,
-	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xcc, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x44,
-	0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xca, 0x01,
-	0x12, 0x11, 0x0a, 0x0c, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x10, 0xcb, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x50, 0x55, 0x53,
-	0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10,
-	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45,
-	0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x66, 0x12, 0x23,
-	0x0a, 0x1f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x44,
-	0x41, 0x45, 0x4d, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x67, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x68, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x55, 0x49,
-	0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49,
-	0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x69, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52,
-	0x49, 0x5a, 0x45, 0x44, 0x10, 0x6a, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
-	0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0x6b, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43,
-	0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0x6c, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
-	0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45,
-	0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6d, 0x12, 0x1e, 0x0a, 0x1a,
-	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54,
-	0x41, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6e, 0x12, 0x1e, 0x0a, 0x1a,
-	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52,
-	0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6f, 0x12, 0x1d, 0x0a, 0x19,
-	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e,
-	0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x70, 0x12, 0x20, 0x0a, 0x1c, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x71, 0x12, 0x22, 0x0a,
-	0x1e, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45,
-	0x52, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x73, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45,
-	0x52, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x50, 0x55, 0x4c,
-	0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x74, 0x12, 0x1f, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x44, 0x49, 0x47, 0x45,
-	0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x75, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55, 0x49, 0x4c,
-	0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x50, 0x41, 0x43,
-	0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x7f, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x44, 0x49,
-	0x47, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x76, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x55,
-	0x49, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4a, 0x49, 0x42, 0x5f,
-	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x77, 0x12, 0x1c, 0x0a,
-	0x18, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x4c,
-	0x45, 0x5f, 0x44, 0x45, 0x50, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x78, 0x12, 0x1b, 0x0a, 0x17, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x4d, 0x41, 0x56, 0x45, 0x4e, 0x5f, 0x44,
-	0x45, 0x50, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x79, 0x12, 0x2a, 0x0a, 0x26, 0x49, 0x4e, 0x49, 0x54,
-	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f,
-	0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45,
-	0x52, 0x53, 0x10, 0x7a, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x43,
-	0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x49, 0x4e, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x10, 0x7b, 0x12, 0x30, 0x0a, 0x2c, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x43,
-	0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
-	0x58, 0x49, 0x53, 0x54, 0x10, 0x7c, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44,
-	0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x7d, 0x12, 0x21, 0x0a, 0x1d,
-	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57,
-	0x4f, 0x52, 0x4b, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x7e, 0x12,
-	0x1f, 0x0a, 0x1a, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x80, 0x01,
-	0x12, 0x1f, 0x0a, 0x1a, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45,
-	0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x49, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x81,
-	0x01, 0x12, 0x23, 0x0a, 0x1e, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47,
-	0x45, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0x82, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
-	0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4c, 0x4f,
-	0x47, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x83, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c,
-	0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x84, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x42,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2a, 0x81, 0x01, 0x0a, 0x0b, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4a, 0x49, 0x42, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x42, 0x41, 0x5a, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x50, 0x41, 0x43, 0x4b, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54,
+	0x4f, 0x4d, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x41, 0x4e, 0x49, 0x4b, 0x4f, 0x10, 0x05,
+	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x06, 0x12, 0x06, 0x0a, 0x02,
+	0x4b, 0x4f, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x50, 0x4b, 0x4f, 0x10, 0x08, 0x2a, 0x44,
+	0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x47, 0x43, 0x42, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43,
+	0x41, 0x4c, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x45,
+	0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x4e, 0x49,
+	0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10,
+	0x02, 0x2a, 0x6b, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x57, 0x4b,
+	0x38, 0x53, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49, 0x5a,
+	0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a,
+	0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
+	0x4b, 0x50, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x10, 0x04, 0x2a, 0x5c,
+	0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x4c,
+	0x4d, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x09, 0x4b, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45,
+	0x10, 0x02, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54,
+	0x4c, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4b, 0x50, 0x54, 0x10, 0x04, 0x2a, 0x49, 0x0a, 0x0b,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42,
+	0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x4b, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e,
+	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x09, 0x0a,
+	0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x4e, 0x49,
+	0x43, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x06, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x07, 0x2a, 0xf7, 0x3f, 0x0a,
+	0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xc8, 0x01, 0x12, 0x12, 0x0a,
+	0x0d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xc9,
+	0x01, 0x12, 0x13, 0x0a, 0x0e, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0xcc, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xca, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xcb, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x41, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x66, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x45, 0x4d, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x67, 0x12, 0x14,
+	0x0a, 0x10, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x68, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f,
+	0x43, 0x4b, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x69, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x6a, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45,
+	0x52, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6b, 0x12, 0x21,
+	0x0a, 0x1d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0x6c, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45,
+	0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x55, 0x49, 0x4c, 0x44,
+	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4c, 0x4f, 0x53,
+	0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x55, 0x49, 0x4c, 0x44,
+	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6f, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55, 0x49, 0x4c, 0x44,
+	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0x70, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x71, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x72, 0x12, 0x1e, 0x0a, 0x1a,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x73, 0x12, 0x24, 0x0a, 0x20,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x43,
+	0x48, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0x74, 0x12, 0x1f, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0x75, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43,
+	0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0x7f, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x47, 0x49,
+	0x53, 0x54, 0x52, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0x76, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x77, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x50,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0x78, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x4a, 0x49, 0x42, 0x5f, 0x4d, 0x41, 0x56, 0x45, 0x4e, 0x5f, 0x44, 0x45, 0x50, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0x79, 0x12, 0x2a, 0x0a, 0x26, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x53, 0x10, 0x7a, 0x12,
+	0x2e, 0x0a, 0x2a, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e,
+	0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x7b, 0x12,
+	0x30, 0x0a, 0x2c, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e,
+	0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
+	0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10,
+	0x7c, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52,
+	0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x7d, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x49, 0x54, 0x5f,
+	0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x50,
+	0x41, 0x52, 0x53, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x7e, 0x12, 0x1f, 0x0a, 0x1a, 0x42, 0x55,
+	0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42,
+	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x80, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x42,
 	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x42, 0x55, 0x49,
-	0x4c, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x85, 0x01, 0x12,
-	0x1b, 0x0a, 0x16, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49,
-	0x4c, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x86, 0x01, 0x12, 0x21, 0x0a, 0x1c,
-	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x87, 0x01, 0x12,
-	0x1c, 0x0a, 0x17, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49,
-	0x4c, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x88, 0x01, 0x12, 0x2c, 0x0a,
-	0x27, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52,
-	0x41, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49,
-	0x50, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x89, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
-	0x54, 0x4f, 0x5f, 0x47, 0x43, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8a, 0x01, 0x12, 0x21, 0x0a,
-	0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x44,
-	0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8b, 0x01,
-	0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45,
-	0x54, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0x8c, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42,
-	0x5f, 0x47, 0x45, 0x54, 0x5f, 0x47, 0x43, 0x53, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0x8d, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
-	0x47, 0x43, 0x42, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45,
-	0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8e, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c,
-	0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x50, 0x52,
-	0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x8f, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x53,
-	0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0x90, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x45,
-	0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x4c,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x91, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x4c, 0x41,
-	0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x96, 0x01, 0x12, 0x1d, 0x0a,
-	0x18, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x50, 0x4c, 0x41,
-	0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x97, 0x01, 0x12, 0x29, 0x0a, 0x24,
-	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x54,
-	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0x98, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c,
-	0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xac, 0x02, 0x12, 0x23, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45,
-	0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xad, 0x02, 0x12, 0x22, 0x0a,
-	0x1d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x55, 0x4e,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xae,
-	0x02, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49,
-	0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0xaf, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0xb0, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
-	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x5f,
-	0x50, 0x4f, 0x44, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xb1, 0x02, 0x12,
-	0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0xe4, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10,
-	0xe5, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
-	0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x45, 0x43,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xe6, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45,
-	0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x90, 0x03,
-	0x12, 0x23, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55,
-	0x52, 0x45, 0x10, 0x91, 0x03, 0x12, 0x29, 0x0a, 0x24, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
-	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
-	0x4b, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x92, 0x03,
-	0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52,
-	0x45, 0x10, 0x93, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44,
-	0x55, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x94, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
-	0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x95, 0x03, 0x12, 0x1f, 0x0a, 0x1a,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x96, 0x03, 0x12, 0x22, 0x0a,
-	0x1d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x97,
-	0x03, 0x12, 0x27, 0x0a, 0x22, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
-	0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x99, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54,
-	0x4c, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x9a, 0x03, 0x12,
-	0x29, 0x0a, 0x24, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4b,
-	0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x45,
-	0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9b, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54,
+	0x4c, 0x44, 0x5f, 0x49, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x81, 0x01, 0x12, 0x23, 0x0a, 0x1e,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x42, 0x55,
+	0x49, 0x4c, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x82,
+	0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47,
+	0x45, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0x83, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42,
+	0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0x84, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54, 0x5f, 0x49, 0x4d,
+	0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x85, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x42, 0x55,
+	0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x86, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44,
+	0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x87, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x42, 0x55,
+	0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x88, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x42,
+	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x4f, 0x52, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0x89, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x47, 0x43, 0x42, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x47, 0x43,
+	0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8a, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8b, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42,
+	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8c, 0x01, 0x12, 0x21,
+	0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x47, 0x45, 0x54, 0x5f,
+	0x47, 0x43, 0x53, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8d,
+	0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0x8e, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42,
+	0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x49, 0x44, 0x10, 0x8f, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x47, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47,
+	0x45, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x90, 0x01, 0x12,
+	0x25, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x4f,
+	0x55, 0x44, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0x91, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d,
+	0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x96, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0x97, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x42, 0x55, 0x49, 0x4c, 0x44,
+	0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f,
+	0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0x98, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0xac, 0x02, 0x12, 0x23, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xad, 0x02, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xae, 0x02, 0x12, 0x25, 0x0a, 0x20,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0xaf, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x4f,
+	0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xb0, 0x02,
+	0x12, 0x28, 0x0a, 0x23, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x44, 0x53, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xb1, 0x02, 0x12, 0x36, 0x0a, 0x31, 0x53, 0x54,
 	0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9c,
-	0x03, 0x12, 0x2a, 0x0a, 0x25, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
-	0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x44, 0x53,
-	0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9d, 0x03, 0x12, 0x35, 0x0a,
-	0x30, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0x9e, 0x03, 0x12, 0x26, 0x0a, 0x21, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x46, 0x55, 0x4c, 0x53, 0x45, 0x54, 0x5f,
-	0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9f, 0x03, 0x12, 0x2a, 0x0a, 0x25,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x45, 0x54, 0x43,
-	0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xa0, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x49, 0x54,
-	0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0xc3, 0x03, 0x12, 0x2d, 0x0a, 0x28, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x50,
-	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0xc4, 0x03, 0x12, 0x28, 0x0a, 0x23, 0x53, 0x54,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x45,
+	0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
+	0xb2, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d,
+	0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0xb3, 0x02, 0x12, 0x25, 0x0a, 0x20,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0xe4, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0xe5, 0x02, 0x12,
+	0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0xe6, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52,
+	0x59, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x90, 0x03, 0x12, 0x23, 0x0a,
+	0x1e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10,
+	0x91, 0x03, 0x12, 0x29, 0x0a, 0x24, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x55,
+	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x92, 0x03, 0x12, 0x22, 0x0a,
+	0x1d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x93,
+	0x03, 0x12, 0x23, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x94, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41,
+	0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x95, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x96, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x97, 0x03, 0x12, 0x27,
+	0x0a, 0x22, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4b, 0x55,
+	0x42, 0x45, 0x43, 0x54, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0x99, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x5f, 0x50,
+	0x49, 0x44, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x9a, 0x03, 0x12, 0x29, 0x0a, 0x24,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4b, 0x55, 0x42, 0x45,
+	0x43, 0x54, 0x4c, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0x9b, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9c, 0x03, 0x12, 0x2a,
+	0x0a, 0x25, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x44, 0x53, 0x5f, 0x46, 0x45,
+	0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9d, 0x03, 0x12, 0x35, 0x0a, 0x30, 0x53, 0x54,
 	0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0xc5, 0x03, 0x12, 0x2d, 0x0a, 0x28, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
-	0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4e, 0x47,
-	0x10, 0xc6, 0x03, 0x12, 0x2b, 0x0a, 0x26, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc7, 0x03,
-	0x12, 0x2c, 0x0a, 0x27, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0xc8, 0x03, 0x12, 0x27,
-	0x0a, 0x22, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55,
-	0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x45, 0x44, 0x10, 0xc9, 0x03, 0x12, 0x2c, 0x0a, 0x27, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x45,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0xca, 0x03, 0x12, 0x2a, 0x0a, 0x25, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
-	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xcb,
-	0x03, 0x12, 0x12, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0xf4, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
-	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xf5, 0x03, 0x12,
-	0x26, 0x0a, 0x21, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
-	0x41, 0x42, 0x4c, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x2a, 0x0a, 0x25, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
-	0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0xf7, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x10, 0xfd, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x82, 0x04, 0x12, 0x13, 0x0a, 0x0e, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xf8, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x53, 0x59, 0x4e,
-	0x43, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xf9, 0x03, 0x12, 0x12, 0x0a, 0x0d,
-	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfa, 0x03,
-	0x12, 0x14, 0x0a, 0x0f, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0xfb, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55,
-	0x50, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfc, 0x03, 0x12, 0x11, 0x0a, 0x0c,
-	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfe, 0x03, 0x12,
-	0x19, 0x0a, 0x14, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x54, 0x45,
-	0x53, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x80, 0x04, 0x12, 0x23, 0x0a,
-	0x1e, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10,
-	0x81, 0x04, 0x12, 0x14, 0x0a, 0x0f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xd9, 0x04, 0x12, 0x20, 0x0a, 0x1b, 0x44, 0x45, 0x56, 0x49,
-	0x4e, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49,
-	0x4c, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x10, 0xbd, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x44, 0x45,
-	0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54,
-	0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x10, 0xbe, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x44,
-	0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f,
-	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x10, 0xbf, 0x05, 0x12, 0x20,
-	0x0a, 0x1b, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x50, 0x10, 0xc0, 0x05,
-	0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x55,
-	0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x56, 0x31, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46,
-	0x45, 0x53, 0x54, 0x10, 0xc1, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49,
-	0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45,
-	0x52, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x10, 0xc2, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x41,
-	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0xa0, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49,
-	0x4e, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0xa1, 0x06, 0x12, 0x14,
-	0x0a, 0x0f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
-	0x44, 0x10, 0xa2, 0x06, 0x12, 0x15, 0x0a, 0x10, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43,
-	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0xa3, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0xa4, 0x06, 0x12, 0x1a, 0x0a, 0x15, 0x42, 0x55, 0x49, 0x4c,
-	0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e,
-	0x45, 0x10, 0xa5, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43,
-	0x42, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
-	0x44, 0x10, 0xa6, 0x06, 0x12, 0x1d, 0x0a, 0x18, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0x85, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x49,
-	0x4b, 0x55, 0x42, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x86, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x4e,
-	0x49, 0x4b, 0x55, 0x42, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
-	0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x87, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x49, 0x4e,
-	0x49, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45,
-	0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x88, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x49, 0x4e,
-	0x49, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x89, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x49,
-	0x4e, 0x49, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f,
-	0x44, 0x45, 0x50, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x8a, 0x07, 0x12, 0x15, 0x0a, 0x10,
-	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0x8b, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x8c, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x49, 0x4e, 0x49,
-	0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43,
-	0x54, 0x5f, 0x44, 0x45, 0x50, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x8d, 0x07, 0x12, 0x22,
-	0x0a, 0x1d, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e,
-	0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x8e, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x55,
-	0x53, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0xe9, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x5f, 0x52, 0x45,
-	0x54, 0x52, 0x49, 0x45, 0x56, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xea, 0x07, 0x12, 0x17, 0x0a,
-	0x12, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0xeb, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4c, 0x41, 0x42, 0x45,
-	0x4c, 0x53, 0x10, 0xec, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f,
-	0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xed, 0x07,
-	0x12, 0x1d, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4e, 0x4f, 0x5f, 0x4d, 0x41,
-	0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0xee, 0x07, 0x12,
-	0x1c, 0x0a, 0x17, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x56,
-	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xef, 0x07, 0x12, 0x20, 0x0a,
-	0x1b, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x4d, 0x49, 0x4e,
-	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf0, 0x07, 0x12,
-	0x1f, 0x0a, 0x1a, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54,
-	0x4c, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd5, 0x08,
-	0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43,
-	0x54, 0x4c, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0xf2, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x5f, 0x45, 0x52, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52,
-	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xf3, 0x07, 0x12, 0x1d, 0x0a, 0x18,
-	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4d, 0x41, 0x4e, 0x49,
-	0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf4, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x44,
-	0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54,
-	0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf5,
-	0x07, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54,
-	0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf6, 0x07,
-	0x12, 0x1c, 0x0a, 0x17, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43,
-	0x54, 0x4c, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf7, 0x07, 0x12, 0x1e,
-	0x0a, 0x19, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49,
-	0x5a, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf8, 0x07, 0x12, 0x21,
-	0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45,
-	0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf9, 0x07, 0x1a, 0x02, 0x08,
-	0x01, 0x12, 0x26, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45,
-	0x52, 0x52, 0x10, 0xfa, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1d, 0x0a, 0x14, 0x44, 0x45, 0x50,
-	0x4c, 0x4f, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xfb, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x22, 0x0a, 0x19, 0x44, 0x45, 0x50, 0x4c,
-	0x4f, 0x59, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54,
-	0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfc, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x29, 0x0a, 0x20,
-	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x4e,
-	0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xfd, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x44, 0x45, 0x50, 0x4c, 0x4f,
-	0x59, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x53,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xfe,
-	0x07, 0x12, 0x27, 0x0a, 0x22, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x53,
-	0x54, 0x45, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xff, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x44, 0x45,
-	0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x49,
-	0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x80, 0x08, 0x12, 0x1a, 0x0a, 0x15, 0x44, 0x45, 0x50, 0x4c,
-	0x4f, 0x59, 0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0x81, 0x08, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b,
-	0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x59,
-	0x41, 0x4d, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x82, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x44, 0x45,
-	0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x45,
-	0x52, 0x52, 0x10, 0x83, 0x08, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f,
-	0x47, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x43, 0x4c,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x84, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x44,
-	0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f,
-	0x47, 0x45, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0x85, 0x08, 0x12, 0x28, 0x0a, 0x23, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f,
-	0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45,
-	0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x86, 0x08, 0x12, 0x28, 0x0a, 0x23,
-	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e,
-	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0x87, 0x08, 0x12, 0x29, 0x0a, 0x24, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x57,
-	0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x88,
-	0x08, 0x12, 0x2c, 0x0a, 0x27, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55,
-	0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x4f, 0x52,
-	0x4b, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x89, 0x08, 0x12,
-	0x2c, 0x0a, 0x27, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f,
-	0x52, 0x55, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45,
-	0x52, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8a, 0x08, 0x12, 0x19, 0x0a,
-	0x14, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcd, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x54, 0x45, 0x53, 0x54,
-	0x5f, 0x43, 0x53, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xce, 0x08,
-	0x12, 0x16, 0x0a, 0x11, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d, 0x47, 0x5f, 0x50, 0x55, 0x4c,
-	0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcf, 0x08, 0x12, 0x1e, 0x0a, 0x19, 0x54, 0x45, 0x53, 0x54,
-	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x53,
-	0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd0, 0x08, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x45, 0x53, 0x54,
-	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f,
-	0x4e, 0x4f, 0x4e, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xd1, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53,
-	0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45,
-	0x44, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd2, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x54,
-	0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52,
-	0x55, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xd3, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd4, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54,
-	0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52,
-	0x55, 0x4e, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd6, 0x08,
-	0x12, 0x1c, 0x0a, 0x17, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f,
-	0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd7, 0x08, 0x12, 0x25,
-	0x0a, 0x20, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x44, 0x45,
-	0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x45,
-	0x52, 0x52, 0x10, 0xd8, 0x08, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55,
-	0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45,
-	0x53, 0x5f, 0x55, 0x4e, 0x4d, 0x41, 0x52, 0x53, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xd9, 0x08, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0xda, 0x08, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52,
-	0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xdd, 0x0b, 0x12, 0x24, 0x0a, 0x1f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b,
-	0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x59,
-	0x41, 0x4d, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf9, 0x0a, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45,
-	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0xfa, 0x0a, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54,
-	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfb,
-	0x0a, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
-	0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45,
-	0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfc, 0x0a, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x4e, 0x44,
-	0x45, 0x52, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0xfd, 0x0a, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x4e, 0x44, 0x45,
-	0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49,
-	0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfe, 0x0a, 0x12, 0x19, 0x0a, 0x14, 0x52,
-	0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0xff, 0x0a, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x53, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9e,
+	0x03, 0x12, 0x26, 0x0a, 0x21, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x46, 0x55, 0x4c, 0x53, 0x45, 0x54, 0x5f, 0x46, 0x45, 0x54,
+	0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x9f, 0x03, 0x12, 0x2a, 0x0a, 0x25, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xa0, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x46, 0x45, 0x54,
+	0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xa1, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x53, 0x53,
+	0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xa2, 0x03, 0x12, 0x25, 0x0a,
+	0x20, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50,
+	0x4c, 0x49, 0x43, 0x41, 0x53, 0x45, 0x54, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xa3, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x5f, 0x50, 0x56, 0x43, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xa4, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x5f, 0x50, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49,
+	0x5a, 0x49, 0x4e, 0x47, 0x10, 0xc3, 0x03, 0x12, 0x2d, 0x0a, 0x28, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52,
+	0x45, 0x53, 0x53, 0x10, 0xc4, 0x03, 0x12, 0x28, 0x0a, 0x23, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0xc5, 0x03,
+	0x12, 0x2d, 0x0a, 0x28, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52,
+	0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc6, 0x03, 0x12,
+	0x2b, 0x0a, 0x26, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc7, 0x03, 0x12, 0x2c, 0x0a, 0x27,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54,
+	0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0xc8, 0x03, 0x12, 0x27, 0x0a, 0x22, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0xc9, 0x03, 0x12, 0x2c, 0x0a, 0x27, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xca,
+	0x03, 0x12, 0x2a, 0x0a, 0x25, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xcb, 0x03, 0x12, 0x23, 0x0a,
+	0x1e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x49, 0x43, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x42, 0x10,
+	0xcc, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10,
+	0xcd, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0xce, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x5f, 0x50, 0x4f, 0x44, 0x5f, 0x45, 0x56, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0xcf, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x50, 0x4f, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x45, 0x4d, 0x50, 0x54, 0x45, 0x44, 0x10,
+	0xd0, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0xd1, 0x03,
+	0x12, 0x2a, 0x0a, 0x25, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xd2, 0x03, 0x12, 0x23, 0x0a, 0x1e,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x53, 0x45, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xd3,
+	0x03, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0xd4, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xd5, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x56, 0x43, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0xd6, 0x03, 0x12, 0x2c, 0x0a, 0x27, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x56, 0x43, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41,
+	0x47, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0xd7, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0xd8, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x41, 0x52, 0x47, 0x4f, 0x5f, 0x52, 0x4f, 0x4c,
+	0x4c, 0x4f, 0x55, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xd9, 0x03, 0x12,
+	0x24, 0x0a, 0x1f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x41,
+	0x52, 0x47, 0x4f, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x5f, 0x50, 0x41, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0xda, 0x03, 0x12, 0x26, 0x0a, 0x21, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x41, 0x52, 0x47, 0x4f, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x55,
+	0x54, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0xdb, 0x03, 0x12, 0x29, 0x0a,
+	0x24, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x57, 0x41, 0x49,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45,
+	0x4e, 0x43, 0x49, 0x45, 0x53, 0x10, 0xdc, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xdd, 0x03, 0x12,
+	0x12, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0xf4, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xf5, 0x03, 0x12, 0x26, 0x0a,
+	0x21, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x2a, 0x0a, 0x25, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x57,
+	0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xf7,
+	0x03, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0xfd,
+	0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x82, 0x04, 0x12, 0x13, 0x0a, 0x0e, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xf8, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xf9, 0x03, 0x12, 0x12, 0x0a, 0x0d, 0x42, 0x55,
+	0x49, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfa, 0x03, 0x12, 0x14,
+	0x0a, 0x0f, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0xfb, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfc, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x49, 0x4e,
+	0x49, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfe, 0x03, 0x12, 0x19, 0x0a,
+	0x14, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x80, 0x04, 0x12, 0x23, 0x0a, 0x1e, 0x42,
+	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x81, 0x04,
+	0x12, 0x14, 0x0a, 0x0f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0xd9, 0x04, 0x12, 0x20, 0x0a, 0x1b, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49,
+	0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44,
+	0x5f, 0x44, 0x45, 0x50, 0x53, 0x10, 0xbd, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x44, 0x45, 0x56, 0x49,
+	0x4e, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x45, 0x53,
+	0x54, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x10, 0xbe, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x44, 0x45, 0x56,
+	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x45,
+	0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x10, 0xbf, 0x05, 0x12, 0x20, 0x0a, 0x1b,
+	0x44, 0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x50, 0x10, 0xc0, 0x05, 0x12, 0x24,
+	0x0a, 0x1f, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50,
+	0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x56, 0x31, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53,
+	0x54, 0x10, 0xc1, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x44, 0x45, 0x56, 0x49, 0x4e, 0x49, 0x54, 0x5f,
+	0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f,
+	0x44, 0x45, 0x50, 0x53, 0x10, 0xc2, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0xa0, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45,
+	0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0xa1, 0x06, 0x12, 0x14, 0x0a, 0x0f,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0xa2, 0x06, 0x12, 0x15, 0x0a, 0x10, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0xa3, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0xa4, 0x06, 0x12, 0x1a, 0x0a, 0x15, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x10,
+	0xa5, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x43, 0x42, 0x5f,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0xa6, 0x06, 0x12, 0x1d, 0x0a, 0x18, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x5f, 0x54, 0x41, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x85,
+	0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55,
+	0x42, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x86, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b,
+	0x55, 0x42, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x87, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x49, 0x4e, 0x49, 0x54,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x88, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x49, 0x4e, 0x49, 0x54,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x45, 0x52,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x89, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x49, 0x4e, 0x49,
+	0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45,
+	0x50, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x8a, 0x07, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e,
+	0x49, 0x54, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x8b,
+	0x07, 0x12, 0x24, 0x0a, 0x1f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0x8c, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x49, 0x4e, 0x49, 0x54, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f,
+	0x44, 0x45, 0x50, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x8d, 0x07, 0x12, 0x22, 0x0a, 0x1d,
+	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4c,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x8e, 0x07,
+	0x12, 0x22, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xe9, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x44,
+	0x45, 0x42, 0x55, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52,
+	0x49, 0x45, 0x56, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xea, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x44,
+	0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xeb, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x48,
+	0x45, 0x4c, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x53,
+	0x10, 0xec, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x48, 0x45,
+	0x4c, 0x4d, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xed, 0x07, 0x12, 0x1d,
+	0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4e, 0x4f, 0x5f, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0xee, 0x07, 0x12, 0x1c, 0x0a,
+	0x17, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xef, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x44,
+	0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf0, 0x07, 0x12, 0x1f, 0x0a,
+	0x1a, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd5, 0x08, 0x12, 0x24,
+	0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c,
+	0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xf2, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x45,
+	0x52, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xf3, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x45,
+	0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45,
+	0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf4, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50,
+	0x4c, 0x4f, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f,
+	0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf5, 0x07, 0x12,
+	0x1d, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4d,
+	0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf6, 0x07, 0x12, 0x1c,
+	0x0a, 0x17, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf7, 0x07, 0x12, 0x1e, 0x0a, 0x19,
+	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf8, 0x07, 0x12, 0x21, 0x0a, 0x18,
+	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x49,
+	0x4d, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf9, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12,
+	0x26, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0xfa, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1d, 0x0a, 0x14, 0x44, 0x45, 0x50, 0x4c, 0x4f,
+	0x59, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xfb, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x22, 0x0a, 0x19, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
 	0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0x80, 0x0b, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52,
-	0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f,
-	0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x81, 0x0b, 0x12, 0x1b, 0x0a,
-	0x16, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x50, 0x4c, 0x55,
-	0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x82, 0x0b, 0x12, 0x1c, 0x0a, 0x17, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49, 0x4e,
-	0x47, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb1, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb2, 0x09, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb3, 0x09, 0x12, 0x29,
-	0x0a, 0x24, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41,
-	0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb4, 0x09, 0x12, 0x2c, 0x0a, 0x27, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x41, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0xb5, 0x09, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xb6, 0x09, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x5a, 0x45,
-	0x52, 0x4f, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb7, 0x09, 0x12,
-	0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb8, 0x09, 0x12,
-	0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
-	0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb9, 0x09, 0x12,
-	0x27, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50,
-	0x41, 0x54, 0x48, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x54, 0x49, 0x54, 0x55, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xba, 0x09, 0x12, 0x2d, 0x0a, 0x28, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0xbb, 0x09, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
-	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xbc, 0x09, 0x12, 0x23, 0x0a, 0x1e, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x50,
-	0x49, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xbd, 0x09,
-	0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x10, 0xbe, 0x09, 0x12,
-	0x1f, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x10, 0xbf, 0x09,
-	0x12, 0x25, 0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
-	0x4e, 0x47, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0xc0, 0x09, 0x12, 0x2b, 0x0a, 0x26, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x43, 0x41,
-	0x43, 0x48, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xc1, 0x09, 0x12, 0x17, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55,
-	0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc2, 0x09, 0x12, 0x18, 0x0a,
-	0x13, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0x95, 0x0a, 0x12, 0x29, 0x0a, 0x24, 0x49, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x5f, 0x41, 0x4c, 0x52,
-	0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0x96, 0x0a, 0x12, 0x29, 0x0a, 0x24, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x55,
-	0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x97, 0x0a, 0x12, 0x22, 0x0a,
-	0x1d, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x98,
-	0x0a, 0x12, 0x26, 0x0a, 0x21, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52,
-	0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc1, 0x0c, 0x12, 0x27, 0x0a, 0x22, 0x50, 0x4f, 0x52,
-	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x50, 0x52,
-	0x4f, 0x58, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0xc2, 0x0c, 0x12, 0x24, 0x0a, 0x1f, 0x4c, 0x4f, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
-	0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc3, 0x0c, 0x2a, 0xa5, 0x12, 0x0a, 0x0e, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e,
-	0x49, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41,
-	0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f,
-	0x10, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x46, 0x41,
-	0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x5f,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x43, 0x4c, 0x4f,
-	0x55, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x10, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x4f,
-	0x43, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x55, 0x52, 0x45, 0x10, 0x68, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x47,
-	0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x69, 0x12,
-	0x18, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f,
-	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x6a, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x49, 0x58,
-	0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0x6b, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c,
-	0x44, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x6c, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x49, 0x58,
-	0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x41, 0x52, 0x54, 0x49,
-	0x46, 0x41, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x6d, 0x12, 0x22, 0x0a,
-	0x1e, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x46, 0x49, 0x4c, 0x45, 0x10,
-	0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x49, 0x58, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x50, 0x4c, 0x55,
-	0x47, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x6f, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x49, 0x58, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45,
-	0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49,
-	0x4e, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x70, 0x12, 0x2a, 0x0a, 0x26, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f,
-	0x52, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x55, 0x4e,
-	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x71, 0x12, 0x3a, 0x0a, 0x36, 0x46, 0x49, 0x58, 0x5f, 0x44, 0x4f,
-	0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4e,
-	0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
-	0x10, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52,
-	0x5f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x10, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x54, 0x5f,
-	0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x74, 0x12, 0x23,
-	0x0a, 0x1f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x4c, 0x41,
-	0x47, 0x10, 0x75, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x53,
-	0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x4d,
-	0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x76, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45, 0x54,
-	0x5f, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49,
-	0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x10, 0x77, 0x12, 0x1d,
-	0x0a, 0x18, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
-	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc9, 0x01, 0x12, 0x1a, 0x0a,
-	0x15, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0xca, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x49, 0x4e, 0x53,
-	0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0xcb, 0x01, 0x12, 0x11, 0x0a, 0x0c,
-	0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0xcc, 0x01, 0x12,
-	0x30, 0x0a, 0x2b, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x41, 0x52, 0x54, 0x49,
-	0x46, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x53, 0x10, 0xcd,
-	0x01, 0x12, 0x13, 0x0a, 0x0e, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x4c,
-	0x4d, 0x33, 0x32, 0x10, 0xce, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b,
-	0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x48, 0x45,
-	0x4c, 0x4d, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50,
-	0x41, 0x43, 0x45, 0x10, 0xcf, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x53, 0x10,
-	0xd0, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x41, 0x4c, 0x49, 0x47, 0x4e, 0x5f, 0x4b, 0x50, 0x54, 0x5f,
-	0x49, 0x4e, 0x56, 0x45, 0x4e, 0x54, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01, 0x12, 0x14, 0x0a, 0x0f,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x10,
-	0xdc, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x59, 0x5f, 0x43, 0x4c,
-	0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0xe6, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0xad, 0x02, 0x12, 0x1a,
-	0x0a, 0x15, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53,
-	0x53, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x10, 0xae, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x49, 0x4d,
-	0x41, 0x47, 0x45, 0x10, 0xaf, 0x02, 0x12, 0x21, 0x0a, 0x1c, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
-	0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52,
-	0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x90, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x44, 0x44,
-	0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x50,
-	0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x91, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x41, 0x44,
-	0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f,
-	0x52, 0x4b, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x92,
-	0x03, 0x12, 0x1e, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44,
-	0x45, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x93,
-	0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10,
-	0x94, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f,
-	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x95,
-	0x03, 0x12, 0x1b, 0x0a, 0x16, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44,
-	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x96, 0x03, 0x12, 0x1e,
-	0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x97, 0x03, 0x12, 0x1a,
-	0x0a, 0x15, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e,
-	0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x98, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x10, 0xf5, 0x03, 0x12,
-	0x15, 0x0a, 0x10, 0x55, 0x4e, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b,
-	0x55, 0x42, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f,
-	0x43, 0x4b, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x10, 0xa7, 0x04, 0x12, 0x22, 0x0a, 0x1d,
-	0x53, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
-	0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0xd8, 0x04,
-	0x12, 0x18, 0x0a, 0x13, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x55,
-	0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x10, 0xd9, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x4b, 0x50,
-	0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x59, 0x41, 0x4d, 0x4c,
-	0x10, 0xda, 0x04, 0x12, 0x24, 0x0a, 0x1f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x4d, 0x41, 0x4e,
-	0x49, 0x46, 0x45, 0x53, 0x54, 0x53, 0x10, 0xdb, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50,
-	0x41, 0x54, 0x48, 0x10, 0xbc, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43,
-	0x59, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xbd, 0x05, 0x12,
-	0x18, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x10, 0xbe, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
-	0x10, 0xbf, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x49,
-	0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc0, 0x05, 0x12, 0x2f, 0x0a, 0x2a, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44,
-	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x53, 0x45,
-	0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc1, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc2, 0x05, 0x12,
-	0x1b, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x50,
-	0x49, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xc3, 0x05, 0x12, 0x20, 0x0a, 0x1b,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54,
-	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0xc4, 0x05, 0x12, 0x22,
-	0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49,
-	0x53, 0x54, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x10,
-	0xc5, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58,
-	0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53,
-	0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xc6, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54,
-	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc7, 0x05, 0x12, 0x27,
-	0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41,
-	0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x56, 0x45, 0x52,
-	0x53, 0x49, 0x4f, 0x4e, 0x10, 0xc8, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x49, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x4f, 0x52,
-	0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xa0, 0x06, 0x12,
-	0x1e, 0x0a, 0x19, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x41,
-	0x44, 0x44, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x10, 0xa1, 0x06, 0x12,
-	0x20, 0x0a, 0x1b, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
-	0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xa2,
-	0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x10,
-	0x84, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0xe8, 0x07, 0x12, 0x1f, 0x0a,
-	0x1a, 0x46, 0x49, 0x58, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
-	0x41, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xe9, 0x07, 0x12, 0x2a,
-	0x0a, 0x25, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43,
-	0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43,
-	0x49, 0x45, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x10, 0xea, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41,
-	0x4e, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f,
-	0x50, 0x41, 0x54, 0x48, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x43, 0x48, 0x45, 0x43,
-	0x4b, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x41,
-	0x4e, 0x44, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0xec, 0x07,
-	0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6f,
-	0x6f, 0x6c, 0x73, 0x2f, 0x73, 0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x2f, 0x76, 0x32, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x45, 0x52, 0x52, 0x10, 0xfc, 0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x29, 0x0a, 0x20, 0x44, 0x45,
+	0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46,
+	0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfd,
+	0x07, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f,
+	0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x53, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xfe, 0x07, 0x12,
+	0x27, 0x0a, 0x22, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xff, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x44, 0x45, 0x50, 0x4c,
+	0x4f, 0x59, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0x80, 0x08, 0x12, 0x1a, 0x0a, 0x15, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
+	0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0x81, 0x08, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x4b, 0x50, 0x54,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x59, 0x41, 0x4d,
+	0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x82, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x44, 0x45, 0x50, 0x4c,
+	0x4f, 0x59, 0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0x83, 0x08, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x47, 0x45,
+	0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x43, 0x4c, 0x49, 0x45,
+	0x4e, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x84, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x45, 0x50,
+	0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x47, 0x45,
+	0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x85, 0x08,
+	0x12, 0x28, 0x0a, 0x23, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44,
+	0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x86, 0x08, 0x12, 0x28, 0x0a, 0x23, 0x44, 0x45,
+	0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0x87, 0x08, 0x12, 0x29, 0x0a, 0x24, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43,
+	0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x57, 0x4f, 0x52,
+	0x4b, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x88, 0x08, 0x12,
+	0x2c, 0x0a, 0x27, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f,
+	0x52, 0x55, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45,
+	0x52, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x89, 0x08, 0x12, 0x2c, 0x0a,
+	0x27, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55,
+	0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f,
+	0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x8a, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xcd, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43,
+	0x53, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xce, 0x08, 0x12, 0x16,
+	0x0a, 0x11, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d, 0x47, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xcf, 0x08, 0x12, 0x1e, 0x0a, 0x19, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43,
+	0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xd0, 0x08, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43,
+	0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xd1, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f,
+	0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f,
+	0x55, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd2, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x45, 0x53,
+	0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd3,
+	0x08, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd4, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54, 0x45, 0x53,
+	0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e,
+	0x5f, 0x45, 0x58, 0x49, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd6, 0x08, 0x12, 0x1c,
+	0x0a, 0x17, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d,
+	0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd7, 0x08, 0x12, 0x25, 0x0a, 0x20,
+	0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x44, 0x45, 0x50, 0x45,
+	0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0xd8, 0x08, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54,
+	0x4f, 0x4d, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f,
+	0x55, 0x4e, 0x4d, 0x41, 0x52, 0x53, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd9,
+	0x08, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xda, 0x08, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b,
+	0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xdd, 0x0b, 0x12, 0x24, 0x0a, 0x1f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x59, 0x41, 0x4d,
+	0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf9, 0x0a, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfa, 0x0a,
+	0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfb, 0x0a, 0x12,
+	0x25, 0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50,
+	0x41, 0x43, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xfc, 0x0a, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xfd, 0x0a, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45,
+	0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfe, 0x0a, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x4e,
+	0x44, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xff, 0x0a, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d,
+	0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0x80, 0x0b, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x50,
+	0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d,
+	0x41, 0x47, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x81, 0x0b, 0x12, 0x1b, 0x0a, 0x16, 0x52,
+	0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x82, 0x0b, 0x12, 0x1c, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49, 0x4e, 0x47, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xb1, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xb2, 0x09, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb3, 0x09, 0x12, 0x29, 0x0a, 0x24,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0xb4, 0x09, 0x12, 0x2c, 0x0a, 0x27, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x47, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x41, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xb5, 0x09, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x42, 0x41, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb6,
+	0x09, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x5a, 0x45, 0x52, 0x4f,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb7, 0x09, 0x12, 0x1e, 0x0a,
+	0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb8, 0x09, 0x12, 0x1e, 0x0a,
+	0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f,
+	0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb9, 0x09, 0x12, 0x27, 0x0a,
+	0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x54,
+	0x48, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x54, 0x49, 0x54, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xba, 0x09, 0x12, 0x2d, 0x0a, 0x28, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xbb, 0x09, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xbc, 0x09, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xbd, 0x09, 0x12, 0x1d,
+	0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x10, 0xbe, 0x09, 0x12, 0x1f, 0x0a,
+	0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x10, 0xbf, 0x09, 0x12, 0x25,
+	0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xc0, 0x09, 0x12, 0x2b, 0x0a, 0x26, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x43, 0x41, 0x43, 0x48,
+	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xc1, 0x09, 0x12, 0x17, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x50, 0x47,
+	0x52, 0x41, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc2, 0x09, 0x12, 0x18, 0x0a, 0x13, 0x49,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0x95, 0x0a, 0x12, 0x29, 0x0a, 0x24, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54,
+	0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x96, 0x0a,
+	0x12, 0x29, 0x0a, 0x24, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x45, 0x4e, 0x56, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x97, 0x0a, 0x12, 0x22, 0x0a, 0x1d, 0x49,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x98, 0x0a, 0x12,
+	0x26, 0x0a, 0x21, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f,
+	0x52, 0x55, 0x4e, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc1, 0x0c, 0x12, 0x27, 0x0a, 0x22, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x58,
+	0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc2, 0x0c,
+	0x12, 0x24, 0x0a, 0x1f, 0x4c, 0x4f, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x52,
+	0x55, 0x4e, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0xc3, 0x0c, 0x2a, 0xde, 0x12, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x49, 0x4c,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x65,
+	0x12, 0x24, 0x0a, 0x20, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44,
+	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x10, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x4f, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52,
+	0x45, 0x10, 0x68, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x47, 0x43, 0x4c,
+	0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x69, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x6a, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x49, 0x58, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6b, 0x12,
+	0x16, 0x0a, 0x12, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x6c, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x49, 0x58, 0x5f, 0x43,
+	0x41, 0x43, 0x48, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41,
+	0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x6d, 0x12, 0x22, 0x0a, 0x1e, 0x46,
+	0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x6e, 0x12,
+	0x20, 0x0a, 0x1c, 0x46, 0x49, 0x58, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x6f, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x49, 0x58, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45,
+	0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x70, 0x12, 0x2a, 0x0a, 0x26, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x71, 0x12, 0x3a, 0x0a, 0x36, 0x46, 0x49, 0x58, 0x5f, 0x44, 0x4f, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x57, 0x48, 0x45, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x72,
+	0x12, 0x14, 0x0a, 0x10, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x50,
+	0x52, 0x55, 0x4e, 0x45, 0x10, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c,
+	0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x74, 0x12, 0x23, 0x0a, 0x1f,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10,
+	0x75, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41,
+	0x4c, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x4d, 0x55, 0x4c,
+	0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x76, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45, 0x54, 0x5f, 0x50,
+	0x55, 0x53, 0x48, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x10, 0x77, 0x12, 0x1d, 0x0a, 0x18,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc9, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0xca, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x49, 0x4e, 0x53, 0x54, 0x41,
+	0x4c, 0x4c, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0xcb, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x55, 0x50,
+	0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0xcc, 0x01, 0x12, 0x30, 0x0a,
+	0x2b, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41,
+	0x43, 0x54, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x53, 0x10, 0xcd, 0x01, 0x12,
+	0x13, 0x0a, 0x0e, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x33,
+	0x32, 0x10, 0xce, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46,
+	0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x4d,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x10, 0xcf, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x4b, 0x50, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x53, 0x10, 0xd0, 0x01,
+	0x12, 0x18, 0x0a, 0x13, 0x41, 0x4c, 0x49, 0x47, 0x4e, 0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x49, 0x4e,
+	0x56, 0x45, 0x4e, 0x54, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x49, 0x4e,
+	0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x10, 0xdc, 0x01,
+	0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55,
+	0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xe6,
+	0x01, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0xad, 0x02, 0x12, 0x1a, 0x0a, 0x15,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f,
+	0x50, 0x52, 0x4f, 0x42, 0x45, 0x10, 0xae, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x49, 0x4d, 0x41, 0x47,
+	0x45, 0x10, 0xaf, 0x02, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x45, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f,
+	0x52, 0x4d, 0x10, 0xb0, 0x02, 0x12, 0x21, 0x0a, 0x1c, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
+	0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x45,
+	0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x90, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x44, 0x44, 0x52,
+	0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x50, 0x52,
+	0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x91, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
+	0x4b, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x92, 0x03,
+	0x12, 0x1e, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x50, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x93, 0x03,
+	0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x94,
+	0x03, 0x12, 0x1d, 0x0a, 0x18, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x95, 0x03,
+	0x12, 0x1b, 0x0a, 0x16, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x96, 0x03, 0x12, 0x1e, 0x0a,
+	0x19, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x97, 0x03, 0x12, 0x1a, 0x0a,
+	0x15, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x98, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x10, 0xf5, 0x03, 0x12, 0x15,
+	0x0a, 0x10, 0x55, 0x4e, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55,
+	0x42, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f, 0x43,
+	0x4b, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x10, 0xa7, 0x04, 0x12, 0x22, 0x0a, 0x1d, 0x53,
+	0x45, 0x54, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4f,
+	0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0xd8, 0x04, 0x12,
+	0x18, 0x0a, 0x13, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41,
+	0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x10, 0xd9, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x4b, 0x50, 0x54,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10,
+	0xda, 0x04, 0x12, 0x24, 0x0a, 0x1f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49,
+	0x46, 0x45, 0x53, 0x54, 0x53, 0x10, 0xdb, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41,
+	0x54, 0x48, 0x10, 0xbc, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xbd, 0x05, 0x12, 0x18,
+	0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x53, 0x10, 0xbe, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10,
+	0xbf, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc0, 0x05, 0x12, 0x2f, 0x0a, 0x2a, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45,
+	0x4e, 0x43, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x53, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc1, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc2, 0x05, 0x12, 0x1b,
+	0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xc3, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0xc4, 0x05, 0x12, 0x22, 0x0a,
+	0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x10, 0xc5,
+	0x05, 0x12, 0x25, 0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xc6, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45,
+	0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc7, 0x05, 0x12, 0x27, 0x0a,
+	0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46,
+	0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0xc8, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x54, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x4f, 0x52, 0x5f,
+	0x4e, 0x45, 0x57, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xa0, 0x06, 0x12, 0x1e,
+	0x0a, 0x19, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x41, 0x44,
+	0x44, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x10, 0xa1, 0x06, 0x12, 0x20,
+	0x0a, 0x1b, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xa2, 0x06,
+	0x12, 0x0f, 0x0a, 0x0a, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x10, 0x84,
+	0x07, 0x12, 0x16, 0x0a, 0x11, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x53, 0x55, 0x47, 0x47,
+	0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x85, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e,
+	0x44, 0x10, 0xe8, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x46, 0x49, 0x58, 0x5f, 0x43, 0x55, 0x53, 0x54,
+	0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f,
+	0x55, 0x54, 0x10, 0xe9, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43,
+	0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x45,
+	0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x10, 0xea,
+	0x07, 0x12, 0x2c, 0x0a, 0x27, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f,
+	0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44,
+	0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x53, 0x10, 0xeb, 0x07, 0x12,
+	0x26, 0x0a, 0x21, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x10, 0xec, 0x07, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x73, 0x6b, 0x61, 0x66, 0x66,
+	0x6f, 0x6c, 0x64, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x75,
+	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -36,6 +36,7 @@ const BuilderType_CUSTOM = enums.BuilderType_CUSTOM
 const BuilderType_KANIKO = enums.BuilderType_KANIKO
 const BuilderType_DOCKER = enums.BuilderType_DOCKER
 const BuilderType_KO = enums.BuilderType_KO
+const BuilderType_APKO = enums.BuilderType_APKO
 
 var BuilderType_name = enums.BuilderType_name
 var BuilderType_value = enums.BuilderType_value
@@ -167,6 +168,8 @@ const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = enums.StatusCode_STATUSCHECK_RU
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING
 const StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING = enums.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING
+const StatusCode_STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED = enums.StatusCode_STATUSCHECK_DEPLOYMENT_PROGRESS_DEADLINE_EXCEEDED
+const StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH = enums.StatusCode_STATUSCHECK_IMAGE_PLATFORM_MISMATCH
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING
 const StatusCode_STATUSCHECK_UNHEALTHY = enums.StatusCode_STATUSCHECK_UNHEALTHY
 const StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR = enums.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR
@@ -186,6 +189,10 @@ const StatusCode_STATUSCHECK_STANDALONE_PODS_FETCH_ERR = enums.StatusCode_STATUS
 const StatusCode_STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR = enums.StatusCode_STATUSCHECK_CONFIG_CONNECTOR_RESOURCES_FETCH_ERR
 const StatusCode_STATUSCHECK_STATEFULSET_FETCH_ERR = enums.StatusCode_STATUSCHECK_STATEFULSET_FETCH_ERR
 const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR = enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR
+const StatusCode_STATUSCHECK_SERVICE_FETCH_ERR = enums.StatusCode_STATUSCHECK_SERVICE_FETCH_ERR
+const StatusCode_STATUSCHECK_INGRESS_FETCH_ERR = enums.StatusCode_STATUSCHECK_INGRESS_FETCH_ERR
+const StatusCode_STATUSCHECK_REPLICASET_FETCH_ERR = enums.StatusCode_STATUSCHECK_REPLICASET_FETCH_ERR
+const StatusCode_STATUSCHECK_PVC_FETCH_ERR = enums.StatusCode_STATUSCHECK_PVC_FETCH_ERR
 const StatusCode_STATUSCHECK_POD_INITIALIZING = enums.StatusCode_STATUSCHECK_POD_INITIALIZING
 const StatusCode_STATUSCHECK_CONFIG_CONNECTOR_IN_PROGRESS = enums.StatusCode_STATUSCHECK_CONFIG_CONNECTOR_IN_PROGRESS
 const StatusCode_STATUSCHECK_CONFIG_CONNECTOR_FAILED = enums.StatusCode_STATUSCHECK_CONFIG_CONNECTOR_FAILED
@@ -195,6 +202,24 @@ const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_IN_PROGRESS = enums.StatusCode_STAT
 const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED = enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED
 const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_TERMINATING = enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_TERMINATING
 const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_NOT_FOUND = enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_NOT_FOUND
+const StatusCode_STATUSCHECK_SERVICE_PENDING_LB = enums.StatusCode_STATUSCHECK_SERVICE_PENDING_LB
+const StatusCode_STATUSCHECK_INITIAL_DELAY = enums.StatusCode_STATUSCHECK_INITIAL_DELAY
+const StatusCode_STATUSCHECK_INGRESS_PENDING = enums.StatusCode_STATUSCHECK_INGRESS_PENDING
+const StatusCode_STATUSCHECK_POD_EVICTED = enums.StatusCode_STATUSCHECK_POD_EVICTED
+const StatusCode_STATUSCHECK_POD_PREEMPTED = enums.StatusCode_STATUSCHECK_POD_PREEMPTED
+const StatusCode_STATUSCHECK_STABILIZING = enums.StatusCode_STATUSCHECK_STABILIZING
+const StatusCode_STATUSCHECK_SERVICE_ENDPOINTS_PENDING = enums.StatusCode_STATUSCHECK_SERVICE_ENDPOINTS_PENDING
+const StatusCode_STATUSCHECK_REPLICASET_PENDING = enums.StatusCode_STATUSCHECK_REPLICASET_PENDING
+const StatusCode_STATUSCHECK_DEPLOYMENT_PAUSED = enums.StatusCode_STATUSCHECK_DEPLOYMENT_PAUSED
+const StatusCode_STATUSCHECK_READINESS_PENDING = enums.StatusCode_STATUSCHECK_READINESS_PENDING
+const StatusCode_STATUSCHECK_PVC_PENDING = enums.StatusCode_STATUSCHECK_PVC_PENDING
+const StatusCode_STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND = enums.StatusCode_STATUSCHECK_PVC_STORAGE_CLASS_NOT_FOUND
+const StatusCode_STATUSCHECK_RESOURCE_DELETED = enums.StatusCode_STATUSCHECK_RESOURCE_DELETED
+const StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING = enums.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PENDING
+const StatusCode_STATUSCHECK_ARGO_ROLLOUT_PAUSED = enums.StatusCode_STATUSCHECK_ARGO_ROLLOUT_PAUSED
+const StatusCode_STATUSCHECK_ARGO_ROLLOUT_DEGRADED = enums.StatusCode_STATUSCHECK_ARGO_ROLLOUT_DEGRADED
+const StatusCode_STATUSCHECK_WAITING_FOR_DEPENDENCIES = enums.StatusCode_STATUSCHECK_WAITING_FOR_DEPENDENCIES
+const StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED = enums.StatusCode_STATUSCHECK_KUBECTL_AUTH_EXPIRED
 const StatusCode_UNKNOWN_ERROR = enums.StatusCode_UNKNOWN_ERROR
 const StatusCode_STATUSCHECK_UNKNOWN = enums.StatusCode_STATUSCHECK_UNKNOWN
 const StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE = enums.StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE
@@ -358,6 +383,7 @@ const SuggestionCode_SPECIFY_CLOUD_RUN_LOCATION = enums.SuggestionCode_SPECIFY_C
 const SuggestionCode_CHECK_CONTAINER_LOGS = enums.SuggestionCode_CHECK_CONTAINER_LOGS
 const SuggestionCode_CHECK_READINESS_PROBE = enums.SuggestionCode_CHECK_READINESS_PROBE
 const SuggestionCode_CHECK_CONTAINER_IMAGE = enums.SuggestionCode_CHECK_CONTAINER_IMAGE
+const SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM = enums.SuggestionCode_REBUILD_IMAGE_FOR_PLATFORM
 const SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE = enums.SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE
 const SuggestionCode_ADDRESS_NODE_DISK_PRESSURE = enums.SuggestionCode_ADDRESS_NODE_DISK_PRESSURE
 const SuggestionCode_ADDRESS_NODE_NETWORK_UNAVAILABLE = enums.SuggestionCode_ADDRESS_NODE_NETWORK_UNAVAILABLE
@@ -391,6 +417,7 @@ const SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE = enums.SuggestionCode_IN
 const SuggestionCode_INSPECT_USE_ADD_BUILD_ENV = enums.SuggestionCode_INSPECT_USE_ADD_BUILD_ENV
 const SuggestionCode_INSPECT_CHECK_INPUT_PROFILE = enums.SuggestionCode_INSPECT_CHECK_INPUT_PROFILE
 const SuggestionCode_OPEN_ISSUE = enums.SuggestionCode_OPEN_ISSUE
+const SuggestionCode_CUSTOM_SUGGESTION = enums.SuggestionCode_CUSTOM_SUGGESTION
 const SuggestionCode_CHECK_CUSTOM_COMMAND = enums.SuggestionCode_CHECK_CUSTOM_COMMAND
 const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = enums.SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD
@@ -2178,14 +2205,15 @@ type BuildSubtaskEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                           // id of the subtask which will be used in SkaffoldLog
-	TaskId          string         `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`     // id of the task of skaffold that this event came from
-	Artifact        string         `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`               // artifact name
-	Step            string         `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`                       // which step of the build for the artifact oneof: Cache, Build, Push
-	Status          string         `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                   // artifact build status oneof: InProgress, Completed, Failed
-	ActionableErr   *ActionableErr `protobuf:"bytes,6,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`     // actionable error message
-	HostPlatform    string         `protobuf:"bytes,7,opt,name=hostPlatform,proto3" json:"hostPlatform,omitempty"`       // platform of the host machine. For example `linux/amd64`
-	TargetPlatforms string         `protobuf:"bytes,8,opt,name=targetPlatforms,proto3" json:"targetPlatforms,omitempty"` // comma-delimited list of build target platforms. For example `linux/amd64,linux/arm64`
+	Id              string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                           // id of the subtask which will be used in SkaffoldLog
+	TaskId          string              `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`     // id of the task of skaffold that this event came from
+	Artifact        string              `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`               // artifact name
+	Step            string              `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`                       // which step of the build for the artifact oneof: Cache, Build, Push
+	Status          string              `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                   // artifact build status oneof: InProgress, Completed, Failed
+	ActionableErr   *ActionableErr      `protobuf:"bytes,6,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`     // actionable error message
+	HostPlatform    string              `protobuf:"bytes,7,opt,name=hostPlatform,proto3" json:"hostPlatform,omitempty"`       // platform of the host machine. For example `linux/amd64`
+	TargetPlatforms string              `protobuf:"bytes,8,opt,name=targetPlatforms,proto3" json:"targetPlatforms,omitempty"` // comma-delimited list of build target platforms. For example `linux/amd64,linux/arm64`
+	PhaseTimings    []*BuildPhaseTiming `protobuf:"bytes,9,rep,name=phaseTimings,proto3" json:"phaseTimings,omitempty"`       // time spent by the artifact in each build phase, sent with the `Timings` step when `--profile-timings` is set
 }
 
 func (x *BuildSubtaskEvent) Reset() {
@@ -2276,6 +2304,69 @@ func (x *BuildSubtaskEvent) GetTargetPlatforms() string {
 	return ""
 }
 
+func (x *BuildSubtaskEvent) GetPhaseTimings() []*BuildPhaseTiming {
+	if x != nil {
+		return x.PhaseTimings
+	}
+	return nil
+}
+
+// `BuildPhaseTiming` is the time spent by an artifact in a build phase.
+type BuildPhaseTiming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase      string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`            // build phase oneof: "cache check", "context", "build" or "push"
+	DurationMs int64  `protobuf:"varint,2,opt,name=durationMs,proto3" json:"durationMs,omitempty"` // time spent in the phase, in milliseconds
+}
+
+func (x *BuildPhaseTiming) Reset() {
+	*x = BuildPhaseTiming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_skaffold_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildPhaseTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPhaseTiming) ProtoMessage() {}
+
+func (x *BuildPhaseTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_skaffold_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPhaseTiming.ProtoReflect.Descriptor instead.
+func (*BuildPhaseTiming) Descriptor() ([]byte, []int) {
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BuildPhaseTiming) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *BuildPhaseTiming) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
 // `TestSubtaskEvent` represents the status of a test, and is emitted by Skaffold
 // anytime a test starts or completes, successfully or not.
 type TestSubtaskEvent struct {
@@ -2292,7 +2383,7 @@ type TestSubtaskEvent struct {
 func (x *TestSubtaskEvent) Reset() {
 	*x = TestSubtaskEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[25]
+		mi := &file_v2_skaffold_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2305,7 +2396,7 @@ func (x *TestSubtaskEvent) String() string {
 func (*TestSubtaskEvent) ProtoMessage() {}
 
 func (x *TestSubtaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[25]
+	mi := &file_v2_skaffold_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2318,7 +2409,7 @@ func (x *TestSubtaskEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestSubtaskEvent.ProtoReflect.Descriptor instead.
 func (*TestSubtaskEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{25}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TestSubtaskEvent) GetId() string {
@@ -2365,7 +2456,7 @@ type RenderSubtaskEvent struct {
 func (x *RenderSubtaskEvent) Reset() {
 	*x = RenderSubtaskEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[26]
+		mi := &file_v2_skaffold_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2378,7 +2469,7 @@ func (x *RenderSubtaskEvent) String() string {
 func (*RenderSubtaskEvent) ProtoMessage() {}
 
 func (x *RenderSubtaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[26]
+	mi := &file_v2_skaffold_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2391,7 +2482,7 @@ func (x *RenderSubtaskEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenderSubtaskEvent.ProtoReflect.Descriptor instead.
 func (*RenderSubtaskEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{26}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RenderSubtaskEvent) GetId() string {
@@ -2438,7 +2529,7 @@ type VerifySubtaskEvent struct {
 func (x *VerifySubtaskEvent) Reset() {
 	*x = VerifySubtaskEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[27]
+		mi := &file_v2_skaffold_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2451,7 +2542,7 @@ func (x *VerifySubtaskEvent) String() string {
 func (*VerifySubtaskEvent) ProtoMessage() {}
 
 func (x *VerifySubtaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[27]
+	mi := &file_v2_skaffold_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,7 +2555,7 @@ func (x *VerifySubtaskEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifySubtaskEvent.ProtoReflect.Descriptor instead.
 func (*VerifySubtaskEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{27}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *VerifySubtaskEvent) GetId() string {
@@ -2511,7 +2602,7 @@ type ExecSubtaskEvent struct {
 func (x *ExecSubtaskEvent) Reset() {
 	*x = ExecSubtaskEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[28]
+		mi := &file_v2_skaffold_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2524,7 +2615,7 @@ func (x *ExecSubtaskEvent) String() string {
 func (*ExecSubtaskEvent) ProtoMessage() {}
 
 func (x *ExecSubtaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[28]
+	mi := &file_v2_skaffold_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +2628,7 @@ func (x *ExecSubtaskEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecSubtaskEvent.ProtoReflect.Descriptor instead.
 func (*ExecSubtaskEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{28}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ExecSubtaskEvent) GetId() string {
@@ -2584,7 +2675,7 @@ type DeploySubtaskEvent struct {
 func (x *DeploySubtaskEvent) Reset() {
 	*x = DeploySubtaskEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[29]
+		mi := &file_v2_skaffold_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2597,7 +2688,7 @@ func (x *DeploySubtaskEvent) String() string {
 func (*DeploySubtaskEvent) ProtoMessage() {}
 
 func (x *DeploySubtaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[29]
+	mi := &file_v2_skaffold_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2610,7 +2701,7 @@ func (x *DeploySubtaskEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeploySubtaskEvent.ProtoReflect.Descriptor instead.
 func (*DeploySubtaskEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{29}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeploySubtaskEvent) GetId() string {
@@ -2646,24 +2737,32 @@ func (x *DeploySubtaskEvent) GetActionableErr() *ActionableErr {
 // There can be multiple events with `status` *Pending*.
 // Skaffold polls for resource status every 0.5 second. If the resource status changes, an event with `status` “Pending”, “Complete” and “Failed”
 // will be sent with the new status.
+// An event with `status` *Started* is sent when skaffold starts checking a resource, and an event with `status` *Complete*
+// when it stops checking it.
 type StatusCheckSubtaskEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // id of the subtask which will be used in SkaffoldLog
-	TaskId        string           `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // id of the task of skaffold that this event came from
-	Resource      string           `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`           // id of the subtask which will be used in SkaffoldLog
-	Status        string           `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`               // id of the task of skaffold that this event came from
-	Message       string           `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	StatusCode    enums.StatusCode `protobuf:"varint,6,opt,name=statusCode,proto3,enum=proto.enums.StatusCode" json:"statusCode,omitempty"`
-	ActionableErr *ActionableErr   `protobuf:"bytes,7,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"` // actionable error message
+	Id              string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // id of the subtask which will be used in SkaffoldLog
+	TaskId          string           `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // id of the task of skaffold that this event came from
+	Resource        string           `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`           // id of the subtask which will be used in SkaffoldLog
+	Status          string           `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`               // id of the task of skaffold that this event came from
+	Message         string           `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	StatusCode      enums.StatusCode `protobuf:"varint,6,opt,name=statusCode,proto3,enum=proto.enums.StatusCode" json:"statusCode,omitempty"`
+	ActionableErr   *ActionableErr   `protobuf:"bytes,7,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`       // actionable error message
+	Kind            string           `protobuf:"bytes,8,opt,name=kind,proto3" json:"kind,omitempty"`                         // kind of the resource, like deployment or statefulset
+	Name            string           `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`                         // name of the resource
+	Namespace       string           `protobuf:"bytes,10,opt,name=namespace,proto3" json:"namespace,omitempty"`              // namespace of the resource
+	DeadlineSeconds int32            `protobuf:"varint,11,opt,name=deadlineSeconds,proto3" json:"deadlineSeconds,omitempty"` // status check deadline of the resource
+	CurrentReplicas int32            `protobuf:"varint,12,opt,name=currentReplicas,proto3" json:"currentReplicas,omitempty"` // replicas that reached the current step of the rollout, like updated or available replicas
+	TotalReplicas   int32            `protobuf:"varint,13,opt,name=totalReplicas,proto3" json:"totalReplicas,omitempty"`     // total replicas of the rollout, 0 if the rollout progress is unknown
 }
 
 func (x *StatusCheckSubtaskEvent) Reset() {
 	*x = StatusCheckSubtaskEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[30]
+		mi := &file_v2_skaffold_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2676,7 +2775,7 @@ func (x *StatusCheckSubtaskEvent) String() string {
 func (*StatusCheckSubtaskEvent) ProtoMessage() {}
 
 func (x *StatusCheckSubtaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[30]
+	mi := &file_v2_skaffold_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2689,7 +2788,7 @@ func (x *StatusCheckSubtaskEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusCheckSubtaskEvent.ProtoReflect.Descriptor instead.
 func (*StatusCheckSubtaskEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{30}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *StatusCheckSubtaskEvent) GetId() string {
@@ -2741,6 +2840,48 @@ func (x *StatusCheckSubtaskEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+func (x *StatusCheckSubtaskEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StatusCheckSubtaskEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatusCheckSubtaskEvent) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StatusCheckSubtaskEvent) GetDeadlineSeconds() int32 {
+	if x != nil {
+		return x.DeadlineSeconds
+	}
+	return 0
+}
+
+func (x *StatusCheckSubtaskEvent) GetCurrentReplicas() int32 {
+	if x != nil {
+		return x.CurrentReplicas
+	}
+	return 0
+}
+
+func (x *StatusCheckSubtaskEvent) GetTotalReplicas() int32 {
+	if x != nil {
+		return x.TotalReplicas
+	}
+	return 0
+}
+
 // A Resource StatusCheck Event for a Cloud Run Service.
 // Indicates that a Cloud Run Service has deployed successfully and is serving
 // on the specified URL
@@ -2759,7 +2900,7 @@ type CloudRunReadyEvent struct {
 func (x *CloudRunReadyEvent) Reset() {
 	*x = CloudRunReadyEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[31]
+		mi := &file_v2_skaffold_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2772,7 +2913,7 @@ func (x *CloudRunReadyEvent) String() string {
 func (*CloudRunReadyEvent) ProtoMessage() {}
 
 func (x *CloudRunReadyEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[31]
+	mi := &file_v2_skaffold_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2785,7 +2926,7 @@ func (x *CloudRunReadyEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloudRunReadyEvent.ProtoReflect.Descriptor instead.
 func (*CloudRunReadyEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{31}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CloudRunReadyEvent) GetId() string {
@@ -2840,12 +2981,14 @@ type PortForwardEvent struct {
 	ResourceName  string       `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"` // name of the resource to forward.
 	Address       string       `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`          // address on which to bind
 	TargetPort    *IntOrString `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`    // target port is the resource port that will be forwarded.
+	Protocol      string       `protobuf:"bytes,12,opt,name=protocol,proto3" json:"protocol,omitempty"`        // protocol of the forwarded port, TCP or UDP.
+	Reconnected   bool         `protobuf:"varint,13,opt,name=reconnected,proto3" json:"reconnected,omitempty"` // true if the port forward was re-established, e.g. on a restarted pod, keeping its local port.
 }
 
 func (x *PortForwardEvent) Reset() {
 	*x = PortForwardEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[32]
+		mi := &file_v2_skaffold_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2858,7 +3001,7 @@ func (x *PortForwardEvent) String() string {
 func (*PortForwardEvent) ProtoMessage() {}
 
 func (x *PortForwardEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[32]
+	mi := &file_v2_skaffold_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2871,7 +3014,7 @@ func (x *PortForwardEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortForwardEvent.ProtoReflect.Descriptor instead.
 func (*PortForwardEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{32}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PortForwardEvent) GetId() string {
@@ -2951,6 +3094,20 @@ func (x *PortForwardEvent) GetTargetPort() *IntOrString {
 	return nil
 }
 
+func (x *PortForwardEvent) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *PortForwardEvent) GetReconnected() bool {
+	if x != nil {
+		return x.Reconnected
+	}
+	return false
+}
+
 // FileSyncEvent describes the sync status.
 type FileSyncEvent struct {
 	state         protoimpl.MessageState
@@ -2968,7 +3125,7 @@ type FileSyncEvent struct {
 func (x *FileSyncEvent) Reset() {
 	*x = FileSyncEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[33]
+		mi := &file_v2_skaffold_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2981,7 +3138,7 @@ func (x *FileSyncEvent) String() string {
 func (*FileSyncEvent) ProtoMessage() {}
 
 func (x *FileSyncEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[33]
+	mi := &file_v2_skaffold_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2994,7 +3151,7 @@ func (x *FileSyncEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileSyncEvent.ProtoReflect.Descriptor instead.
 func (*FileSyncEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{33}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *FileSyncEvent) GetId() string {
@@ -3060,7 +3217,7 @@ type DebuggingContainerEvent struct {
 func (x *DebuggingContainerEvent) Reset() {
 	*x = DebuggingContainerEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[34]
+		mi := &file_v2_skaffold_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3073,7 +3230,7 @@ func (x *DebuggingContainerEvent) String() string {
 func (*DebuggingContainerEvent) ProtoMessage() {}
 
 func (x *DebuggingContainerEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[34]
+	mi := &file_v2_skaffold_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3086,7 +3243,7 @@ func (x *DebuggingContainerEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebuggingContainerEvent.ProtoReflect.Descriptor instead.
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{34}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *DebuggingContainerEvent) GetId() string {
@@ -3170,7 +3327,7 @@ type UserIntentRequest struct {
 func (x *UserIntentRequest) Reset() {
 	*x = UserIntentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[35]
+		mi := &file_v2_skaffold_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3183,7 +3340,7 @@ func (x *UserIntentRequest) String() string {
 func (*UserIntentRequest) ProtoMessage() {}
 
 func (x *UserIntentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[35]
+	mi := &file_v2_skaffold_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3196,7 +3353,7 @@ func (x *UserIntentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserIntentRequest.ProtoReflect.Descriptor instead.
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{35}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UserIntentRequest) GetIntent() *Intent {
@@ -3217,7 +3374,7 @@ type TriggerRequest struct {
 func (x *TriggerRequest) Reset() {
 	*x = TriggerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[36]
+		mi := &file_v2_skaffold_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3230,7 +3387,7 @@ func (x *TriggerRequest) String() string {
 func (*TriggerRequest) ProtoMessage() {}
 
 func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[36]
+	mi := &file_v2_skaffold_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3243,7 +3400,7 @@ func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerRequest.ProtoReflect.Descriptor instead.
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{36}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TriggerRequest) GetState() *TriggerState {
@@ -3268,7 +3425,7 @@ type TriggerState struct {
 func (x *TriggerState) Reset() {
 	*x = TriggerState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[37]
+		mi := &file_v2_skaffold_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3281,7 +3438,7 @@ func (x *TriggerState) String() string {
 func (*TriggerState) ProtoMessage() {}
 
 func (x *TriggerState) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[37]
+	mi := &file_v2_skaffold_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3294,7 +3451,7 @@ func (x *TriggerState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerState.ProtoReflect.Descriptor instead.
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{37}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{38}
 }
 
 func (m *TriggerState) GetVal() isTriggerState_Val {
@@ -3336,7 +3493,7 @@ type Intent struct {
 func (x *Intent) Reset() {
 	*x = Intent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[38]
+		mi := &file_v2_skaffold_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3349,7 +3506,7 @@ func (x *Intent) String() string {
 func (*Intent) ProtoMessage() {}
 
 func (x *Intent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[38]
+	mi := &file_v2_skaffold_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,7 +3519,7 @@ func (x *Intent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Intent.ProtoReflect.Descriptor instead.
 func (*Intent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{38}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *Intent) GetBuild() bool {
@@ -3406,7 +3563,7 @@ type Suggestion struct {
 func (x *Suggestion) Reset() {
 	*x = Suggestion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[39]
+		mi := &file_v2_skaffold_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3419,7 +3576,7 @@ func (x *Suggestion) String() string {
 func (*Suggestion) ProtoMessage() {}
 
 func (x *Suggestion) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[39]
+	mi := &file_v2_skaffold_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,7 +3589,7 @@ func (x *Suggestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{39}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *Suggestion) GetSuggestionCode() enums.SuggestionCode {
@@ -3463,7 +3620,7 @@ type IntOrString struct {
 func (x *IntOrString) Reset() {
 	*x = IntOrString{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[40]
+		mi := &file_v2_skaffold_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3476,7 +3633,7 @@ func (x *IntOrString) String() string {
 func (*IntOrString) ProtoMessage() {}
 
 func (x *IntOrString) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[40]
+	mi := &file_v2_skaffold_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3489,7 +3646,7 @@ func (x *IntOrString) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntOrString.ProtoReflect.Descriptor instead.
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{40}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *IntOrString) GetType() int32 {
@@ -3527,7 +3684,7 @@ type BuildMetadata_Artifact struct {
 func (x *BuildMetadata_Artifact) Reset() {
 	*x = BuildMetadata_Artifact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[43]
+		mi := &file_v2_skaffold_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3540,7 +3697,7 @@ func (x *BuildMetadata_Artifact) String() string {
 func (*BuildMetadata_Artifact) ProtoMessage() {}
 
 func (x *BuildMetadata_Artifact) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[43]
+	mi := &file_v2_skaffold_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3596,7 +3753,7 @@ type TestMetadata_Tester struct {
 func (x *TestMetadata_Tester) Reset() {
 	*x = TestMetadata_Tester{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[45]
+		mi := &file_v2_skaffold_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3609,7 +3766,7 @@ func (x *TestMetadata_Tester) String() string {
 func (*TestMetadata_Tester) ProtoMessage() {}
 
 func (x *TestMetadata_Tester) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[45]
+	mi := &file_v2_skaffold_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3651,7 +3808,7 @@ type RenderMetadata_Renderer struct {
 func (x *RenderMetadata_Renderer) Reset() {
 	*x = RenderMetadata_Renderer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[46]
+		mi := &file_v2_skaffold_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3664,7 +3821,7 @@ func (x *RenderMetadata_Renderer) String() string {
 func (*RenderMetadata_Renderer) ProtoMessage() {}
 
 func (x *RenderMetadata_Renderer) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[46]
+	mi := &file_v2_skaffold_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3706,7 +3863,7 @@ type DeployMetadata_Deployer struct {
 func (x *DeployMetadata_Deployer) Reset() {
 	*x = DeployMetadata_Deployer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[47]
+		mi := &file_v2_skaffold_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3719,7 +3876,7 @@ func (x *DeployMetadata_Deployer) String() string {
 func (*DeployMetadata_Deployer) ProtoMessage() {}
 
 func (x *DeployMetadata_Deployer) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[47]
+	mi := &file_v2_skaffold_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4093,7 +4250,7 @@ var file_v2_skaffold_proto_rawDesc = []byte{
 	0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e,
 	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0x91, 0x02, 0x0a,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0xd1, 0x02, 0x0a,
 	0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65,
 	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
@@ -4111,45 +4268,16 @@ var file_v2_skaffold_proto_rawDesc = []byte{
 	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
 	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73,
-	0x22, 0x92, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a,
-	0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0x94, 0x01, 0x0a,
-	0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x45, 0x72, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x53, 0x75, 0x62, 0x74,
-	0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x52, 0x0c, 0x70, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x48, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x54,
+	0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
@@ -4158,173 +4286,227 @@ var file_v2_skaffold_proto_rawDesc = []byte{
 	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72,
 	0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22,
-	0x88, 0x02, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x94, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d,
+	0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0x92, 0x01,
+	0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45,
+	0x72, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x75, 0x62,
+	0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0xc8, 0x03, 0x0a, 0x17, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x72, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74,
 	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
 	0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65,
-	0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0xec, 0x02, 0x0a, 0x10, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x72,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xc3,
-	0x01, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69,
-	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x72, 0x72, 0x22, 0xa0, 0x03, 0x0a, 0x17, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x44, 0x69, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x51, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06,
-	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x06,
-	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x31, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x64, 0x0a, 0x06, 0x49, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x0a,
-	0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70, 0x22,
-	0x69, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a,
-	0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x6e,
-	0x75, 0x6d, 0x73, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0b, 0x49, 0x6e,
-	0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69,
-	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x32, 0xdf, 0x05,
-	0x0a, 0x11, 0x53, 0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x56, 0x32, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x03, 0x0a, 0x10, 0x50, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32,
+	0x2e, 0x49, 0x6e, 0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a,
+	0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x22, 0xa0, 0x03, 0x0a,
+	0x17, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x51, 0x0a,
+	0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x3d, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3e,
+	0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x31,
+	0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x76, 0x61,
+	0x6c, 0x22, 0x64, 0x0a, 0x06, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70, 0x22, 0x69, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x56, 0x61, 0x6c, 0x32, 0xdf, 0x05, 0x0a, 0x11, 0x53, 0x6b, 0x61, 0x66, 0x66, 0x6f,
+	0x6c, 0x64, 0x56, 0x32, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a,
+	0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0f,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b,
-	0x12, 0x09, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x12,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x12,
-	0x5b, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
-	0x0b, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x64, 0x0a, 0x09,
-	0x41, 0x75, 0x74, 0x6f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x3a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2f, 0x76, 0x32, 0x2f,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x12, 0x62, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x18,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a,
-	0x15, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x4f,
-	0x0a, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76,
-	0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x42,
-	0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6f,
-	0x6c, 0x73, 0x2f, 0x73, 0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x76, 0x32, 0x50, 0x03, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x12, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a,
+	0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x0b, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x12, 0x64, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x1a, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x08, 0x41, 0x75,
+	0x74, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x3a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x79, 0x6e,
+	0x63, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x66,
+	0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x17, 0x2f,
+	0x76, 0x32, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x73, 0x6b, 0x61, 0x66, 0x66,
+	0x6f, 0x6c, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x32, 0x50, 0x03, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4339,7 +4521,7 @@ func file_v2_skaffold_proto_rawDescGZIP() []byte {
 	return file_v2_skaffold_proto_rawDescData
 }
 
-var file_v2_skaffold_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_v2_skaffold_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_v2_skaffold_proto_goTypes = []interface{}{
 	(*StateResponse)(nil),           // 0: proto.v2.StateResponse
 	(*Response)(nil),                // 1: proto.v2.Response
@@ -4366,52 +4548,53 @@ var file_v2_skaffold_proto_goTypes = []interface{}{
 	(*ApplicationLogEvent)(nil),     // 22: proto.v2.ApplicationLogEvent
 	(*TaskEvent)(nil),               // 23: proto.v2.TaskEvent
 	(*BuildSubtaskEvent)(nil),       // 24: proto.v2.BuildSubtaskEvent
-	(*TestSubtaskEvent)(nil),        // 25: proto.v2.TestSubtaskEvent
-	(*RenderSubtaskEvent)(nil),      // 26: proto.v2.RenderSubtaskEvent
-	(*VerifySubtaskEvent)(nil),      // 27: proto.v2.VerifySubtaskEvent
-	(*ExecSubtaskEvent)(nil),        // 28: proto.v2.ExecSubtaskEvent
-	(*DeploySubtaskEvent)(nil),      // 29: proto.v2.DeploySubtaskEvent
-	(*StatusCheckSubtaskEvent)(nil), // 30: proto.v2.StatusCheckSubtaskEvent
-	(*CloudRunReadyEvent)(nil),      // 31: proto.v2.CloudRunReadyEvent
-	(*PortForwardEvent)(nil),        // 32: proto.v2.PortForwardEvent
-	(*FileSyncEvent)(nil),           // 33: proto.v2.FileSyncEvent
-	(*DebuggingContainerEvent)(nil), // 34: proto.v2.DebuggingContainerEvent
-	(*UserIntentRequest)(nil),       // 35: proto.v2.UserIntentRequest
-	(*TriggerRequest)(nil),          // 36: proto.v2.TriggerRequest
-	(*TriggerState)(nil),            // 37: proto.v2.TriggerState
-	(*Intent)(nil),                  // 38: proto.v2.Intent
-	(*Suggestion)(nil),              // 39: proto.v2.Suggestion
-	(*IntOrString)(nil),             // 40: proto.v2.IntOrString
-	nil,                             // 41: proto.v2.State.ForwardedPortsEntry
-	nil,                             // 42: proto.v2.Metadata.AdditionalEntry
-	(*BuildMetadata_Artifact)(nil),  // 43: proto.v2.BuildMetadata.Artifact
-	nil,                             // 44: proto.v2.BuildMetadata.AdditionalEntry
-	(*TestMetadata_Tester)(nil),     // 45: proto.v2.TestMetadata.Tester
-	(*RenderMetadata_Renderer)(nil), // 46: proto.v2.RenderMetadata.Renderer
-	(*DeployMetadata_Deployer)(nil), // 47: proto.v2.DeployMetadata.Deployer
-	nil,                             // 48: proto.v2.BuildState.ArtifactsEntry
-	nil,                             // 49: proto.v2.StatusCheckState.ResourcesEntry
-	nil,                             // 50: proto.v2.DebuggingContainerEvent.DebugPortsEntry
-	(enums.BuildType)(0),            // 51: proto.enums.BuildType
-	(enums.ClusterType)(0),          // 52: proto.enums.ClusterType
-	(enums.StatusCode)(0),           // 53: proto.enums.StatusCode
-	(*timestamppb.Timestamp)(nil),   // 54: google.protobuf.Timestamp
-	(enums.LogLevel)(0),             // 55: proto.enums.LogLevel
-	(enums.SuggestionCode)(0),       // 56: proto.enums.SuggestionCode
-	(enums.BuilderType)(0),          // 57: proto.enums.BuilderType
-	(enums.TesterType)(0),           // 58: proto.enums.TesterType
-	(enums.RenderType)(0),           // 59: proto.enums.RenderType
-	(enums.DeployerType)(0),         // 60: proto.enums.DeployerType
-	(*emptypb.Empty)(nil),           // 61: google.protobuf.Empty
+	(*BuildPhaseTiming)(nil),        // 25: proto.v2.BuildPhaseTiming
+	(*TestSubtaskEvent)(nil),        // 26: proto.v2.TestSubtaskEvent
+	(*RenderSubtaskEvent)(nil),      // 27: proto.v2.RenderSubtaskEvent
+	(*VerifySubtaskEvent)(nil),      // 28: proto.v2.VerifySubtaskEvent
+	(*ExecSubtaskEvent)(nil),        // 29: proto.v2.ExecSubtaskEvent
+	(*DeploySubtaskEvent)(nil),      // 30: proto.v2.DeploySubtaskEvent
+	(*StatusCheckSubtaskEvent)(nil), // 31: proto.v2.StatusCheckSubtaskEvent
+	(*CloudRunReadyEvent)(nil),      // 32: proto.v2.CloudRunReadyEvent
+	(*PortForwardEvent)(nil),        // 33: proto.v2.PortForwardEvent
+	(*FileSyncEvent)(nil),           // 34: proto.v2.FileSyncEvent
+	(*DebuggingContainerEvent)(nil), // 35: proto.v2.DebuggingContainerEvent
+	(*UserIntentRequest)(nil),       // 36: proto.v2.UserIntentRequest
+	(*TriggerRequest)(nil),          // 37: proto.v2.TriggerRequest
+	(*TriggerState)(nil),            // 38: proto.v2.TriggerState
+	(*Intent)(nil),                  // 39: proto.v2.Intent
+	(*Suggestion)(nil),              // 40: proto.v2.Suggestion
+	(*IntOrString)(nil),             // 41: proto.v2.IntOrString
+	nil,                             // 42: proto.v2.State.ForwardedPortsEntry
+	nil,                             // 43: proto.v2.Metadata.AdditionalEntry
+	(*BuildMetadata_Artifact)(nil),  // 44: proto.v2.BuildMetadata.Artifact
+	nil,                             // 45: proto.v2.BuildMetadata.AdditionalEntry
+	(*TestMetadata_Tester)(nil),     // 46: proto.v2.TestMetadata.Tester
+	(*RenderMetadata_Renderer)(nil), // 47: proto.v2.RenderMetadata.Renderer
+	(*DeployMetadata_Deployer)(nil), // 48: proto.v2.DeployMetadata.Deployer
+	nil,                             // 49: proto.v2.BuildState.ArtifactsEntry
+	nil,                             // 50: proto.v2.StatusCheckState.ResourcesEntry
+	nil,                             // 51: proto.v2.DebuggingContainerEvent.DebugPortsEntry
+	(enums.BuildType)(0),            // 52: proto.enums.BuildType
+	(enums.ClusterType)(0),          // 53: proto.enums.ClusterType
+	(enums.StatusCode)(0),           // 54: proto.enums.StatusCode
+	(*timestamppb.Timestamp)(nil),   // 55: google.protobuf.Timestamp
+	(enums.LogLevel)(0),             // 56: proto.enums.LogLevel
+	(enums.SuggestionCode)(0),       // 57: proto.enums.SuggestionCode
+	(enums.BuilderType)(0),          // 58: proto.enums.BuilderType
+	(enums.TesterType)(0),           // 59: proto.enums.TesterType
+	(enums.RenderType)(0),           // 60: proto.enums.RenderType
+	(enums.DeployerType)(0),         // 61: proto.enums.DeployerType
+	(*emptypb.Empty)(nil),           // 62: google.protobuf.Empty
 }
 var file_v2_skaffold_proto_depIdxs = []int32{
 	3,  // 0: proto.v2.StateResponse.state:type_name -> proto.v2.State
 	9,  // 1: proto.v2.State.buildState:type_name -> proto.v2.BuildState
 	13, // 2: proto.v2.State.deployState:type_name -> proto.v2.DeployState
-	41, // 3: proto.v2.State.forwardedPorts:type_name -> proto.v2.State.ForwardedPortsEntry
+	42, // 3: proto.v2.State.forwardedPorts:type_name -> proto.v2.State.ForwardedPortsEntry
 	15, // 4: proto.v2.State.statusCheckState:type_name -> proto.v2.StatusCheckState
 	16, // 5: proto.v2.State.fileSyncState:type_name -> proto.v2.FileSyncState
-	34, // 6: proto.v2.State.debuggingContainers:type_name -> proto.v2.DebuggingContainerEvent
+	35, // 6: proto.v2.State.debuggingContainers:type_name -> proto.v2.DebuggingContainerEvent
 	4,  // 7: proto.v2.State.metadata:type_name -> proto.v2.Metadata
 	10, // 8: proto.v2.State.testState:type_name -> proto.v2.TestState
 	11, // 9: proto.v2.State.renderState:type_name -> proto.v2.RenderState
@@ -4421,86 +4604,87 @@ var file_v2_skaffold_proto_depIdxs = []int32{
 	8,  // 13: proto.v2.Metadata.deploy:type_name -> proto.v2.DeployMetadata
 	6,  // 14: proto.v2.Metadata.test:type_name -> proto.v2.TestMetadata
 	7,  // 15: proto.v2.Metadata.render:type_name -> proto.v2.RenderMetadata
-	42, // 16: proto.v2.Metadata.additional:type_name -> proto.v2.Metadata.AdditionalEntry
-	43, // 17: proto.v2.BuildMetadata.artifacts:type_name -> proto.v2.BuildMetadata.Artifact
-	51, // 18: proto.v2.BuildMetadata.type:type_name -> proto.enums.BuildType
-	44, // 19: proto.v2.BuildMetadata.additional:type_name -> proto.v2.BuildMetadata.AdditionalEntry
-	45, // 20: proto.v2.TestMetadata.Testers:type_name -> proto.v2.TestMetadata.Tester
-	46, // 21: proto.v2.RenderMetadata.Renderers:type_name -> proto.v2.RenderMetadata.Renderer
-	47, // 22: proto.v2.DeployMetadata.deployers:type_name -> proto.v2.DeployMetadata.Deployer
-	52, // 23: proto.v2.DeployMetadata.cluster:type_name -> proto.enums.ClusterType
-	48, // 24: proto.v2.BuildState.artifacts:type_name -> proto.v2.BuildState.ArtifactsEntry
-	53, // 25: proto.v2.BuildState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 26: proto.v2.TestState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 27: proto.v2.RenderState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 28: proto.v2.VerifyState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 29: proto.v2.DeployState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 30: proto.v2.ExecState.statusCode:type_name -> proto.enums.StatusCode
-	49, // 31: proto.v2.StatusCheckState.resources:type_name -> proto.v2.StatusCheckState.ResourcesEntry
-	53, // 32: proto.v2.StatusCheckState.statusCode:type_name -> proto.enums.StatusCode
-	54, // 33: proto.v2.Event.timestamp:type_name -> google.protobuf.Timestamp
+	43, // 16: proto.v2.Metadata.additional:type_name -> proto.v2.Metadata.AdditionalEntry
+	44, // 17: proto.v2.BuildMetadata.artifacts:type_name -> proto.v2.BuildMetadata.Artifact
+	52, // 18: proto.v2.BuildMetadata.type:type_name -> proto.enums.BuildType
+	45, // 19: proto.v2.BuildMetadata.additional:type_name -> proto.v2.BuildMetadata.AdditionalEntry
+	46, // 20: proto.v2.TestMetadata.Testers:type_name -> proto.v2.TestMetadata.Tester
+	47, // 21: proto.v2.RenderMetadata.Renderers:type_name -> proto.v2.RenderMetadata.Renderer
+	48, // 22: proto.v2.DeployMetadata.deployers:type_name -> proto.v2.DeployMetadata.Deployer
+	53, // 23: proto.v2.DeployMetadata.cluster:type_name -> proto.enums.ClusterType
+	49, // 24: proto.v2.BuildState.artifacts:type_name -> proto.v2.BuildState.ArtifactsEntry
+	54, // 25: proto.v2.BuildState.statusCode:type_name -> proto.enums.StatusCode
+	54, // 26: proto.v2.TestState.statusCode:type_name -> proto.enums.StatusCode
+	54, // 27: proto.v2.RenderState.statusCode:type_name -> proto.enums.StatusCode
+	54, // 28: proto.v2.VerifyState.statusCode:type_name -> proto.enums.StatusCode
+	54, // 29: proto.v2.DeployState.statusCode:type_name -> proto.enums.StatusCode
+	54, // 30: proto.v2.ExecState.statusCode:type_name -> proto.enums.StatusCode
+	50, // 31: proto.v2.StatusCheckState.resources:type_name -> proto.v2.StatusCheckState.ResourcesEntry
+	54, // 32: proto.v2.StatusCheckState.statusCode:type_name -> proto.enums.StatusCode
+	55, // 33: proto.v2.Event.timestamp:type_name -> google.protobuf.Timestamp
 	20, // 34: proto.v2.Event.metaEvent:type_name -> proto.v2.MetaEvent
 	21, // 35: proto.v2.Event.skaffoldLogEvent:type_name -> proto.v2.SkaffoldLogEvent
 	22, // 36: proto.v2.Event.applicationLogEvent:type_name -> proto.v2.ApplicationLogEvent
 	23, // 37: proto.v2.Event.taskEvent:type_name -> proto.v2.TaskEvent
 	24, // 38: proto.v2.Event.buildSubtaskEvent:type_name -> proto.v2.BuildSubtaskEvent
-	29, // 39: proto.v2.Event.deploySubtaskEvent:type_name -> proto.v2.DeploySubtaskEvent
-	32, // 40: proto.v2.Event.portEvent:type_name -> proto.v2.PortForwardEvent
-	30, // 41: proto.v2.Event.statusCheckSubtaskEvent:type_name -> proto.v2.StatusCheckSubtaskEvent
-	33, // 42: proto.v2.Event.fileSyncEvent:type_name -> proto.v2.FileSyncEvent
-	34, // 43: proto.v2.Event.debuggingContainerEvent:type_name -> proto.v2.DebuggingContainerEvent
+	30, // 39: proto.v2.Event.deploySubtaskEvent:type_name -> proto.v2.DeploySubtaskEvent
+	33, // 40: proto.v2.Event.portEvent:type_name -> proto.v2.PortForwardEvent
+	31, // 41: proto.v2.Event.statusCheckSubtaskEvent:type_name -> proto.v2.StatusCheckSubtaskEvent
+	34, // 42: proto.v2.Event.fileSyncEvent:type_name -> proto.v2.FileSyncEvent
+	35, // 43: proto.v2.Event.debuggingContainerEvent:type_name -> proto.v2.DebuggingContainerEvent
 	18, // 44: proto.v2.Event.terminationEvent:type_name -> proto.v2.TerminationEvent
-	25, // 45: proto.v2.Event.testEvent:type_name -> proto.v2.TestSubtaskEvent
-	26, // 46: proto.v2.Event.renderEvent:type_name -> proto.v2.RenderSubtaskEvent
-	27, // 47: proto.v2.Event.verifyEvent:type_name -> proto.v2.VerifySubtaskEvent
-	31, // 48: proto.v2.Event.cloudRunReadyEvent:type_name -> proto.v2.CloudRunReadyEvent
-	28, // 49: proto.v2.Event.execEvent:type_name -> proto.v2.ExecSubtaskEvent
+	26, // 45: proto.v2.Event.testEvent:type_name -> proto.v2.TestSubtaskEvent
+	27, // 46: proto.v2.Event.renderEvent:type_name -> proto.v2.RenderSubtaskEvent
+	28, // 47: proto.v2.Event.verifyEvent:type_name -> proto.v2.VerifySubtaskEvent
+	32, // 48: proto.v2.Event.cloudRunReadyEvent:type_name -> proto.v2.CloudRunReadyEvent
+	29, // 49: proto.v2.Event.execEvent:type_name -> proto.v2.ExecSubtaskEvent
 	19, // 50: proto.v2.TerminationEvent.err:type_name -> proto.v2.ActionableErr
-	53, // 51: proto.v2.ActionableErr.errCode:type_name -> proto.enums.StatusCode
-	39, // 52: proto.v2.ActionableErr.suggestions:type_name -> proto.v2.Suggestion
+	54, // 51: proto.v2.ActionableErr.errCode:type_name -> proto.enums.StatusCode
+	40, // 52: proto.v2.ActionableErr.suggestions:type_name -> proto.v2.Suggestion
 	4,  // 53: proto.v2.MetaEvent.metadata:type_name -> proto.v2.Metadata
-	55, // 54: proto.v2.SkaffoldLogEvent.level:type_name -> proto.enums.LogLevel
+	56, // 54: proto.v2.SkaffoldLogEvent.level:type_name -> proto.enums.LogLevel
 	19, // 55: proto.v2.TaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
 	19, // 56: proto.v2.BuildSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 57: proto.v2.TestSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 58: proto.v2.RenderSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 59: proto.v2.VerifySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 60: proto.v2.ExecSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 61: proto.v2.DeploySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	53, // 62: proto.v2.StatusCheckSubtaskEvent.statusCode:type_name -> proto.enums.StatusCode
-	19, // 63: proto.v2.StatusCheckSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	40, // 64: proto.v2.PortForwardEvent.targetPort:type_name -> proto.v2.IntOrString
-	19, // 65: proto.v2.FileSyncEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	50, // 66: proto.v2.DebuggingContainerEvent.debugPorts:type_name -> proto.v2.DebuggingContainerEvent.DebugPortsEntry
-	38, // 67: proto.v2.UserIntentRequest.intent:type_name -> proto.v2.Intent
-	37, // 68: proto.v2.TriggerRequest.state:type_name -> proto.v2.TriggerState
-	56, // 69: proto.v2.Suggestion.suggestionCode:type_name -> proto.enums.SuggestionCode
-	32, // 70: proto.v2.State.ForwardedPortsEntry.value:type_name -> proto.v2.PortForwardEvent
-	57, // 71: proto.v2.BuildMetadata.Artifact.type:type_name -> proto.enums.BuilderType
-	58, // 72: proto.v2.TestMetadata.Tester.type:type_name -> proto.enums.TesterType
-	59, // 73: proto.v2.RenderMetadata.Renderer.type:type_name -> proto.enums.RenderType
-	60, // 74: proto.v2.DeployMetadata.Deployer.type:type_name -> proto.enums.DeployerType
-	61, // 75: proto.v2.SkaffoldV2Service.GetState:input_type -> google.protobuf.Empty
-	61, // 76: proto.v2.SkaffoldV2Service.Events:input_type -> google.protobuf.Empty
-	61, // 77: proto.v2.SkaffoldV2Service.ApplicationLogs:input_type -> google.protobuf.Empty
-	35, // 78: proto.v2.SkaffoldV2Service.Execute:input_type -> proto.v2.UserIntentRequest
-	36, // 79: proto.v2.SkaffoldV2Service.AutoBuild:input_type -> proto.v2.TriggerRequest
-	36, // 80: proto.v2.SkaffoldV2Service.AutoSync:input_type -> proto.v2.TriggerRequest
-	36, // 81: proto.v2.SkaffoldV2Service.AutoDeploy:input_type -> proto.v2.TriggerRequest
-	17, // 82: proto.v2.SkaffoldV2Service.Handle:input_type -> proto.v2.Event
-	3,  // 83: proto.v2.SkaffoldV2Service.GetState:output_type -> proto.v2.State
-	17, // 84: proto.v2.SkaffoldV2Service.Events:output_type -> proto.v2.Event
-	17, // 85: proto.v2.SkaffoldV2Service.ApplicationLogs:output_type -> proto.v2.Event
-	61, // 86: proto.v2.SkaffoldV2Service.Execute:output_type -> google.protobuf.Empty
-	61, // 87: proto.v2.SkaffoldV2Service.AutoBuild:output_type -> google.protobuf.Empty
-	61, // 88: proto.v2.SkaffoldV2Service.AutoSync:output_type -> google.protobuf.Empty
-	61, // 89: proto.v2.SkaffoldV2Service.AutoDeploy:output_type -> google.protobuf.Empty
-	61, // 90: proto.v2.SkaffoldV2Service.Handle:output_type -> google.protobuf.Empty
-	83, // [83:91] is the sub-list for method output_type
-	75, // [75:83] is the sub-list for method input_type
-	75, // [75:75] is the sub-list for extension type_name
-	75, // [75:75] is the sub-list for extension extendee
-	0,  // [0:75] is the sub-list for field type_name
+	25, // 57: proto.v2.BuildSubtaskEvent.phaseTimings:type_name -> proto.v2.BuildPhaseTiming
+	19, // 58: proto.v2.TestSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 59: proto.v2.RenderSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 60: proto.v2.VerifySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 61: proto.v2.ExecSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 62: proto.v2.DeploySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	54, // 63: proto.v2.StatusCheckSubtaskEvent.statusCode:type_name -> proto.enums.StatusCode
+	19, // 64: proto.v2.StatusCheckSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	41, // 65: proto.v2.PortForwardEvent.targetPort:type_name -> proto.v2.IntOrString
+	19, // 66: proto.v2.FileSyncEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	51, // 67: proto.v2.DebuggingContainerEvent.debugPorts:type_name -> proto.v2.DebuggingContainerEvent.DebugPortsEntry
+	39, // 68: proto.v2.UserIntentRequest.intent:type_name -> proto.v2.Intent
+	38, // 69: proto.v2.TriggerRequest.state:type_name -> proto.v2.TriggerState
+	57, // 70: proto.v2.Suggestion.suggestionCode:type_name -> proto.enums.SuggestionCode
+	33, // 71: proto.v2.State.ForwardedPortsEntry.value:type_name -> proto.v2.PortForwardEvent
+	58, // 72: proto.v2.BuildMetadata.Artifact.type:type_name -> proto.enums.BuilderType
+	59, // 73: proto.v2.TestMetadata.Tester.type:type_name -> proto.enums.TesterType
+	60, // 74: proto.v2.RenderMetadata.Renderer.type:type_name -> proto.enums.RenderType
+	61, // 75: proto.v2.DeployMetadata.Deployer.type:type_name -> proto.enums.DeployerType
+	62, // 76: proto.v2.SkaffoldV2Service.GetState:input_type -> google.protobuf.Empty
+	62, // 77: proto.v2.SkaffoldV2Service.Events:input_type -> google.protobuf.Empty
+	62, // 78: proto.v2.SkaffoldV2Service.ApplicationLogs:input_type -> google.protobuf.Empty
+	36, // 79: proto.v2.SkaffoldV2Service.Execute:input_type -> proto.v2.UserIntentRequest
+	37, // 80: proto.v2.SkaffoldV2Service.AutoBuild:input_type -> proto.v2.TriggerRequest
+	37, // 81: proto.v2.SkaffoldV2Service.AutoSync:input_type -> proto.v2.TriggerRequest
+	37, // 82: proto.v2.SkaffoldV2Service.AutoDeploy:input_type -> proto.v2.TriggerRequest
+	17, // 83: proto.v2.SkaffoldV2Service.Handle:input_type -> proto.v2.Event
+	3,  // 84: proto.v2.SkaffoldV2Service.GetState:output_type -> proto.v2.State
+	17, // 85: proto.v2.SkaffoldV2Service.Events:output_type -> proto.v2.Event
+	17, // 86: proto.v2.SkaffoldV2Service.ApplicationLogs:output_type -> proto.v2.Event
+	62, // 87: proto.v2.SkaffoldV2Service.Execute:output_type -> google.protobuf.Empty
+	62, // 88: proto.v2.SkaffoldV2Service.AutoBuild:output_type -> google.protobuf.Empty
+	62, // 89: proto.v2.SkaffoldV2Service.AutoSync:output_type -> google.protobuf.Empty
+	62, // 90: proto.v2.SkaffoldV2Service.AutoDeploy:output_type -> google.protobuf.Empty
+	62, // 91: proto.v2.SkaffoldV2Service.Handle:output_type -> google.protobuf.Empty
+	84, // [84:92] is the sub-list for method output_type
+	76, // [76:84] is the sub-list for method input_type
+	76, // [76:76] is the sub-list for extension type_name
+	76, // [76:76] is the sub-list for extension extendee
+	0,  // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_v2_skaffold_proto_init() }
@@ -4810,7 +4994,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestSubtaskEvent); i {
+			switch v := v.(*BuildPhaseTiming); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4822,7 +5006,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenderSubtaskEvent); i {
+			switch v := v.(*TestSubtaskEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4834,7 +5018,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifySubtaskEvent); i {
+			switch v := v.(*RenderSubtaskEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4846,7 +5030,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecSubtaskEvent); i {
+			switch v := v.(*VerifySubtaskEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4858,7 +5042,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeploySubtaskEvent); i {
+			switch v := v.(*ExecSubtaskEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4870,7 +5054,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusCheckSubtaskEvent); i {
+			switch v := v.(*DeploySubtaskEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4882,7 +5066,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloudRunReadyEvent); i {
+			switch v := v.(*StatusCheckSubtaskEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4894,7 +5078,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortForwardEvent); i {
+			switch v := v.(*CloudRunReadyEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4906,7 +5090,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileSyncEvent); i {
+			switch v := v.(*PortForwardEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4918,7 +5102,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebuggingContainerEvent); i {
+			switch v := v.(*FileSyncEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4930,7 +5114,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIntentRequest); i {
+			switch v := v.(*DebuggingContainerEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4942,7 +5126,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRequest); i {
+			switch v := v.(*UserIntentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4954,7 +5138,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerState); i {
+			switch v := v.(*TriggerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4966,7 +5150,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Intent); i {
+			switch v := v.(*TriggerState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4978,7 +5162,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Suggestion); i {
+			switch v := v.(*Intent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4990,6 +5174,18 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_skaffold_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IntOrString); i {
 			case 0:
 				return &v.state
@@ -5001,7 +5197,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuildMetadata_Artifact); i {
 			case 0:
 				return &v.state
@@ -5013,7 +5209,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestMetadata_Tester); i {
 			case 0:
 				return &v.state
@@ -5025,7 +5221,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenderMetadata_Renderer); i {
 			case 0:
 				return &v.state
@@ -5037,7 +5233,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeployMetadata_Deployer); i {
 			case 0:
 				return &v.state
@@ -5068,7 +5264,7 @@ func file_v2_skaffold_proto_init() {
 		(*Event_CloudRunReadyEvent)(nil),
 		(*Event_ExecEvent)(nil),
 	}
-	file_v2_skaffold_proto_msgTypes[37].OneofWrappers = []interface{}{
+	file_v2_skaffold_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*TriggerState_Enabled)(nil),
 	}
 	type x struct{}
@@ -5077,7 +5273,7 @@ func file_v2_skaffold_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v2_skaffold_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   51,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -74,6 +74,10 @@ func CmdRun(command string) *FakeCmd {
 	return newFakeCmd().AndRun(command)
 }
 
+func CmdRunInput(command string, input string) *FakeCmd {
+	return newFakeCmd().AndRunInput(command, input)
+}
+
 func CmdRunInputOut(command string, input string, output string) *FakeCmd {
 	return newFakeCmd().AndRunInputOut(command, input, output)
 }